@@ -0,0 +1,128 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChannelRolloutApplyConfiguration represents a declarative configuration of the ChannelRollout type for use
+// with apply.
+//
+// ChannelRollout is the progress of the rollout of a version assigned to a channel.
+type ChannelRolloutApplyConfiguration struct {
+	// Channel is the module channel.
+	Channel *string `json:"channel,omitempty"`
+	// Version is the version rolled out.
+	Version *string `json:"version,omitempty"`
+	// PreviousVersion is the version that Kymas not yet in the rollout install.
+	PreviousVersion *string `json:"previousVersion,omitempty"`
+	// Wave is the index of the last wave the version is rolled out to. It equals the number of waves once the
+	// version is rolled out to all Kymas.
+	Wave *int `json:"wave,omitempty"`
+	// WaveStartTime is the time the last wave rolled out to started to soak, once the module ran the version in
+	// all of its Kymas.
+	WaveStartTime *v1.Time `json:"waveStartTime,omitempty"`
+	// State is the state of the rollout.
+	State *apiv1beta2.RolloutState `json:"state,omitempty"`
+	// UpgradedKymas is the number of Kymas in which the module runs in the version.
+	UpgradedKymas *int `json:"upgradedKymas,omitempty"`
+	// FailedKymas is the number of Kymas in which the module runs in the version and is in the Error state.
+	FailedKymas *int `json:"failedKymas,omitempty"`
+	// Message explains the state of the rollout.
+	Message *string `json:"message,omitempty"`
+}
+
+// ChannelRolloutApplyConfiguration constructs a declarative configuration of the ChannelRollout type for use with
+// apply.
+func ChannelRollout() *ChannelRolloutApplyConfiguration {
+	return &ChannelRolloutApplyConfiguration{}
+}
+
+// WithChannel sets the Channel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Channel field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithChannel(value string) *ChannelRolloutApplyConfiguration {
+	b.Channel = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithVersion(value string) *ChannelRolloutApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithPreviousVersion sets the PreviousVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousVersion field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithPreviousVersion(value string) *ChannelRolloutApplyConfiguration {
+	b.PreviousVersion = &value
+	return b
+}
+
+// WithWave sets the Wave field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Wave field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithWave(value int) *ChannelRolloutApplyConfiguration {
+	b.Wave = &value
+	return b
+}
+
+// WithWaveStartTime sets the WaveStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WaveStartTime field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithWaveStartTime(value v1.Time) *ChannelRolloutApplyConfiguration {
+	b.WaveStartTime = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithState(value apiv1beta2.RolloutState) *ChannelRolloutApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithUpgradedKymas sets the UpgradedKymas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradedKymas field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithUpgradedKymas(value int) *ChannelRolloutApplyConfiguration {
+	b.UpgradedKymas = &value
+	return b
+}
+
+// WithFailedKymas sets the FailedKymas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedKymas field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithFailedKymas(value int) *ChannelRolloutApplyConfiguration {
+	b.FailedKymas = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ChannelRolloutApplyConfiguration) WithMessage(value string) *ChannelRolloutApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -24,6 +24,11 @@ type ChannelVersionAssignmentApplyConfiguration struct {
 	Channel *string `json:"channel,omitempty"`
 	// Version is the module version of the corresponding module channel.
 	Version *string `json:"version,omitempty"`
+	// Rollout is the strategy to roll out a new version of the channel. If not set, a new version is rolled out
+	// to all Kymas at once.
+	Rollout *RolloutStrategyApplyConfiguration `json:"rollout,omitempty"`
+	// Deprecation announces the removal of the channel to the Kymas that use it.
+	Deprecation *DeprecationApplyConfiguration `json:"deprecation,omitempty"`
 }
 
 // ChannelVersionAssignmentApplyConfiguration constructs a declarative configuration of the ChannelVersionAssignment type for use with
@@ -47,3 +52,19 @@ func (b *ChannelVersionAssignmentApplyConfiguration) WithVersion(value string) *
 	b.Version = &value
 	return b
 }
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *ChannelVersionAssignmentApplyConfiguration) WithRollout(value *RolloutStrategyApplyConfiguration) *ChannelVersionAssignmentApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithDeprecation sets the Deprecation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecation field is set to the value of the last call.
+func (b *ChannelVersionAssignmentApplyConfiguration) WithDeprecation(value *DeprecationApplyConfiguration) *ChannelVersionAssignmentApplyConfiguration {
+	b.Deprecation = value
+	return b
+}
@@ -24,7 +24,8 @@ import (
 // CustomStateCheckApplyConfiguration represents a declarative configuration of the CustomStateCheck type for use
 // with apply.
 type CustomStateCheckApplyConfiguration struct {
-	// JSONPath specifies the JSON path to the state variable in the Module CR
+	// JSONPath specifies the JSON path to the state variable in the Module CR,
+	// e.g. status.conditions[?(@.type=="Ready")].status
 	JSONPath *string `json:"jsonPath,omitempty"`
 	// Value is the value at the JSONPath for which the Module CR state should map with MappedState
 	Value *string `json:"value,omitempty"`
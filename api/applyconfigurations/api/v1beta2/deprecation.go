@@ -0,0 +1,61 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// DeprecationApplyConfiguration represents a declarative configuration of the Deprecation type for use
+// with apply.
+//
+// Deprecation announces the removal of a module or of a module channel.
+type DeprecationApplyConfiguration struct {
+	// Deprecated marks the module or channel as deprecated.
+	Deprecated *bool `json:"deprecated,omitempty"`
+	// SunsetDate is the date from which the module or channel is no longer available, in the format YYYY-MM-DD.
+	SunsetDate *string `json:"sunsetDate,omitempty"`
+	// Replacement is the module replacing a deprecated module, or the channel replacing a deprecated channel.
+	Replacement *string `json:"replacement,omitempty"`
+}
+
+// DeprecationApplyConfiguration constructs a declarative configuration of the Deprecation type for use with
+// apply.
+func Deprecation() *DeprecationApplyConfiguration {
+	return &DeprecationApplyConfiguration{}
+}
+
+// WithDeprecated sets the Deprecated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecated field is set to the value of the last call.
+func (b *DeprecationApplyConfiguration) WithDeprecated(value bool) *DeprecationApplyConfiguration {
+	b.Deprecated = &value
+	return b
+}
+
+// WithSunsetDate sets the SunsetDate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SunsetDate field is set to the value of the last call.
+func (b *DeprecationApplyConfiguration) WithSunsetDate(value string) *DeprecationApplyConfiguration {
+	b.SunsetDate = &value
+	return b
+}
+
+// WithReplacement sets the Replacement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replacement field is set to the value of the last call.
+func (b *DeprecationApplyConfiguration) WithReplacement(value string) *DeprecationApplyConfiguration {
+	b.Replacement = &value
+	return b
+}
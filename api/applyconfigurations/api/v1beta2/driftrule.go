@@ -0,0 +1,103 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// DriftRuleApplyConfiguration represents a declarative configuration of the DriftRule type for use
+// with apply.
+//
+// DriftRule determines how changes in the remote cluster to the fields of selected module resources are handled.
+// By default, all changes to fields set by the module manifest are reverted. If several rules match a field, the
+// last one applies.
+type DriftRuleApplyConfiguration struct {
+	// Group is the API group of the resources, empty for the core API group.
+	Group *string `json:"group,omitempty"`
+	// Kind is the kind of the resources.
+	Kind *string `json:"kind,omitempty"`
+	// Name is the name of the resource. If empty, the rule applies to all resources of the kind.
+	Name *string `json:"name,omitempty"`
+	// Namespace is the namespace of the resource. If empty, the rule applies to resources in all namespaces.
+	Namespace *string `json:"namespace,omitempty"`
+	// Paths are the paths of the fields the rule applies to, with the field names separated by dots and names
+	// containing dots in square brackets, for example "spec.replicas" or "data[config.yaml]". Paths into lists are
+	// not supported. If empty, the rule applies to the whole resource.
+	Paths []string `json:"paths,omitempty"`
+	// Action is the handling of changes to the fields. With Revert, the changes are reverted on the next
+	// reconciliation. With Ignore and Report, the fields are only set when the resource is created and changes are
+	// kept, and with Report, the changes are reported in the drift status of the Manifest.
+	Action *apiv1beta2.DriftAction `json:"action,omitempty"`
+}
+
+// DriftRuleApplyConfiguration constructs a declarative configuration of the DriftRule type for use with
+// apply.
+func DriftRule() *DriftRuleApplyConfiguration {
+	return &DriftRuleApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *DriftRuleApplyConfiguration) WithGroup(value string) *DriftRuleApplyConfiguration {
+	b.Group = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *DriftRuleApplyConfiguration) WithKind(value string) *DriftRuleApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *DriftRuleApplyConfiguration) WithName(value string) *DriftRuleApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *DriftRuleApplyConfiguration) WithNamespace(value string) *DriftRuleApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithPaths adds the given value to the Paths field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Paths field.
+func (b *DriftRuleApplyConfiguration) WithPaths(values ...string) *DriftRuleApplyConfiguration {
+	for i := range values {
+		b.Paths = append(b.Paths, values[i])
+	}
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *DriftRuleApplyConfiguration) WithAction(value apiv1beta2.DriftAction) *DriftRuleApplyConfiguration {
+	b.Action = &value
+	return b
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// FreezeApplyConfiguration represents a declarative configuration of the Freeze type for use
+// with apply.
+//
+// Freeze defines a fleet-wide stop of the upgrades of a module.
+type FreezeApplyConfiguration struct {
+	// Reason explains why the module is frozen.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// FreezeApplyConfiguration constructs a declarative configuration of the Freeze type for use with
+// apply.
+func Freeze() *FreezeApplyConfiguration {
+	return &FreezeApplyConfiguration{}
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *FreezeApplyConfiguration) WithReason(value string) *FreezeApplyConfiguration {
+	b.Reason = &value
+	return b
+}
@@ -29,6 +29,9 @@ type KymaSpecApplyConfiguration struct {
 	SkipMaintenanceWindows *bool `json:"skipMaintenanceWindows,omitempty"`
 	// Modules specifies the list of modules to be installed
 	Modules []ModuleApplyConfiguration `json:"modules,omitempty"`
+	// Scheduling specifies where the workloads of all modules are scheduled in the remote cluster, for example on
+	// a dedicated node pool for system workloads. It is applied to the Pod templates of the module resources.
+	Scheduling *SchedulingPolicyApplyConfiguration `json:"scheduling,omitempty"`
 }
 
 // KymaSpecApplyConfiguration constructs a declarative configuration of the KymaSpec type for use with
@@ -65,3 +68,11 @@ func (b *KymaSpecApplyConfiguration) WithModules(values ...*ModuleApplyConfigura
 	}
 	return b
 }
+
+// WithScheduling sets the Scheduling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheduling field is set to the value of the last call.
+func (b *KymaSpecApplyConfiguration) WithScheduling(value *SchedulingPolicyApplyConfiguration) *KymaSpecApplyConfiguration {
+	b.Scheduling = value
+	return b
+}
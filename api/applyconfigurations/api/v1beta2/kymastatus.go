@@ -38,6 +38,9 @@ type KymaStatusApplyConfiguration struct {
 	Modules []ModuleStatusApplyConfiguration `json:"modules,omitempty"`
 	// Active Channel
 	ActiveChannel *string `json:"activeChannel,omitempty"`
+	// Plan lists the changes to module Manifests that a reconciliation would apply.
+	// It is only reported while the Kyma is annotated with operator.kyma-project.io/dry-run.
+	Plan *ReconcilePlanApplyConfiguration `json:"plan,omitempty"`
 }
 
 // KymaStatusApplyConfiguration constructs a declarative configuration of the KymaStatus type for use with
@@ -95,3 +98,11 @@ func (b *KymaStatusApplyConfiguration) WithActiveChannel(value string) *KymaStat
 	b.ActiveChannel = &value
 	return b
 }
+
+// WithPlan sets the Plan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Plan field is set to the value of the last call.
+func (b *KymaStatusApplyConfiguration) WithPlan(value *ReconcilePlanApplyConfiguration) *KymaStatusApplyConfiguration {
+	b.Plan = value
+	return b
+}
@@ -19,7 +19,9 @@ package v1beta2
 
 import (
 	apiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // ManifestSpecApplyConfiguration represents a declarative configuration of the ManifestSpec type for use
@@ -36,6 +38,9 @@ type ManifestSpecApplyConfiguration struct {
 	Config *ImageSpecApplyConfiguration `json:"config,omitempty"`
 	// Install specifies a list of installations for Manifest
 	Install *InstallInfoApplyConfiguration `json:"install,omitempty"`
+	// Values override the values used to render the Helm chart layer. They are merged on top of the values from
+	// the Config layer. For Manifests installing a raw manifest layer, they are ignored.
+	Values *runtime.RawExtension `json:"values,omitempty"`
 	// Resource specifies a resource to be watched for state updates
 	Resource *unstructured.Unstructured `json:"resource,omitempty"`
 	// LocalizedImages specifies a list of docker image references valid for the environment
@@ -44,8 +49,25 @@ type ManifestSpecApplyConfiguration struct {
 	// If provided, when the Kyma Module is installed in the target cluster,
 	// the "localized" image reference is used instead of the original one.
 	LocalizedImages []string `json:"localizedImages,omitempty"`
+	// ImageDigests specifies a list of docker image references of the Kyma module pinned to their digests,
+	// in the format <host[:port][/path]>/<image>:<tag>@<digest>.
+	// The list entries are taken from the OCM component descriptor of the module or resolved from the registry.
+	// If provided, the images in the K8s resources of the Kyma module matching an entry in name and tag
+	// are pinned to the digest of the entry.
+	ImageDigests []string `json:"imageDigests,omitempty"`
+	// Scheduling specifies the scheduling constraints taken from the Kyma, which are applied to the Pod templates
+	// in the K8s resources of the Kyma module.
+	Scheduling *SchedulingPolicyApplyConfiguration `json:"scheduling,omitempty"`
 	// Manager contains information for identifying a module's resource that can be used as indicator for the installation readiness of the module. Typically, this is the manager Deployment of the module. In exceptional cases, it may also be another resource.
 	Manager *ManagerApplyConfiguration `json:"manager,omitempty"`
+	// CustomStateCheck contains the rules of the ModuleTemplate used to derive the module state from the
+	// default Module CR, or from the manager resource if the Manifest has no default Module CR.
+	CustomStateCheck []*apiv1beta2.CustomStateCheck `json:"customStateCheck,omitempty"`
+	// AssociatedResources contains the GroupVersionKinds of module related resources taken over from the
+	// ModuleTemplate. The deletion of the Manifest is blocked as long as instances of these resources exist.
+	AssociatedResources []v1.GroupVersionKind `json:"associatedResources,omitempty"`
+	// DriftPolicy contains the drift rules of the ModuleTemplate followed by the ones of the module in the Kyma.
+	DriftPolicy []DriftRuleApplyConfiguration `json:"driftPolicy,omitempty"`
 }
 
 // ManifestSpecApplyConfiguration constructs a declarative configuration of the ManifestSpec type for use with
@@ -94,6 +116,14 @@ func (b *ManifestSpecApplyConfiguration) WithInstall(value *InstallInfoApplyConf
 	return b
 }
 
+// WithValues sets the Values field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Values field is set to the value of the last call.
+func (b *ManifestSpecApplyConfiguration) WithValues(value runtime.RawExtension) *ManifestSpecApplyConfiguration {
+	b.Values = &value
+	return b
+}
+
 // WithResource sets the Resource field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Resource field is set to the value of the last call.
@@ -112,6 +142,24 @@ func (b *ManifestSpecApplyConfiguration) WithLocalizedImages(values ...string) *
 	return b
 }
 
+// WithImageDigests adds the given value to the ImageDigests field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImageDigests field.
+func (b *ManifestSpecApplyConfiguration) WithImageDigests(values ...string) *ManifestSpecApplyConfiguration {
+	for i := range values {
+		b.ImageDigests = append(b.ImageDigests, values[i])
+	}
+	return b
+}
+
+// WithScheduling sets the Scheduling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scheduling field is set to the value of the last call.
+func (b *ManifestSpecApplyConfiguration) WithScheduling(value *SchedulingPolicyApplyConfiguration) *ManifestSpecApplyConfiguration {
+	b.Scheduling = value
+	return b
+}
+
 // WithManager sets the Manager field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Manager field is set to the value of the last call.
@@ -119,3 +167,39 @@ func (b *ManifestSpecApplyConfiguration) WithManager(value *ManagerApplyConfigur
 	b.Manager = value
 	return b
 }
+
+// WithCustomStateCheck adds the given value to the CustomStateCheck field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the CustomStateCheck field.
+func (b *ManifestSpecApplyConfiguration) WithCustomStateCheck(values ...**apiv1beta2.CustomStateCheck) *ManifestSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCustomStateCheck")
+		}
+		b.CustomStateCheck = append(b.CustomStateCheck, *values[i])
+	}
+	return b
+}
+
+// WithAssociatedResources adds the given value to the AssociatedResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AssociatedResources field.
+func (b *ManifestSpecApplyConfiguration) WithAssociatedResources(values ...v1.GroupVersionKind) *ManifestSpecApplyConfiguration {
+	for i := range values {
+		b.AssociatedResources = append(b.AssociatedResources, values[i])
+	}
+	return b
+}
+
+// WithDriftPolicy adds the given value to the DriftPolicy field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DriftPolicy field.
+func (b *ManifestSpecApplyConfiguration) WithDriftPolicy(values ...*DriftRuleApplyConfiguration) *ManifestSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDriftPolicy")
+		}
+		b.DriftPolicy = append(b.DriftPolicy, *values[i])
+	}
+	return b
+}
@@ -19,6 +19,7 @@ package v1beta2
 
 import (
 	apiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // ModuleApplyConfiguration represents a declarative configuration of the Module type for use
@@ -37,12 +38,26 @@ type ModuleApplyConfiguration struct {
 	// Channel is the desired channel of the Module. If this changes or is set, it will be used to resolve a new
 	// ModuleTemplate based on the new resolved resources.
 	Channel *string `json:"channel,omitempty"`
+	// Version is the desired version of the Module. If this changes or is set, it will be used to resolve a new
+	// ModuleTemplate based on this specific version. The version must be one of the versions assigned to a channel
+	// in the ModuleReleaseMeta of the Module. A Module pinned to a version does not follow channel updates and is
+	// reported with the "none" channel in the status.
+	// The Version and Channel are mutually exclusive options.
+	// The regular expression come from here:
+	// https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
+	Version *string `json:"version,omitempty"`
 	// RemoteModuleTemplateRef is deprecated and will no longer have any functionality.
 	// It will be removed in the upcoming API version.
 	RemoteModuleTemplateRef *string `json:"remoteModuleTemplateRef,omitempty"`
 	// Managed is determining whether the module is managed or not. If the module is unmanaged, the user is responsible
 	// for the lifecycle of the module.
 	Managed *bool `json:"managed,omitempty"`
+	// Values override the values used to render a Module that is shipped as a Helm chart. They are merged on top
+	// of the values from the config layer of the Module. For Modules shipped as raw manifests, they are ignored.
+	Values *runtime.RawExtension `json:"values,omitempty"`
+	// DriftPolicy is a list of rules for changes to the module resources in the cluster, for example to keep a
+	// tuned ConfigMap of the Module. The rules are applied after the ones of the ModuleTemplate.
+	DriftPolicy []DriftRuleApplyConfiguration `json:"driftPolicy,omitempty"`
 }
 
 // ModuleApplyConfiguration constructs a declarative configuration of the Module type for use with
@@ -83,6 +98,14 @@ func (b *ModuleApplyConfiguration) WithChannel(value string) *ModuleApplyConfigu
 	return b
 }
 
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ModuleApplyConfiguration) WithVersion(value string) *ModuleApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
 // WithRemoteModuleTemplateRef sets the RemoteModuleTemplateRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RemoteModuleTemplateRef field is set to the value of the last call.
@@ -98,3 +121,24 @@ func (b *ModuleApplyConfiguration) WithManaged(value bool) *ModuleApplyConfigura
 	b.Managed = &value
 	return b
 }
+
+// WithValues sets the Values field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Values field is set to the value of the last call.
+func (b *ModuleApplyConfiguration) WithValues(value runtime.RawExtension) *ModuleApplyConfiguration {
+	b.Values = &value
+	return b
+}
+
+// WithDriftPolicy adds the given value to the DriftPolicy field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DriftPolicy field.
+func (b *ModuleApplyConfiguration) WithDriftPolicy(values ...*DriftRuleApplyConfiguration) *ModuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDriftPolicy")
+		}
+		b.DriftPolicy = append(b.DriftPolicy, *values[i])
+	}
+	return b
+}
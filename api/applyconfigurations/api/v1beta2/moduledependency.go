@@ -0,0 +1,51 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ModuleDependencyApplyConfiguration represents a declarative configuration of the ModuleDependency type for use
+// with apply.
+//
+// ModuleDependency declares that a module requires another module.
+type ModuleDependencyApplyConfiguration struct {
+	// Name is the name of the required module.
+	Name *string `json:"name,omitempty"`
+	// Version is an optional semantic version constraint the required module must satisfy, e.g. ">=1.2.0 <2.0.0".
+	Version *string `json:"version,omitempty"`
+}
+
+// ModuleDependencyApplyConfiguration constructs a declarative configuration of the ModuleDependency type for use with
+// apply.
+func ModuleDependency() *ModuleDependencyApplyConfiguration {
+	return &ModuleDependencyApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ModuleDependencyApplyConfiguration) WithName(value string) *ModuleDependencyApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ModuleDependencyApplyConfiguration) WithVersion(value string) *ModuleDependencyApplyConfiguration {
+	b.Version = &value
+	return b
+}
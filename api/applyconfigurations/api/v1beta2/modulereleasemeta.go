@@ -34,7 +34,8 @@ import (
 type ModuleReleaseMetaApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *ModuleReleaseMetaSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *ModuleReleaseMetaSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *ModuleReleaseMetaStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // ModuleReleaseMeta constructs a declarative configuration of the ModuleReleaseMeta type for use with
@@ -83,6 +84,12 @@ func ExtractModuleReleaseMeta(moduleReleaseMeta *apiv1beta2.ModuleReleaseMeta, f
 	return ExtractModuleReleaseMetaFrom(moduleReleaseMeta, fieldManager, "")
 }
 
+// ExtractModuleReleaseMetaStatus extracts the applied configuration owned by fieldManager from
+// moduleReleaseMeta for the status subresource.
+func ExtractModuleReleaseMetaStatus(moduleReleaseMeta *apiv1beta2.ModuleReleaseMeta, fieldManager string) (*ModuleReleaseMetaApplyConfiguration, error) {
+	return ExtractModuleReleaseMetaFrom(moduleReleaseMeta, fieldManager, "status")
+}
+
 func (b ModuleReleaseMetaApplyConfiguration) IsApplyConfiguration() {}
 
 // WithKind sets the Kind field in the declarative configuration to the given value
@@ -251,6 +258,14 @@ func (b *ModuleReleaseMetaApplyConfiguration) WithSpec(value *ModuleReleaseMetaS
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ModuleReleaseMetaApplyConfiguration) WithStatus(value *ModuleReleaseMetaStatusApplyConfiguration) *ModuleReleaseMetaApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetKind retrieves the value of the Kind field in the declarative configuration.
 func (b *ModuleReleaseMetaApplyConfiguration) GetKind() *string {
 	return b.TypeMetaApplyConfiguration.Kind
@@ -35,6 +35,22 @@ type ModuleReleaseMetaSpecApplyConfiguration struct {
 	Channels []ChannelVersionAssignmentApplyConfiguration `json:"channels,omitempty"`
 	// Mandatory specifies a version for the mandatory module.
 	Mandatory *MandatoryApplyConfiguration `json:"mandatory,omitempty"`
+	// Rollbacks is a list of explicitly allowed downgrades of the module. By default, Lifecycle Manager does not
+	// install a version lower than the version installed in a Kyma runtime. To recover from a faulty release,
+	// assign the previous version to the channel again and add a rollback from the faulty version to it.
+	Rollbacks []RollbackApplyConfiguration `json:"rollbacks,omitempty"`
+	// Freeze stops all upgrades of the module during an incident. While frozen, Kymas keep the version of the module
+	// they run, and only Kymas that newly enable the module install the version assigned to their channel.
+	Freeze *FreezeApplyConfiguration `json:"freeze,omitempty"`
+	// Revocations is a list of module versions that must no longer run. Revoked versions are not installed, and
+	// Kymas running a revoked version are moved to its safe version if allowed, or report the module in the Warning
+	// state otherwise.
+	Revocations []RevocationApplyConfiguration `json:"revocations,omitempty"`
+	// Deprecation announces the removal of the module to the Kymas that use it.
+	Deprecation *DeprecationApplyConfiguration `json:"deprecation,omitempty"`
+	// Requires is a list of modules that must be enabled in the same Kyma runtime for any version of this module
+	// to be installed. The ModuleTemplate of a version can override single requirements.
+	Requires []ModuleDependencyApplyConfiguration `json:"requires,omitempty"`
 	// Beta indicates if the module is in beta state. Beta modules are only available for beta Kymas.
 	//
 	// Deprecated: This field is deprecated and will be removed in the upcoming API version.
@@ -90,6 +106,61 @@ func (b *ModuleReleaseMetaSpecApplyConfiguration) WithMandatory(value *Mandatory
 	return b
 }
 
+// WithRollbacks adds the given value to the Rollbacks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rollbacks field.
+func (b *ModuleReleaseMetaSpecApplyConfiguration) WithRollbacks(values ...*RollbackApplyConfiguration) *ModuleReleaseMetaSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRollbacks")
+		}
+		b.Rollbacks = append(b.Rollbacks, *values[i])
+	}
+	return b
+}
+
+// WithFreeze sets the Freeze field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Freeze field is set to the value of the last call.
+func (b *ModuleReleaseMetaSpecApplyConfiguration) WithFreeze(value *FreezeApplyConfiguration) *ModuleReleaseMetaSpecApplyConfiguration {
+	b.Freeze = value
+	return b
+}
+
+// WithRevocations adds the given value to the Revocations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Revocations field.
+func (b *ModuleReleaseMetaSpecApplyConfiguration) WithRevocations(values ...*RevocationApplyConfiguration) *ModuleReleaseMetaSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRevocations")
+		}
+		b.Revocations = append(b.Revocations, *values[i])
+	}
+	return b
+}
+
+// WithDeprecation sets the Deprecation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deprecation field is set to the value of the last call.
+func (b *ModuleReleaseMetaSpecApplyConfiguration) WithDeprecation(value *DeprecationApplyConfiguration) *ModuleReleaseMetaSpecApplyConfiguration {
+	b.Deprecation = value
+	return b
+}
+
+// WithRequires adds the given value to the Requires field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Requires field.
+func (b *ModuleReleaseMetaSpecApplyConfiguration) WithRequires(values ...*ModuleDependencyApplyConfiguration) *ModuleReleaseMetaSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRequires")
+		}
+		b.Requires = append(b.Requires, *values[i])
+	}
+	return b
+}
+
 // WithBeta sets the Beta field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Beta field is set to the value of the last call.
@@ -0,0 +1,46 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ModuleReleaseMetaStatusApplyConfiguration represents a declarative configuration of the ModuleReleaseMetaStatus type for use
+// with apply.
+//
+// ModuleReleaseMetaStatus defines the observed state of the ModuleReleaseMeta.
+type ModuleReleaseMetaStatusApplyConfiguration struct {
+	// Rollouts is the progress of the rollouts of the versions assigned to the channels with a rollout strategy.
+	Rollouts []ChannelRolloutApplyConfiguration `json:"rollouts,omitempty"`
+}
+
+// ModuleReleaseMetaStatusApplyConfiguration constructs a declarative configuration of the ModuleReleaseMetaStatus type for use with
+// apply.
+func ModuleReleaseMetaStatus() *ModuleReleaseMetaStatusApplyConfiguration {
+	return &ModuleReleaseMetaStatusApplyConfiguration{}
+}
+
+// WithRollouts adds the given value to the Rollouts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rollouts field.
+func (b *ModuleReleaseMetaStatusApplyConfiguration) WithRollouts(values ...*ChannelRolloutApplyConfiguration) *ModuleReleaseMetaStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRollouts")
+		}
+		b.Rollouts = append(b.Rollouts, *values[i])
+	}
+	return b
+}
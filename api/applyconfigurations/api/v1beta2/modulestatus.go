@@ -37,6 +37,9 @@ type ModuleStatusApplyConfiguration struct {
 	Channel *string `json:"channel,omitempty"`
 	// Channel tracks the active Version of the Module.
 	Version *string `json:"version,omitempty"`
+	// RolledBackFrom is the faulty version the module was rolled back from to the current Version.
+	// It is cleared once the module is updated to another version.
+	RolledBackFrom *string `json:"rolledBackFrom,omitempty"`
 	// Message is a human-readable message indicating details about the State.
 	Message *string `json:"message,omitempty"`
 	// State of the Module in the currently tracked Generation
@@ -98,6 +101,14 @@ func (b *ModuleStatusApplyConfiguration) WithVersion(value string) *ModuleStatus
 	return b
 }
 
+// WithRolledBackFrom sets the RolledBackFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RolledBackFrom field is set to the value of the last call.
+func (b *ModuleStatusApplyConfiguration) WithRolledBackFrom(value string) *ModuleStatusApplyConfiguration {
+	b.RolledBackFrom = &value
+	return b
+}
+
 // WithMessage sets the Message field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Message field is set to the value of the last call.
@@ -60,18 +60,28 @@ type ModuleTemplateSpecApplyConfiguration struct {
 	// NOTE: Only Raw Rendering is Supported for the layers. So previously used "config" layers for the helm
 	// charts and kustomize renderers are deprecated and ignored.
 	Descriptor *runtime.RawExtension `json:"descriptor,omitempty"`
-	// CustomStateCheck is deprecated.
+	// CustomStateCheck is a list of rules that map values found at a JSONPath of the default Module CR to a module
+	// state. It is meant for modules that report their health through custom fields (e.g. status conditions) instead
+	// of status.state. If no rule matches, the module is considered to be Processing.
 	CustomStateCheck []*apiv1beta2.CustomStateCheck `json:"customStateCheck,omitempty"`
 	// Resources is a list of additional resources of the module that can be fetched, e.g., the raw manifest.
 	Resources []ResourceApplyConfiguration `json:"resources,omitempty"`
 	// Info contains metadata about the module.
 	Info *ModuleInfoApplyConfiguration `json:"info,omitempty"`
-	// AssociatedResources is a list of module related resources that usually must be cleaned when uninstalling a module. Informational purpose only.
+	// AssociatedResources is a list of module related resources that usually must be cleaned when uninstalling a module.
+	// The module deletion is blocked as long as instances of these resources exist in the runtime cluster.
 	AssociatedResources []v1.GroupVersionKind `json:"associatedResources,omitempty"`
+	// Requires is a list of modules that must be enabled in the same Kyma runtime for this module version to be
+	// installed. The required modules are installed first, and they can not be removed as long as this module is
+	// enabled. Entries override the requirements of the same module declared in the ModuleReleaseMeta.
+	Requires []ModuleDependencyApplyConfiguration `json:"requires,omitempty"`
 	// Manager contains information for identifying a module's resource that can be used as indicator for the installation readiness of the module. Typically, this is the manager Deployment of the module. In exceptional cases, it may also be another resource.
 	Manager *ManagerApplyConfiguration `json:"manager,omitempty"`
 	// RequiresDowntime indicates whether the module requires downtime in support of maintenance windows during module upgrades.
 	RequiresDowntime *bool `json:"requiresDowntime,omitempty"`
+	// DriftPolicy is a list of rules for changes to the module resources in the runtime cluster, for example to keep
+	// the replicas of a Deployment scaled by a HorizontalPodAutoscaler. By default, all changes are reverted.
+	DriftPolicy []DriftRuleApplyConfiguration `json:"driftPolicy,omitempty"`
 }
 
 // ModuleTemplateSpecApplyConfiguration constructs a declarative configuration of the ModuleTemplateSpec type for use with
@@ -172,6 +182,19 @@ func (b *ModuleTemplateSpecApplyConfiguration) WithAssociatedResources(values ..
 	return b
 }
 
+// WithRequires adds the given value to the Requires field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Requires field.
+func (b *ModuleTemplateSpecApplyConfiguration) WithRequires(values ...*ModuleDependencyApplyConfiguration) *ModuleTemplateSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRequires")
+		}
+		b.Requires = append(b.Requires, *values[i])
+	}
+	return b
+}
+
 // WithManager sets the Manager field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Manager field is set to the value of the last call.
@@ -187,3 +210,16 @@ func (b *ModuleTemplateSpecApplyConfiguration) WithRequiresDowntime(value bool)
 	b.RequiresDowntime = &value
 	return b
 }
+
+// WithDriftPolicy adds the given value to the DriftPolicy field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DriftPolicy field.
+func (b *ModuleTemplateSpecApplyConfiguration) WithDriftPolicy(values ...*DriftRuleApplyConfiguration) *ModuleTemplateSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDriftPolicy")
+		}
+		b.DriftPolicy = append(b.DriftPolicy, *values[i])
+	}
+	return b
+}
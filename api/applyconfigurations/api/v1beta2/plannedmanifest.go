@@ -0,0 +1,129 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	shared "github.com/kyma-project/lifecycle-manager/api/shared"
+	apiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// PlannedManifestApplyConfiguration represents a declarative configuration of the PlannedManifest type for use
+// with apply.
+//
+// PlannedManifest describes a change to the Manifest of a module.
+type PlannedManifestApplyConfiguration struct {
+	// Module is the name of the module.
+	Module *string `json:"module,omitempty"`
+	// Name is the name of the Manifest.
+	Name *string `json:"name,omitempty"`
+	// Action is the change that would be applied to the Manifest.
+	Action *apiv1beta2.PlanAction `json:"action,omitempty"`
+	// FromVersion is the version of the module currently installed.
+	FromVersion *string `json:"fromVersion,omitempty"`
+	// ToVersion is the version of the module that would be installed.
+	ToVersion *string `json:"toVersion,omitempty"`
+	// FromOCIRef is the reference of the installation layer currently installed.
+	FromOCIRef *string `json:"fromOCIRef,omitempty"`
+	// ToOCIRef is the reference of the installation layer that would be installed.
+	ToOCIRef *string `json:"toOCIRef,omitempty"`
+	// PrunedResources lists the resources that would be removed from the remote cluster.
+	PrunedResources []shared.Resource `json:"prunedResources,omitempty"`
+	// Message explains why the planned change is incomplete, for example if the pruned resources
+	// could not be determined.
+	Message *string `json:"message,omitempty"`
+}
+
+// PlannedManifestApplyConfiguration constructs a declarative configuration of the PlannedManifest type for use with
+// apply.
+func PlannedManifest() *PlannedManifestApplyConfiguration {
+	return &PlannedManifestApplyConfiguration{}
+}
+
+// WithModule sets the Module field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Module field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithModule(value string) *PlannedManifestApplyConfiguration {
+	b.Module = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithName(value string) *PlannedManifestApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithAction(value apiv1beta2.PlanAction) *PlannedManifestApplyConfiguration {
+	b.Action = &value
+	return b
+}
+
+// WithFromVersion sets the FromVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FromVersion field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithFromVersion(value string) *PlannedManifestApplyConfiguration {
+	b.FromVersion = &value
+	return b
+}
+
+// WithToVersion sets the ToVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ToVersion field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithToVersion(value string) *PlannedManifestApplyConfiguration {
+	b.ToVersion = &value
+	return b
+}
+
+// WithFromOCIRef sets the FromOCIRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FromOCIRef field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithFromOCIRef(value string) *PlannedManifestApplyConfiguration {
+	b.FromOCIRef = &value
+	return b
+}
+
+// WithToOCIRef sets the ToOCIRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ToOCIRef field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithToOCIRef(value string) *PlannedManifestApplyConfiguration {
+	b.ToOCIRef = &value
+	return b
+}
+
+// WithPrunedResources adds the given value to the PrunedResources field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PrunedResources field.
+func (b *PlannedManifestApplyConfiguration) WithPrunedResources(values ...shared.Resource) *PlannedManifestApplyConfiguration {
+	for i := range values {
+		b.PrunedResources = append(b.PrunedResources, values[i])
+	}
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *PlannedManifestApplyConfiguration) WithMessage(value string) *PlannedManifestApplyConfiguration {
+	b.Message = &value
+	return b
+}
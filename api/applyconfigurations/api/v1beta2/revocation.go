@@ -0,0 +1,63 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// RevocationApplyConfiguration represents a declarative configuration of the Revocation type for use
+// with apply.
+//
+// Revocation defines a module version that must no longer run.
+type RevocationApplyConfiguration struct {
+	// Version is the revoked module version.
+	Version *string `json:"version,omitempty"`
+	// Reason explains why the version is revoked.
+	Reason *string `json:"reason,omitempty"`
+	// SafeVersion is the version that Kymas running the revoked version are moved to. A safe version lower than
+	// the revoked version is only installed if the rollback from the revoked version to it is listed in the
+	// rollbacks.
+	SafeVersion *string `json:"safeVersion,omitempty"`
+}
+
+// RevocationApplyConfiguration constructs a declarative configuration of the Revocation type for use with
+// apply.
+func Revocation() *RevocationApplyConfiguration {
+	return &RevocationApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *RevocationApplyConfiguration) WithVersion(value string) *RevocationApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *RevocationApplyConfiguration) WithReason(value string) *RevocationApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithSafeVersion sets the SafeVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SafeVersion field is set to the value of the last call.
+func (b *RevocationApplyConfiguration) WithSafeVersion(value string) *RevocationApplyConfiguration {
+	b.SafeVersion = &value
+	return b
+}
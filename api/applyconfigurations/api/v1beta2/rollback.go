@@ -0,0 +1,51 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// RollbackApplyConfiguration represents a declarative configuration of the Rollback type for use
+// with apply.
+//
+// Rollback allows downgrading a module from a faulty version to a lower version.
+type RollbackApplyConfiguration struct {
+	// FromVersion is the faulty version that may be rolled back.
+	FromVersion *string `json:"fromVersion,omitempty"`
+	// ToVersion is the lower version the module is rolled back to.
+	ToVersion *string `json:"toVersion,omitempty"`
+}
+
+// RollbackApplyConfiguration constructs a declarative configuration of the Rollback type for use with
+// apply.
+func Rollback() *RollbackApplyConfiguration {
+	return &RollbackApplyConfiguration{}
+}
+
+// WithFromVersion sets the FromVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FromVersion field is set to the value of the last call.
+func (b *RollbackApplyConfiguration) WithFromVersion(value string) *RollbackApplyConfiguration {
+	b.FromVersion = &value
+	return b
+}
+
+// WithToVersion sets the ToVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ToVersion field is set to the value of the last call.
+func (b *RollbackApplyConfiguration) WithToVersion(value string) *RollbackApplyConfiguration {
+	b.ToVersion = &value
+	return b
+}
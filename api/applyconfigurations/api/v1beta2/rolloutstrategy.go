@@ -0,0 +1,72 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutStrategyApplyConfiguration represents a declarative configuration of the RolloutStrategy type for use
+// with apply.
+//
+// RolloutStrategy rolls out a new version of a channel to the Kymas in ordered waves. Each wave is rolled out once
+// the previous wave has soaked for the soak time without too many errors. Kymas in no wave are rolled out to last.
+type RolloutStrategyApplyConfiguration struct {
+	// Waves are the ordered waves of Kymas. A Kyma belongs to the first wave it matches.
+	Waves []RolloutWaveApplyConfiguration `json:"waves,omitempty"`
+	// SoakTime is the time a wave runs the new version before the next wave is rolled out to.
+	SoakTime *v1.Duration `json:"soakTime,omitempty"`
+	// MaxErrorPercentage is the percentage of the Kymas running the new version in which the module may be in the
+	// Error state. Above it, the rollout is paused until enough modules recover.
+	MaxErrorPercentage *int `json:"maxErrorPercentage,omitempty"`
+}
+
+// RolloutStrategyApplyConfiguration constructs a declarative configuration of the RolloutStrategy type for use with
+// apply.
+func RolloutStrategy() *RolloutStrategyApplyConfiguration {
+	return &RolloutStrategyApplyConfiguration{}
+}
+
+// WithWaves adds the given value to the Waves field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Waves field.
+func (b *RolloutStrategyApplyConfiguration) WithWaves(values ...*RolloutWaveApplyConfiguration) *RolloutStrategyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWaves")
+		}
+		b.Waves = append(b.Waves, *values[i])
+	}
+	return b
+}
+
+// WithSoakTime sets the SoakTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SoakTime field is set to the value of the last call.
+func (b *RolloutStrategyApplyConfiguration) WithSoakTime(value v1.Duration) *RolloutStrategyApplyConfiguration {
+	b.SoakTime = &value
+	return b
+}
+
+// WithMaxErrorPercentage sets the MaxErrorPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxErrorPercentage field is set to the value of the last call.
+func (b *RolloutStrategyApplyConfiguration) WithMaxErrorPercentage(value int) *RolloutStrategyApplyConfiguration {
+	b.MaxErrorPercentage = &value
+	return b
+}
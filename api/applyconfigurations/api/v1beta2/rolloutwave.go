@@ -0,0 +1,67 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// RolloutWaveApplyConfiguration represents a declarative configuration of the RolloutWave type for use
+// with apply.
+//
+// RolloutWave selects the Kymas of a wave by their labels or as a share of all Kymas.
+type RolloutWaveApplyConfiguration struct {
+	// Name is the name of the wave.
+	Name *string `json:"name,omitempty"`
+	// KymaSelector selects the Kymas of the wave by their labels.
+	KymaSelector *v1.LabelSelectorApplyConfiguration `json:"kymaSelector,omitempty"`
+	// Percentage selects the given share of all Kymas, including the ones of previous waves, by a stable hash of
+	// the Kyma name. For example, waves with 10 and 50 percent roll out to 10 percent of the Kymas first and to
+	// another 40 percent next.
+	Percentage *int `json:"percentage,omitempty"`
+}
+
+// RolloutWaveApplyConfiguration constructs a declarative configuration of the RolloutWave type for use with
+// apply.
+func RolloutWave() *RolloutWaveApplyConfiguration {
+	return &RolloutWaveApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RolloutWaveApplyConfiguration) WithName(value string) *RolloutWaveApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithKymaSelector sets the KymaSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KymaSelector field is set to the value of the last call.
+func (b *RolloutWaveApplyConfiguration) WithKymaSelector(value *v1.LabelSelectorApplyConfiguration) *RolloutWaveApplyConfiguration {
+	b.KymaSelector = value
+	return b
+}
+
+// WithPercentage sets the Percentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Percentage field is set to the value of the last call.
+func (b *RolloutWaveApplyConfiguration) WithPercentage(value int) *RolloutWaveApplyConfiguration {
+	b.Percentage = &value
+	return b
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SchedulingPolicyApplyConfiguration represents a declarative configuration of the SchedulingPolicy type for use
+// with apply.
+//
+// SchedulingPolicy defines the scheduling constraints applied to the Pod templates of the module resources.
+type SchedulingPolicyApplyConfiguration struct {
+	// Tolerations are added to the tolerations of the Pods.
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// NodeSelector is merged into the node selector of the Pods, overriding entries with the same key.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Affinity replaces the node affinity, pod affinity and pod anti-affinity of the Pods, where set.
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// PriorityClassName replaces the priority class of the Pods.
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// TopologySpreadConstraints replace the constraints of the Pods with the same topology key and
+	// whenUnsatisfiable action and are added otherwise. A constraint without a label selector selects the Pods of
+	// the workload it is applied to.
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// SchedulingPolicyApplyConfiguration constructs a declarative configuration of the SchedulingPolicy type for use with
+// apply.
+func SchedulingPolicy() *SchedulingPolicyApplyConfiguration {
+	return &SchedulingPolicyApplyConfiguration{}
+}
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *SchedulingPolicyApplyConfiguration) WithTolerations(values ...v1.Toleration) *SchedulingPolicyApplyConfiguration {
+	for i := range values {
+		b.Tolerations = append(b.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the NodeSelector field,
+// overwriting an existing map entries in NodeSelector field with the same key.
+func (b *SchedulingPolicyApplyConfiguration) WithNodeSelector(entries map[string]string) *SchedulingPolicyApplyConfiguration {
+	if b.NodeSelector == nil && len(entries) > 0 {
+		b.NodeSelector = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.NodeSelector[k] = v
+	}
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *SchedulingPolicyApplyConfiguration) WithAffinity(value v1.Affinity) *SchedulingPolicyApplyConfiguration {
+	b.Affinity = &value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *SchedulingPolicyApplyConfiguration) WithPriorityClassName(value string) *SchedulingPolicyApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *SchedulingPolicyApplyConfiguration) WithTopologySpreadConstraints(values ...v1.TopologySpreadConstraint) *SchedulingPolicyApplyConfiguration {
+	for i := range values {
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, values[i])
+	}
+	return b
+}
@@ -83,6 +83,34 @@ var schemaYAML = typed.YAMLObject(`types:
                       type:
                         scalar: string
                       default: CreateAndDelete
+                    - name: driftPolicy
+                      type:
+                        list:
+                          elementType:
+                            map:
+                              fields:
+                              - name: action
+                                type:
+                                  scalar: string
+                              - name: group
+                                type:
+                                  scalar: string
+                              - name: kind
+                                type:
+                                  scalar: string
+                              - name: name
+                                type:
+                                  scalar: string
+                              - name: namespace
+                                type:
+                                  scalar: string
+                              - name: paths
+                                type:
+                                  list:
+                                    elementType:
+                                      scalar: string
+                                    elementRelationship: atomic
+                          elementRelationship: atomic
                     - name: managed
                       type:
                         scalar: boolean
@@ -93,9 +121,597 @@ var schemaYAML = typed.YAMLObject(`types:
                     - name: remoteModuleTemplateRef
                       type:
                         scalar: string
+                    - name: values
+                      type:
+                        map:
+                          elementType:
+                            scalar: untyped
+                            list:
+                              elementType:
+                                namedType: __untyped_atomic_
+                              elementRelationship: atomic
+                            map:
+                              elementType:
+                                namedType: __untyped_deduced_
+                              elementRelationship: separable
+                    - name: version
+                      type:
+                        scalar: string
                 elementRelationship: associative
                 keys:
                 - name
+          - name: scheduling
+            type:
+              map:
+                fields:
+                - name: affinity
+                  type:
+                    map:
+                      fields:
+                      - name: nodeAffinity
+                        type:
+                          map:
+                            fields:
+                            - name: preferredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: preference
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchFields
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            elementRelationship: atomic
+                                      - name: weight
+                                        type:
+                                          scalar: numeric
+                                  elementRelationship: atomic
+                            - name: requiredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                map:
+                                  fields:
+                                  - name: nodeSelectorTerms
+                                    type:
+                                      list:
+                                        elementType:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchFields
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            elementRelationship: atomic
+                                        elementRelationship: atomic
+                                  elementRelationship: atomic
+                      - name: podAffinity
+                        type:
+                          map:
+                            fields:
+                            - name: preferredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: podAffinityTerm
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: labelSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: matchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: mismatchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaceSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaces
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: topologyKey
+                                              type:
+                                                scalar: string
+                                      - name: weight
+                                        type:
+                                          scalar: numeric
+                                  elementRelationship: atomic
+                            - name: requiredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: labelSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: matchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: mismatchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaceSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaces
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: topologyKey
+                                        type:
+                                          scalar: string
+                                  elementRelationship: atomic
+                      - name: podAntiAffinity
+                        type:
+                          map:
+                            fields:
+                            - name: preferredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: podAffinityTerm
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: labelSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: matchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: mismatchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaceSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaces
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: topologyKey
+                                              type:
+                                                scalar: string
+                                      - name: weight
+                                        type:
+                                          scalar: numeric
+                                  elementRelationship: atomic
+                            - name: requiredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: labelSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: matchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: mismatchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaceSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaces
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: topologyKey
+                                        type:
+                                          scalar: string
+                                  elementRelationship: atomic
+                - name: nodeSelector
+                  type:
+                    map:
+                      elementType:
+                        scalar: string
+                - name: priorityClassName
+                  type:
+                    scalar: string
+                - name: tolerations
+                  type:
+                    list:
+                      elementType:
+                        map:
+                          fields:
+                          - name: effect
+                            type:
+                              scalar: string
+                          - name: key
+                            type:
+                              scalar: string
+                          - name: operator
+                            type:
+                              scalar: string
+                          - name: tolerationSeconds
+                            type:
+                              scalar: numeric
+                          - name: value
+                            type:
+                              scalar: string
+                      elementRelationship: atomic
+                - name: topologySpreadConstraints
+                  type:
+                    list:
+                      elementType:
+                        map:
+                          fields:
+                          - name: labelSelector
+                            type:
+                              map:
+                                fields:
+                                - name: matchExpressions
+                                  type:
+                                    list:
+                                      elementType:
+                                        map:
+                                          fields:
+                                          - name: key
+                                            type:
+                                              scalar: string
+                                          - name: operator
+                                            type:
+                                              scalar: string
+                                          - name: values
+                                            type:
+                                              list:
+                                                elementType:
+                                                  scalar: string
+                                                elementRelationship: atomic
+                                      elementRelationship: atomic
+                                - name: matchLabels
+                                  type:
+                                    map:
+                                      elementType:
+                                        scalar: string
+                                elementRelationship: atomic
+                          - name: matchLabelKeys
+                            type:
+                              list:
+                                elementType:
+                                  scalar: string
+                                elementRelationship: atomic
+                          - name: maxSkew
+                            type:
+                              scalar: numeric
+                          - name: minDomains
+                            type:
+                              scalar: numeric
+                          - name: nodeAffinityPolicy
+                            type:
+                              scalar: string
+                          - name: nodeTaintsPolicy
+                            type:
+                              scalar: string
+                          - name: topologyKey
+                            type:
+                              scalar: string
+                          - name: whenUnsatisfiable
+                            type:
+                              scalar: string
+                      elementRelationship: atomic
           - name: skipMaintenanceWindows
             type:
               scalar: boolean
@@ -214,6 +830,9 @@ var schemaYAML = typed.YAMLObject(`types:
                                 - name: namespace
                                   type:
                                     scalar: string
+                    - name: rolledBackFrom
+                      type:
+                        scalar: string
                     - name: state
                       type:
                         scalar: string
@@ -244,6 +863,63 @@ var schemaYAML = typed.YAMLObject(`types:
                       type:
                         scalar: string
                 elementRelationship: atomic
+          - name: plan
+            type:
+              map:
+                fields:
+                - name: manifests
+                  type:
+                    list:
+                      elementType:
+                        map:
+                          fields:
+                          - name: action
+                            type:
+                              scalar: string
+                          - name: fromOCIRef
+                            type:
+                              scalar: string
+                          - name: fromVersion
+                            type:
+                              scalar: string
+                          - name: message
+                            type:
+                              scalar: string
+                          - name: module
+                            type:
+                              scalar: string
+                          - name: name
+                            type:
+                              scalar: string
+                          - name: prunedResources
+                            type:
+                              list:
+                                elementType:
+                                  map:
+                                    fields:
+                                    - name: group
+                                      type:
+                                        scalar: string
+                                    - name: kind
+                                      type:
+                                        scalar: string
+                                    - name: name
+                                      type:
+                                        scalar: string
+                                    - name: namespace
+                                      type:
+                                        scalar: string
+                                    - name: version
+                                      type:
+                                        scalar: string
+                                elementRelationship: atomic
+                          - name: toOCIRef
+                            type:
+                              scalar: string
+                          - name: toVersion
+                            type:
+                              scalar: string
+                      elementRelationship: atomic
           - name: state
             type:
               scalar: string
@@ -273,6 +949,22 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         map:
           fields:
+          - name: associatedResources
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: group
+                      type:
+                        scalar: string
+                    - name: kind
+                      type:
+                        scalar: string
+                    - name: version
+                      type:
+                        scalar: string
+                elementRelationship: atomic
           - name: config
             type:
               map:
@@ -322,6 +1014,56 @@ var schemaYAML = typed.YAMLObject(`types:
             type:
               scalar: string
             default: CreateAndDelete
+          - name: customStateCheck
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: jsonPath
+                      type:
+                        scalar: string
+                    - name: mappedState
+                      type:
+                        scalar: string
+                    - name: value
+                      type:
+                        scalar: string
+                elementRelationship: atomic
+          - name: driftPolicy
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: action
+                      type:
+                        scalar: string
+                    - name: group
+                      type:
+                        scalar: string
+                    - name: kind
+                      type:
+                        scalar: string
+                    - name: name
+                      type:
+                        scalar: string
+                    - name: namespace
+                      type:
+                        scalar: string
+                    - name: paths
+                      type:
+                        list:
+                          elementType:
+                            scalar: string
+                          elementRelationship: atomic
+                elementRelationship: atomic
+          - name: imageDigests
+            type:
+              list:
+                elementType:
+                  scalar: string
+                elementRelationship: atomic
           - name: install
             type:
               map:
@@ -383,6 +1125,591 @@ var schemaYAML = typed.YAMLObject(`types:
                     elementType:
                       namedType: __untyped_deduced_
                     elementRelationship: separable
+          - name: scheduling
+            type:
+              map:
+                fields:
+                - name: affinity
+                  type:
+                    map:
+                      fields:
+                      - name: nodeAffinity
+                        type:
+                          map:
+                            fields:
+                            - name: preferredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: preference
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchFields
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            elementRelationship: atomic
+                                      - name: weight
+                                        type:
+                                          scalar: numeric
+                                  elementRelationship: atomic
+                            - name: requiredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                map:
+                                  fields:
+                                  - name: nodeSelectorTerms
+                                    type:
+                                      list:
+                                        elementType:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchFields
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            elementRelationship: atomic
+                                        elementRelationship: atomic
+                                  elementRelationship: atomic
+                      - name: podAffinity
+                        type:
+                          map:
+                            fields:
+                            - name: preferredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: podAffinityTerm
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: labelSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: matchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: mismatchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaceSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaces
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: topologyKey
+                                              type:
+                                                scalar: string
+                                      - name: weight
+                                        type:
+                                          scalar: numeric
+                                  elementRelationship: atomic
+                            - name: requiredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: labelSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: matchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: mismatchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaceSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaces
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: topologyKey
+                                        type:
+                                          scalar: string
+                                  elementRelationship: atomic
+                      - name: podAntiAffinity
+                        type:
+                          map:
+                            fields:
+                            - name: preferredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: podAffinityTerm
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: labelSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: matchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: mismatchLabelKeys
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaceSelector
+                                              type:
+                                                map:
+                                                  fields:
+                                                  - name: matchExpressions
+                                                    type:
+                                                      list:
+                                                        elementType:
+                                                          map:
+                                                            fields:
+                                                            - name: key
+                                                              type:
+                                                                scalar: string
+                                                            - name: operator
+                                                              type:
+                                                                scalar: string
+                                                            - name: values
+                                                              type:
+                                                                list:
+                                                                  elementType:
+                                                                    scalar: string
+                                                                  elementRelationship: atomic
+                                                        elementRelationship: atomic
+                                                  - name: matchLabels
+                                                    type:
+                                                      map:
+                                                        elementType:
+                                                          scalar: string
+                                                  elementRelationship: atomic
+                                            - name: namespaces
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    scalar: string
+                                                  elementRelationship: atomic
+                                            - name: topologyKey
+                                              type:
+                                                scalar: string
+                                      - name: weight
+                                        type:
+                                          scalar: numeric
+                                  elementRelationship: atomic
+                            - name: requiredDuringSchedulingIgnoredDuringExecution
+                              type:
+                                list:
+                                  elementType:
+                                    map:
+                                      fields:
+                                      - name: labelSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: matchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: mismatchLabelKeys
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaceSelector
+                                        type:
+                                          map:
+                                            fields:
+                                            - name: matchExpressions
+                                              type:
+                                                list:
+                                                  elementType:
+                                                    map:
+                                                      fields:
+                                                      - name: key
+                                                        type:
+                                                          scalar: string
+                                                      - name: operator
+                                                        type:
+                                                          scalar: string
+                                                      - name: values
+                                                        type:
+                                                          list:
+                                                            elementType:
+                                                              scalar: string
+                                                            elementRelationship: atomic
+                                                  elementRelationship: atomic
+                                            - name: matchLabels
+                                              type:
+                                                map:
+                                                  elementType:
+                                                    scalar: string
+                                            elementRelationship: atomic
+                                      - name: namespaces
+                                        type:
+                                          list:
+                                            elementType:
+                                              scalar: string
+                                            elementRelationship: atomic
+                                      - name: topologyKey
+                                        type:
+                                          scalar: string
+                                  elementRelationship: atomic
+                - name: nodeSelector
+                  type:
+                    map:
+                      elementType:
+                        scalar: string
+                - name: priorityClassName
+                  type:
+                    scalar: string
+                - name: tolerations
+                  type:
+                    list:
+                      elementType:
+                        map:
+                          fields:
+                          - name: effect
+                            type:
+                              scalar: string
+                          - name: key
+                            type:
+                              scalar: string
+                          - name: operator
+                            type:
+                              scalar: string
+                          - name: tolerationSeconds
+                            type:
+                              scalar: numeric
+                          - name: value
+                            type:
+                              scalar: string
+                      elementRelationship: atomic
+                - name: topologySpreadConstraints
+                  type:
+                    list:
+                      elementType:
+                        map:
+                          fields:
+                          - name: labelSelector
+                            type:
+                              map:
+                                fields:
+                                - name: matchExpressions
+                                  type:
+                                    list:
+                                      elementType:
+                                        map:
+                                          fields:
+                                          - name: key
+                                            type:
+                                              scalar: string
+                                          - name: operator
+                                            type:
+                                              scalar: string
+                                          - name: values
+                                            type:
+                                              list:
+                                                elementType:
+                                                  scalar: string
+                                                elementRelationship: atomic
+                                      elementRelationship: atomic
+                                - name: matchLabels
+                                  type:
+                                    map:
+                                      elementType:
+                                        scalar: string
+                                elementRelationship: atomic
+                          - name: matchLabelKeys
+                            type:
+                              list:
+                                elementType:
+                                  scalar: string
+                                elementRelationship: atomic
+                          - name: maxSkew
+                            type:
+                              scalar: numeric
+                          - name: minDomains
+                            type:
+                              scalar: numeric
+                          - name: nodeAffinityPolicy
+                            type:
+                              scalar: string
+                          - name: nodeTaintsPolicy
+                            type:
+                              scalar: string
+                          - name: topologyKey
+                            type:
+                              scalar: string
+                          - name: whenUnsatisfiable
+                            type:
+                              scalar: string
+                      elementRelationship: atomic
+          - name: values
+            type:
+              map:
+                elementType:
+                  scalar: untyped
+                  list:
+                    elementType:
+                      namedType: __untyped_atomic_
+                    elementRelationship: atomic
+                  map:
+                    elementType:
+                      namedType: __untyped_deduced_
+                    elementRelationship: separable
           - name: version
             type:
               scalar: string
@@ -417,6 +1744,71 @@ var schemaYAML = typed.YAMLObject(`types:
                 elementRelationship: associative
                 keys:
                 - type
+          - name: drift
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: fields
+                      type:
+                        list:
+                          elementType:
+                            scalar: string
+                          elementRelationship: atomic
+                    - name: group
+                      type:
+                        scalar: string
+                    - name: kind
+                      type:
+                        scalar: string
+                    - name: manager
+                      type:
+                        scalar: string
+                    - name: name
+                      type:
+                        scalar: string
+                    - name: namespace
+                      type:
+                        scalar: string
+                    - name: operation
+                      type:
+                        scalar: string
+                    - name: reverted
+                      type:
+                        scalar: boolean
+                    - name: version
+                      type:
+                        scalar: string
+                elementRelationship: atomic
+          - name: health
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: group
+                      type:
+                        scalar: string
+                    - name: kind
+                      type:
+                        scalar: string
+                    - name: message
+                      type:
+                        scalar: string
+                    - name: name
+                      type:
+                        scalar: string
+                    - name: namespace
+                      type:
+                        scalar: string
+                    - name: state
+                      type:
+                        scalar: string
+                    - name: version
+                      type:
+                        scalar: string
+                elementRelationship: atomic
           - name: lastOperation
             type:
               map:
@@ -491,12 +1883,101 @@ var schemaYAML = typed.YAMLObject(`types:
                     - name: channel
                       type:
                         scalar: string
+                    - name: deprecation
+                      type:
+                        map:
+                          fields:
+                          - name: deprecated
+                            type:
+                              scalar: boolean
+                          - name: replacement
+                            type:
+                              scalar: string
+                          - name: sunsetDate
+                            type:
+                              scalar: untyped
+                    - name: rollout
+                      type:
+                        map:
+                          fields:
+                          - name: maxErrorPercentage
+                            type:
+                              scalar: numeric
+                            default: 10
+                          - name: soakTime
+                            type:
+                              scalar: string
+                            default: 1h
+                          - name: waves
+                            type:
+                              list:
+                                elementType:
+                                  map:
+                                    fields:
+                                    - name: kymaSelector
+                                      type:
+                                        map:
+                                          fields:
+                                          - name: matchExpressions
+                                            type:
+                                              list:
+                                                elementType:
+                                                  map:
+                                                    fields:
+                                                    - name: key
+                                                      type:
+                                                        scalar: string
+                                                    - name: operator
+                                                      type:
+                                                        scalar: string
+                                                    - name: values
+                                                      type:
+                                                        list:
+                                                          elementType:
+                                                            scalar: string
+                                                          elementRelationship: atomic
+                                                elementRelationship: atomic
+                                          - name: matchLabels
+                                            type:
+                                              map:
+                                                elementType:
+                                                  scalar: string
+                                          elementRelationship: atomic
+                                    - name: name
+                                      type:
+                                        scalar: string
+                                    - name: percentage
+                                      type:
+                                        scalar: numeric
+                                elementRelationship: associative
+                                keys:
+                                - name
                     - name: version
                       type:
                         scalar: string
                 elementRelationship: associative
                 keys:
                 - channel
+          - name: deprecation
+            type:
+              map:
+                fields:
+                - name: deprecated
+                  type:
+                    scalar: boolean
+                - name: replacement
+                  type:
+                    scalar: string
+                - name: sunsetDate
+                  type:
+                    scalar: untyped
+          - name: freeze
+            type:
+              map:
+                fields:
+                - name: reason
+                  type:
+                    scalar: string
           - name: internal
             type:
               scalar: boolean
@@ -543,6 +2024,94 @@ var schemaYAML = typed.YAMLObject(`types:
           - name: ocmComponentName
             type:
               scalar: string
+          - name: requires
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: name
+                      type:
+                        scalar: string
+                    - name: version
+                      type:
+                        scalar: string
+                elementRelationship: associative
+                keys:
+                - name
+          - name: revocations
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: reason
+                      type:
+                        scalar: string
+                    - name: safeVersion
+                      type:
+                        scalar: string
+                    - name: version
+                      type:
+                        scalar: string
+                elementRelationship: associative
+                keys:
+                - version
+          - name: rollbacks
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: fromVersion
+                      type:
+                        scalar: string
+                    - name: toVersion
+                      type:
+                        scalar: string
+                elementRelationship: associative
+                keys:
+                - fromVersion
+    - name: status
+      type:
+        map:
+          fields:
+          - name: rollouts
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: channel
+                      type:
+                        scalar: string
+                    - name: failedKymas
+                      type:
+                        scalar: numeric
+                    - name: message
+                      type:
+                        scalar: string
+                    - name: previousVersion
+                      type:
+                        scalar: string
+                    - name: state
+                      type:
+                        scalar: string
+                    - name: upgradedKymas
+                      type:
+                        scalar: numeric
+                    - name: version
+                      type:
+                        scalar: string
+                    - name: wave
+                      type:
+                        scalar: numeric
+                    - name: waveStartTime
+                      type:
+                        scalar: untyped
+                elementRelationship: associative
+                keys:
+                - channel
 - name: com.github.kyma-project.lifecycle-manager.api.v1beta2.ModuleTemplate
   map:
     fields:
@@ -630,6 +2199,34 @@ var schemaYAML = typed.YAMLObject(`types:
                     elementType:
                       namedType: __untyped_deduced_
                     elementRelationship: separable
+          - name: driftPolicy
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: action
+                      type:
+                        scalar: string
+                    - name: group
+                      type:
+                        scalar: string
+                    - name: kind
+                      type:
+                        scalar: string
+                    - name: name
+                      type:
+                        scalar: string
+                    - name: namespace
+                      type:
+                        scalar: string
+                    - name: paths
+                      type:
+                        list:
+                          elementType:
+                            scalar: string
+                          elementRelationship: atomic
+                elementRelationship: atomic
           - name: info
             type:
               map:
@@ -680,6 +2277,21 @@ var schemaYAML = typed.YAMLObject(`types:
           - name: moduleName
             type:
               scalar: string
+          - name: requires
+            type:
+              list:
+                elementType:
+                  map:
+                    fields:
+                    - name: name
+                      type:
+                        scalar: string
+                    - name: version
+                      type:
+                        scalar: string
+                elementRelationship: associative
+                keys:
+                - name
           - name: requiresDowntime
             type:
               scalar: boolean
@@ -31,10 +31,18 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=operator.kyma-project.io, Version=v1beta2
+	case v1beta2.SchemeGroupVersion.WithKind("ChannelRollout"):
+		return &apiv1beta2.ChannelRolloutApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ChannelVersionAssignment"):
 		return &apiv1beta2.ChannelVersionAssignmentApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("CustomStateCheck"):
 		return &apiv1beta2.CustomStateCheckApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Deprecation"):
+		return &apiv1beta2.DeprecationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("DriftRule"):
+		return &apiv1beta2.DriftRuleApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Freeze"):
+		return &apiv1beta2.FreezeApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("GatewayConfig"):
 		return &apiv1beta2.GatewayConfigApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ImageSpec"):
@@ -57,6 +65,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.ManifestSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Module"):
 		return &apiv1beta2.ModuleApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ModuleDependency"):
+		return &apiv1beta2.ModuleDependencyApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ModuleIcon"):
 		return &apiv1beta2.ModuleIconApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ModuleInfo"):
@@ -65,6 +75,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.ModuleReleaseMetaApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ModuleReleaseMetaSpec"):
 		return &apiv1beta2.ModuleReleaseMetaSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ModuleReleaseMetaStatus"):
+		return &apiv1beta2.ModuleReleaseMetaStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ModuleStatus"):
 		return &apiv1beta2.ModuleStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ModuleTemplate"):
@@ -73,8 +85,22 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.ModuleTemplateSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PartialMeta"):
 		return &apiv1beta2.PartialMetaApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PlannedManifest"):
+		return &apiv1beta2.PlannedManifestApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReconcilePlan"):
+		return &apiv1beta2.ReconcilePlanApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Resource"):
 		return &apiv1beta2.ResourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Revocation"):
+		return &apiv1beta2.RevocationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Rollback"):
+		return &apiv1beta2.RollbackApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("RolloutStrategy"):
+		return &apiv1beta2.RolloutStrategyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("RolloutWave"):
+		return &apiv1beta2.RolloutWaveApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SchedulingPolicy"):
+		return &apiv1beta2.SchedulingPolicyApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Service"):
 		return &apiv1beta2.ServiceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TrackingObject"):
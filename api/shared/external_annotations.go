@@ -3,4 +3,11 @@ package shared
 const (
 	// SkrDomainAnnotation carries the FQDN of the shoot cluster, for example: "<shootid>.kyma.ondemand.com".
 	SkrDomainAnnotation = "skr-domain"
+
+	// MaintenanceWindowBeginAnnotation and MaintenanceWindowEndAnnotation carry the times of the day of the
+	// maintenance window chosen for the shoot cluster in the Gardener format, for example: "220000+0000".
+	MaintenanceWindowBeginAnnotation = "kyma-project.io/maintenance-window-begin"
+	MaintenanceWindowEndAnnotation   = "kyma-project.io/maintenance-window-end"
+	// MaintenanceDaysAnnotation carries the comma-separated days of the maintenance window, for example: "Sat,Sun".
+	MaintenanceDaysAnnotation = "kyma-project.io/maintenance-days"
 )
@@ -2,4 +2,5 @@ package shared
 
 const (
 	InstanceIDLabel = "kyma-project.io/instance-id"
+	ShootNameLabel  = "kyma-project.io/shoot-name"
 )
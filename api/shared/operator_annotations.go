@@ -17,4 +17,7 @@ const (
 	// injected .data. Only honored for restricted default module "deployer" (see the corresponding
 	// resource transform in internal/declarative/v2).
 	InjectDataFromKCPAnnotation = OperatorGroup + Separator + "inject-data-from-kcp"
+	// DryRunAnnotation, when set to "true" on a Kyma, makes the Kyma controller only compute the changes to
+	// module Manifests a reconciliation would apply and report them in .status.plan instead of applying them.
+	DryRunAnnotation = OperatorGroup + Separator + "dry-run"
 )
@@ -27,6 +27,55 @@ type Status struct {
 	// and it is used to determine effective differences from one state to the next.
 	// +listType=atomic
 	Synced []Resource `json:"synced,omitempty"`
+
+	// Health summarizes the health of the synced resources that are evaluated for the State, for example
+	// workloads, Jobs, CustomResourceDefinitions or resources reporting a Ready condition.
+	// +listType=atomic
+	// +optional
+	Health []ResourceHealth `json:"health,omitempty"`
+
+	// Drift lists the synced resources with fields that are managed by field managers other than Lifecycle Manager,
+	// for example after a kubectl edit in the remote cluster, including the fields that Lifecycle Manager reverted
+	// in the last apply. The list is bounded, see the Drift condition for the total.
+	// +listType=atomic
+	// +optional
+	Drift []ResourceDrift `json:"drift,omitempty"`
+}
+
+// ResourceHealth describes the health of a resource synced to the remote cluster.
+// +k8s:deepcopy-gen=true
+type ResourceHealth struct {
+	Resource `json:",inline"`
+
+	// State is the health of the resource mapped to a State.
+	State State `json:"state"`
+
+	// Message explains why the resource is not ready.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceDrift describes the fields of a resource synced to the remote cluster that an unknown field manager manages.
+// +k8s:deepcopy-gen=true
+type ResourceDrift struct {
+	Resource `json:",inline"`
+
+	// Manager is the name of the field manager, for example "kubectl-edit".
+	Manager string `json:"manager"`
+
+	// Operation is the operation the field manager last performed on the fields, either "Apply" or "Update".
+	// +optional
+	Operation string `json:"operation,omitempty"`
+
+	// Fields are the paths of the fields, for example ".spec.replicas". The list is bounded.
+	// +listType=atomic
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+
+	// Reverted is true if the manager changed fields of the module manifest, which Lifecycle Manager took over and
+	// reset in the last apply. Otherwise, the manager still manages the fields.
+	// +optional
+	Reverted bool `json:"reverted,omitempty"`
 }
 
 func (s Status) WithState(state State) Status {
@@ -39,6 +88,16 @@ func (s Status) WithErr(err error) Status {
 	return s
 }
 
+func (s Status) WithHealth(health []ResourceHealth) Status {
+	s.Health = health
+	return s
+}
+
+func (s Status) WithDrift(drift []ResourceDrift) Status {
+	s.Drift = drift
+	return s
+}
+
 func (s Status) WithOperation(operation string) Status {
 	s.LastOperation = LastOperation{Operation: operation, LastUpdateTime: apimetav1.NewTime(time.Now())}
 	return s
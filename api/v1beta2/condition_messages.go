@@ -15,6 +15,10 @@ const (
 
 	ConditionTypeSKRImagePullSecretSync KymaConditionType = "SKRImagePullSecretSync"
 
+	// ConditionTypeModuleDeprecation is only set while deprecated modules or channels are in use, so that it does
+	// not affect the state of the Kyma.
+	ConditionTypeModuleDeprecation KymaConditionType = "ModuleDeprecation"
+
 	// ConditionReason will be set to `Ready` on all Conditions. If the Condition is actual ready,
 	// can be determined by the state.
 	ConditionReason KymaConditionReason = "Ready"
@@ -32,6 +36,7 @@ const (
 	ConditionMessageSKRWebhookIsOutOfSync       = "skrwebhook is out of sync and needs to be resynchronized"
 	ConditionMessageSKRImagePullSecretSynced    = "skr image pull secret is synchronized"
 	ConditionMessageSKRImagePullSecretOutOfSync = "skr image pull secret is out of sync and needs to be resynchronized"
+	ConditionMessageModuleDeprecated            = "deprecated modules or channels are in use"
 )
 
 func GenerateMessage(conditionType KymaConditionType, status apimetav1.ConditionStatus) string {
@@ -86,6 +91,9 @@ var conditionMessagesByType = map[KymaConditionType]conditionMessages{
 		trueMessage:  ConditionMessageSKRImagePullSecretSynced,
 		falseMessage: ConditionMessageSKRImagePullSecretOutOfSync,
 	},
+	ConditionTypeModuleDeprecation: {
+		trueMessage: ConditionMessageModuleDeprecated,
+	},
 }
 
 // GetRequiredConditionTypes returns all required ConditionTypes for a KymaCR, ordered to mirror
@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"slices"
 
+	apicorev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -61,6 +62,36 @@ type KymaSpec struct {
 	// +listType=map
 	// +listMapKey=name
 	Modules []Module `json:"modules,omitempty"`
+
+	// Scheduling specifies where the workloads of all modules are scheduled in the remote cluster, for example on
+	// a dedicated node pool for system workloads. It is applied to the Pod templates of the module resources.
+	// +optional
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+}
+
+// SchedulingPolicy defines the scheduling constraints applied to the Pod templates of the module resources.
+type SchedulingPolicy struct {
+	// Tolerations are added to the tolerations of the Pods.
+	// +optional
+	Tolerations []apicorev1.Toleration `json:"tolerations,omitempty"`
+
+	// NodeSelector is merged into the node selector of the Pods, overriding entries with the same key.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Affinity replaces the node affinity, pod affinity and pod anti-affinity of the Pods, where set.
+	// +optional
+	Affinity *apicorev1.Affinity `json:"affinity,omitempty"`
+
+	// PriorityClassName replaces the priority class of the Pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints replace the constraints of the Pods with the same topology key and
+	// whenUnsatisfiable action and are added otherwise. A constraint without a label selector selects the Pods of
+	// the workload it is applied to.
+	// +optional
+	TopologySpreadConstraints []apicorev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // Module defines the components to be installed.
@@ -85,13 +116,16 @@ type Module struct {
 	Channel string `json:"channel,omitempty"`
 
 	// Version is the desired version of the Module. If this changes or is set, it will be used to resolve a new
-	// ModuleTemplate based on this specific version.
+	// ModuleTemplate based on this specific version. The version must be one of the versions assigned to a channel
+	// in the ModuleReleaseMeta of the Module. A Module pinned to a version does not follow channel updates and is
+	// reported with the "none" channel in the status.
 	// The Version and Channel are mutually exclusive options.
 	// The regular expression come from here:
 	// https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
-	// json:"-" to disable installation of specific versions until decided to roll this out
-	// see https://github.com/kyma-project/lifecycle-manager/issues/1847
-	Version string `json:"-"`
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	// +kubebuilder:validation:MaxLength:=128
+	// +optional
+	Version string `json:"version,omitempty"`
 
 	// RemoteModuleTemplateRef is deprecated and will no longer have any functionality.
 	// It will be removed in the upcoming API version.
@@ -101,6 +135,17 @@ type Module struct {
 	// for the lifecycle of the module.
 	// +kubebuilder:default:=true
 	Managed bool `json:"managed"`
+
+	// Values override the values used to render a Module that is shipped as a Helm chart. They are merged on top
+	// of the values from the config layer of the Module. For Modules shipped as raw manifests, they are ignored.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Values *machineryruntime.RawExtension `json:"values,omitempty"`
+	// DriftPolicy is a list of rules for changes to the module resources in the cluster, for example to keep a
+	// tuned ConfigMap of the Module. The rules are applied after the ones of the ModuleTemplate.
+	// +listType=atomic
+	// +optional
+	DriftPolicy []DriftRule `json:"driftPolicy,omitempty"`
 }
 
 // CustomResourcePolicy determines how a ModuleTemplate should be parsed. When CustomResourcePolicy is set to
@@ -133,6 +178,17 @@ func (kyma *Kyma) GetModuleStatusMap() map[string]*ModuleStatus {
 	return moduleStatusMap
 }
 
+// GetModuleVersion returns the version of the module recorded in the status, or an empty string if the module is
+// not installed.
+func (kyma *Kyma) GetModuleVersion(moduleName string) string {
+	for i := range kyma.Status.Modules {
+		if kyma.Status.Modules[i].Name == moduleName {
+			return kyma.Status.Modules[i].Version
+		}
+	}
+	return ""
+}
+
 // KymaStatus defines the observed state of Kyma.
 type KymaStatus struct {
 	LastOperation shared.LastOperation `json:"lastOperation,omitempty"`
@@ -154,6 +210,64 @@ type KymaStatus struct {
 	// Active Channel
 	// +optional
 	ActiveChannel string `json:"activeChannel,omitempty"`
+
+	// Plan lists the changes to module Manifests that a reconciliation would apply.
+	// It is only reported while the Kyma is annotated with operator.kyma-project.io/dry-run.
+	// +optional
+	Plan *ReconcilePlan `json:"plan,omitempty"`
+}
+
+// ReconcilePlan describes the outcome of a reconciliation that was computed but not applied.
+type ReconcilePlan struct {
+	// Manifests lists the Manifests that would be created, updated, or deleted.
+	// +optional
+	Manifests []PlannedManifest `json:"manifests,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Create;Update;Delete
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "Create"
+	PlanActionUpdate PlanAction = "Update"
+	PlanActionDelete PlanAction = "Delete"
+)
+
+// PlannedManifest describes a change to the Manifest of a module.
+type PlannedManifest struct {
+	// Module is the name of the module.
+	Module string `json:"module"`
+
+	// Name is the name of the Manifest.
+	Name string `json:"name"`
+
+	// Action is the change that would be applied to the Manifest.
+	Action PlanAction `json:"action"`
+
+	// FromVersion is the version of the module currently installed.
+	// +optional
+	FromVersion string `json:"fromVersion,omitempty"`
+
+	// ToVersion is the version of the module that would be installed.
+	// +optional
+	ToVersion string `json:"toVersion,omitempty"`
+
+	// FromOCIRef is the reference of the installation layer currently installed.
+	// +optional
+	FromOCIRef string `json:"fromOCIRef,omitempty"`
+
+	// ToOCIRef is the reference of the installation layer that would be installed.
+	// +optional
+	ToOCIRef string `json:"toOCIRef,omitempty"`
+
+	// PrunedResources lists the resources that would be removed from the remote cluster.
+	// +optional
+	PrunedResources []shared.Resource `json:"prunedResources,omitempty"`
+
+	// Message explains why the planned change is incomplete, for example if the pruned resources
+	// could not be determined.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 func (status *KymaStatus) GetModuleStatus(moduleName string) *ModuleStatus {
@@ -184,6 +298,11 @@ type ModuleStatus struct {
 	// Channel tracks the active Version of the Module.
 	Version string `json:"version,omitempty"`
 
+	// RolledBackFrom is the faulty version the module was rolled back from to the current Version.
+	// It is cleared once the module is updated to another version.
+	// +optional
+	RolledBackFrom string `json:"rolledBackFrom,omitempty"`
+
 	// Message is a human-readable message indicating details about the State.
 	Message string `json:"message,omitempty"`
 
@@ -410,6 +529,11 @@ func (kyma *Kyma) SkipReconciliation() bool {
 	return found && shared.IsEnabled(skip)
 }
 
+func (kyma *Kyma) IsDryRun() bool {
+	dryRun, found := kyma.Annotations[shared.DryRunAnnotation]
+	return found && shared.IsEnabled(dryRun)
+}
+
 func (kyma *Kyma) IsInternal() bool {
 	internal, found := kyma.Labels[shared.InternalLabel]
 	return found && shared.IsEnabled(internal)
@@ -468,3 +592,15 @@ func (kyma *Kyma) GetPlan() string {
 func (kyma *Kyma) GetRuntimeID() string {
 	return kyma.Labels[shared.RuntimeIDLabel]
 }
+
+func (kyma *Kyma) GetSubAccountID() string {
+	return kyma.Labels[shared.SubAccountIDLabel]
+}
+
+func (kyma *Kyma) GetInstanceID() string {
+	return kyma.Labels[shared.InstanceIDLabel]
+}
+
+func (kyma *Kyma) GetShootName() string {
+	return kyma.Labels[shared.ShootNameLabel]
+}
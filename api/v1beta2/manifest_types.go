@@ -34,6 +34,7 @@ const (
 	ConfigLayer      LayerName = "config"
 	DefaultCRLayer   LayerName = "default-cr"
 	RawManifestLayer LayerName = "raw-manifest"
+	HelmChartLayer   LayerName = "helm-chart"
 )
 
 var ErrLabelNotFound = errors.New("label is not found")
@@ -70,6 +71,12 @@ type ManifestSpec struct {
 	// Install specifies a list of installations for Manifest
 	Install InstallInfo `json:"install"`
 
+	// Values override the values used to render the Helm chart layer. They are merged on top of the values from
+	// the Config layer. For Manifests installing a raw manifest layer, they are ignored.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Values *machineryruntime.RawExtension `json:"values,omitempty"`
+
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:XEmbeddedResource
 	// +nullable
@@ -84,9 +91,37 @@ type ManifestSpec struct {
 	// +optional
 	LocalizedImages []string `json:"localizedImages,omitempty"`
 
+	// ImageDigests specifies a list of docker image references of the Kyma module pinned to their digests,
+	// in the format <host[:port][/path]>/<image>:<tag>@<digest>.
+	// The list entries are taken from the OCM component descriptor of the module or resolved from the registry.
+	// If provided, the images in the K8s resources of the Kyma module matching an entry in name and tag
+	// are pinned to the digest of the entry.
+	// +optional
+	ImageDigests []string `json:"imageDigests,omitempty"`
+
+	// Scheduling specifies the scheduling constraints taken from the Kyma, which are applied to the Pod templates
+	// in the K8s resources of the Kyma module.
+	// +optional
+	Scheduling *SchedulingPolicy `json:"scheduling,omitempty"`
+
 	// Manager contains information for identifying a module's resource that can be used as indicator for the installation readiness of the module. Typically, this is the manager Deployment of the module. In exceptional cases, it may also be another resource.
 	// +optional
 	Manager *Manager `json:"manager,omitempty"`
+
+	// CustomStateCheck contains the rules of the ModuleTemplate used to derive the module state from the
+	// default Module CR, or from the manager resource if the Manifest has no default Module CR.
+	// +optional
+	CustomStateCheck []*CustomStateCheck `json:"customStateCheck,omitempty"`
+
+	// AssociatedResources contains the GroupVersionKinds of module related resources taken over from the
+	// ModuleTemplate. The deletion of the Manifest is blocked as long as instances of these resources exist.
+	// +optional
+	AssociatedResources []apimetav1.GroupVersionKind `json:"associatedResources,omitempty"`
+
+	// DriftPolicy contains the drift rules of the ModuleTemplate followed by the ones of the module in the Kyma.
+	// +listType=atomic
+	// +optional
+	DriftPolicy []DriftRule `json:"driftPolicy,omitempty"`
 }
 
 // ImageSpec defines OCI Image specifications.
@@ -111,6 +146,48 @@ type ImageSpec struct {
 	CredSecretSelector *apimetav1.LabelSelector `json:"credSecretSelector,omitempty"`
 }
 
+// DriftRule determines how changes in the remote cluster to the fields of selected module resources are handled.
+// By default, all changes to fields set by the module manifest are reverted. If several rules match a field, the
+// last one applies.
+type DriftRule struct {
+	// Group is the API group of the resources, empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind is the kind of the resources.
+	// +kubebuilder:validation:MinLength:=1
+	Kind string `json:"kind"`
+
+	// Name is the name of the resource. If empty, the rule applies to all resources of the kind.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Namespace is the namespace of the resource. If empty, the rule applies to resources in all namespaces.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Paths are the paths of the fields the rule applies to, with the field names separated by dots and names
+	// containing dots in square brackets, for example "spec.replicas" or "data[config.yaml]". Paths into lists are
+	// not supported. If empty, the rule applies to the whole resource.
+	// +listType=atomic
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// Action is the handling of changes to the fields. With Revert, the changes are reverted on the next
+	// reconciliation. With Ignore and Report, the fields are only set when the resource is created and changes are
+	// kept, and with Report, the changes are reported in the drift status of the Manifest.
+	// +kubebuilder:validation:Enum=Revert;Ignore;Report
+	Action DriftAction `json:"action"`
+}
+
+type DriftAction string
+
+const (
+	DriftActionRevert DriftAction = "Revert"
+	DriftActionIgnore DriftAction = "Ignore"
+	DriftActionReport DriftAction = "Report"
+)
+
 type RefTypeMetadata string
 
 const (
@@ -12,13 +12,15 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:singular=modulereleasemeta,path=modulereleasemetas,shortName=mrm
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 
 type ModuleReleaseMeta struct {
 	apimetav1.TypeMeta   `json:",inline"`
 	apimetav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ModuleReleaseMetaSpec `json:"spec,omitempty"`
+	Spec   ModuleReleaseMetaSpec   `json:"spec,omitempty"`
+	Status ModuleReleaseMetaStatus `json:"status,omitempty"`
 }
 
 // ModuleReleaseMetaSpec defines the channel-version assignments for a module.
@@ -46,6 +48,38 @@ type ModuleReleaseMetaSpec struct {
 	// +optional
 	Mandatory *Mandatory `json:"mandatory,omitempty"`
 
+	// Rollbacks is a list of explicitly allowed downgrades of the module. By default, Lifecycle Manager does not
+	// install a version lower than the version installed in a Kyma runtime. To recover from a faulty release,
+	// assign the previous version to the channel again and add a rollback from the faulty version to it.
+	// +optional
+	// +listType=map
+	// +listMapKey=fromVersion
+	Rollbacks []Rollback `json:"rollbacks,omitempty"`
+
+	// Freeze stops all upgrades of the module during an incident. While frozen, Kymas keep the version of the module
+	// they run, and only Kymas that newly enable the module install the version assigned to their channel.
+	// +optional
+	Freeze *Freeze `json:"freeze,omitempty"`
+
+	// Revocations is a list of module versions that must no longer run. Revoked versions are not installed, and
+	// Kymas running a revoked version are moved to its safe version if allowed, or report the module in the Warning
+	// state otherwise.
+	// +optional
+	// +listType=map
+	// +listMapKey=version
+	Revocations []Revocation `json:"revocations,omitempty"`
+
+	// Deprecation announces the removal of the module to the Kymas that use it.
+	// +optional
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+
+	// Requires is a list of modules that must be enabled in the same Kyma runtime for any version of this module
+	// to be installed. The ModuleTemplate of a version can override single requirements.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Requires []ModuleDependency `json:"requires,omitempty"`
+
 	// Beta indicates if the module is in beta state. Beta modules are only available for beta Kymas.
 	//
 	// Deprecated: This field is deprecated and will be removed in the upcoming API version.
@@ -65,6 +99,140 @@ type ModuleReleaseMetaSpec struct {
 	KymaSelector *apimetav1.LabelSelector `json:"kymaSelector,omitempty"`
 }
 
+// ModuleReleaseMetaStatus defines the observed state of the ModuleReleaseMeta.
+type ModuleReleaseMetaStatus struct {
+	// Rollouts is the progress of the rollouts of the versions assigned to the channels with a rollout strategy.
+	// +optional
+	// +listType=map
+	// +listMapKey=channel
+	Rollouts []ChannelRollout `json:"rollouts,omitempty"`
+}
+
+// ChannelRollout is the progress of the rollout of a version assigned to a channel.
+type ChannelRollout struct {
+	// Channel is the module channel.
+	Channel string `json:"channel"`
+
+	// Version is the version rolled out.
+	Version string `json:"version"`
+
+	// PreviousVersion is the version that Kymas not yet in the rollout install.
+	// +optional
+	PreviousVersion string `json:"previousVersion,omitempty"`
+
+	// Wave is the index of the last wave the version is rolled out to. It equals the number of waves once the
+	// version is rolled out to all Kymas.
+	Wave int `json:"wave"`
+
+	// WaveStartTime is the time the last wave rolled out to started to soak, once the module ran the version in
+	// all of its Kymas.
+	// +optional
+	WaveStartTime apimetav1.Time `json:"waveStartTime,omitempty"`
+
+	// State is the state of the rollout.
+	State RolloutState `json:"state"`
+
+	// UpgradedKymas is the number of Kymas in which the module runs in the version.
+	// +optional
+	UpgradedKymas int `json:"upgradedKymas,omitempty"`
+
+	// FailedKymas is the number of Kymas in which the module runs in the version and is in the Error state.
+	// +optional
+	FailedKymas int `json:"failedKymas,omitempty"`
+
+	// Message explains the state of the rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type RolloutState string
+
+const (
+	RolloutStateProgressing RolloutState = "Progressing"
+	RolloutStatePaused      RolloutState = "Paused"
+	RolloutStateCompleted   RolloutState = "Completed"
+)
+
+// RolloutStrategy rolls out a new version of a channel to the Kymas in ordered waves. Each wave is rolled out once
+// the previous wave has soaked for the soak time without too many errors. Kymas in no wave are rolled out to last.
+type RolloutStrategy struct {
+	// Waves are the ordered waves of Kymas. A Kyma belongs to the first wave it matches.
+	// +kubebuilder:validation:MinItems:=1
+	// +listType=map
+	// +listMapKey=name
+	Waves []RolloutWave `json:"waves"`
+
+	// SoakTime is the time a wave runs the new version before the next wave is rolled out to.
+	// +kubebuilder:default:="1h"
+	// +optional
+	SoakTime apimetav1.Duration `json:"soakTime,omitempty"`
+
+	// MaxErrorPercentage is the percentage of the Kymas running the new version in which the module may be in the
+	// Error state. Above it, the rollout is paused until enough modules recover.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=100
+	// +kubebuilder:default:=10
+	// +optional
+	MaxErrorPercentage int `json:"maxErrorPercentage,omitempty"`
+}
+
+// RolloutWave selects the Kymas of a wave by their labels or as a share of all Kymas.
+// +kubebuilder:validation:XValidation:rule="has(self.kymaSelector) != has(self.percentage)",message="exactly one of 'kymaSelector' or 'percentage' must be specified"
+type RolloutWave struct {
+	// Name is the name of the wave.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=64
+	Name string `json:"name"`
+
+	// KymaSelector selects the Kymas of the wave by their labels.
+	// +optional
+	KymaSelector *apimetav1.LabelSelector `json:"kymaSelector,omitempty"`
+
+	// Percentage selects the given share of all Kymas, including the ones of previous waves, by a stable hash of
+	// the Kyma name. For example, waves with 10 and 50 percent roll out to 10 percent of the Kymas first and to
+	// another 40 percent next.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +optional
+	Percentage *int `json:"percentage,omitempty"`
+}
+
+// Rollback allows downgrading a module from a faulty version to a lower version.
+type Rollback struct {
+	// FromVersion is the faulty version that may be rolled back.
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	FromVersion string `json:"fromVersion"`
+
+	// ToVersion is the lower version the module is rolled back to.
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	ToVersion string `json:"toVersion"`
+}
+
+// Freeze defines a fleet-wide stop of the upgrades of a module.
+type Freeze struct {
+	// Reason explains why the module is frozen.
+	// +kubebuilder:validation:MinLength:=1
+	Reason string `json:"reason"`
+}
+
+// Revocation defines a module version that must no longer run.
+type Revocation struct {
+	// Version is the revoked module version.
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	Version string `json:"version"`
+
+	// Reason explains why the version is revoked.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// SafeVersion is the version that Kymas running the revoked version are moved to. A safe version lower than
+	// the revoked version is only installed if the rollback from the revoked version to it is listed in the
+	// rollbacks.
+	// +optional
+	// +kubebuilder:validation:Pattern:=`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	SafeVersion string `json:"safeVersion,omitempty"`
+}
+
 // Mandatory defines a mandatory module with a specific version.
 type Mandatory struct {
 	// Version is the mandatory module version in semantic version format.
@@ -95,6 +263,31 @@ type ChannelVersionAssignment struct {
 	// +kubebuilder:validation:Pattern:=`^((0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[a-zA-Z-][0-9a-zA-Z-]*)?)?$`
 	// +kubebuilder:validation:MaxLength:=32
 	Version string `json:"version"`
+
+	// Rollout is the strategy to roll out a new version of the channel. If not set, a new version is rolled out
+	// to all Kymas at once.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+
+	// Deprecation announces the removal of the channel to the Kymas that use it.
+	// +optional
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+}
+
+// Deprecation announces the removal of a module or of a module channel.
+type Deprecation struct {
+	// Deprecated marks the module or channel as deprecated.
+	Deprecated bool `json:"deprecated"`
+
+	// SunsetDate is the date from which the module or channel is no longer available, in the format YYYY-MM-DD.
+	// +optional
+	// +kubebuilder:validation:Format:=date
+	SunsetDate string `json:"sunsetDate,omitempty"`
+
+	// Replacement is the module replacing a deprecated module, or the channel replacing a deprecated channel.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=64
+	Replacement string `json:"replacement,omitempty"`
 }
 
 //nolint:gochecknoinits // registers ModuleReleaseMeta CRD on startup
@@ -113,6 +306,26 @@ func (m *ModuleReleaseMeta) IsInternal() bool {
 	return m.Spec.Internal
 }
 
+// GetRollout returns the rollout of the channel in the status, or nil if there is none.
+func (m *ModuleReleaseMeta) GetRollout(channel string) *ChannelRollout {
+	for i := range m.Status.Rollouts {
+		if m.Status.Rollouts[i].Channel == channel {
+			return &m.Status.Rollouts[i]
+		}
+	}
+	return nil
+}
+
+// GetRevocation returns the revocation of the version, or nil if the version is not revoked.
+func (m *ModuleReleaseMeta) GetRevocation(version string) *Revocation {
+	for i := range m.Spec.Revocations {
+		if m.Spec.Revocations[i].Version == version {
+			return &m.Spec.Revocations[i]
+		}
+	}
+	return nil
+}
+
 func (m *ModuleReleaseMeta) GetAllChannels() []string {
 	allChannels := make([]string, 0, len(m.Spec.Channels))
 	for _, channelVersionAssignment := range m.Spec.Channels {
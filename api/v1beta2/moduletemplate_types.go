@@ -99,7 +99,10 @@ type ModuleTemplateSpec struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Descriptor machineryruntime.RawExtension `json:"descriptor"`
 
-	// CustomStateCheck is deprecated.
+	// CustomStateCheck is a list of rules that map values found at a JSONPath of the default Module CR to a module
+	// state. It is meant for modules that report their health through custom fields (e.g. status conditions) instead
+	// of status.state. If no rule matches, the module is considered to be Processing.
+	// +optional
 	CustomStateCheck []*CustomStateCheck `json:"customStateCheck,omitempty"`
 
 	// Resources is a list of additional resources of the module that can be fetched, e.g., the raw manifest.
@@ -112,10 +115,19 @@ type ModuleTemplateSpec struct {
 	// +optional
 	Info *ModuleInfo `json:"info,omitempty"`
 
-	// AssociatedResources is a list of module related resources that usually must be cleaned when uninstalling a module. Informational purpose only.
+	// AssociatedResources is a list of module related resources that usually must be cleaned when uninstalling a module.
+	// The module deletion is blocked as long as instances of these resources exist in the runtime cluster.
 	// +optional
 	AssociatedResources []apimetav1.GroupVersionKind `json:"associatedResources,omitempty"`
 
+	// Requires is a list of modules that must be enabled in the same Kyma runtime for this module version to be
+	// installed. The required modules are installed first, and they can not be removed as long as this module is
+	// enabled. Entries override the requirements of the same module declared in the ModuleReleaseMeta.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Requires []ModuleDependency `json:"requires,omitempty"`
+
 	// Manager contains information for identifying a module's resource that can be used as indicator for the installation readiness of the module. Typically, this is the manager Deployment of the module. In exceptional cases, it may also be another resource.
 	// +optional
 	Manager *Manager `json:"manager,omitempty"`
@@ -123,6 +135,23 @@ type ModuleTemplateSpec struct {
 	// RequiresDowntime indicates whether the module requires downtime in support of maintenance windows during module upgrades.
 	// +optional
 	RequiresDowntime bool `json:"requiresDowntime"`
+	// DriftPolicy is a list of rules for changes to the module resources in the runtime cluster, for example to keep
+	// the replicas of a Deployment scaled by a HorizontalPodAutoscaler. By default, all changes are reverted.
+	// +listType=atomic
+	// +optional
+	DriftPolicy []DriftRule `json:"driftPolicy,omitempty"`
+}
+
+// ModuleDependency declares that a module requires another module.
+type ModuleDependency struct {
+	// Name is the name of the required module.
+	// +kubebuilder:validation:Pattern:=`^([a-z]{3,}(-[a-z]{3,})*)?$`
+	// +kubebuilder:validation:MaxLength:=64
+	Name string `json:"name"`
+
+	// Version is an optional semantic version constraint the required module must satisfy, e.g. ">=1.2.0 <2.0.0".
+	// +optional
+	Version string `json:"version,omitempty"`
 }
 
 // Manager defines the structure for the manager field in ModuleTemplateSpec.
@@ -165,7 +194,8 @@ type ModuleIcon struct {
 }
 
 type CustomStateCheck struct {
-	// JSONPath specifies the JSON path to the state variable in the Module CR
+	// JSONPath specifies the JSON path to the state variable in the Module CR,
+	// e.g. status.conditions[?(@.type=="Ready")].status
 	JSONPath string `json:"jsonPath" yaml:"jsonPath"`
 
 	// Value is the value at the JSONPath for which the Module CR state should map with MappedState
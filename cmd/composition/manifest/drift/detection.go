@@ -0,0 +1,17 @@
+package drift
+
+import (
+	manifestctrl "github.com/kyma-project/lifecycle-manager/internal/controller/manifest"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+)
+
+// ComposeDriftDetection creates the detection of module resources drifted by unknown field managers, which reports
+// nothing if it is disabled.
+func ComposeDriftDetection(flagVar *flags.FlagVar, metrics skrresources.DriftMetrics) manifestctrl.DriftDetection {
+	if !flagVar.DriftDetection {
+		return skrresources.DisabledDriftDetection{}
+	}
+	return skrresources.NewDriftDetection(flagVar.GetDriftKnownFieldManagers(), flagVar.DriftReportMaxEntries,
+		metrics)
+}
@@ -0,0 +1,35 @@
+package imagedigest
+
+import (
+	"os"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/imagedigest"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/oci"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/parser"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
+)
+
+// ComposeImageDigestResolver creates the resolver for the digests of module images that the component descriptor
+// references by tag only. It returns nil if the resolution is disabled, in which case such images are not pinned.
+// The resolver must be shared by all parsers, so that they share the resolved digests.
+//
+//nolint:ireturn // constructor functions can return interfaces
+func ComposeImageDigestResolver(
+	kcl spec.KeyChainLookup,
+	flagVar *flags.FlagVar,
+	logger logr.Logger,
+	bootstrapFailedExitCode int,
+) parser.ImageDigestResolver {
+	if !flagVar.ResolveImageDigests {
+		return nil
+	}
+	ociRepository, err := oci.NewRepository(kcl, false)
+	if err != nil {
+		logger.Error(err, "failed to create OCI repository for image digest resolution")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return imagedigest.NewResolver(ociRepository)
+}
@@ -0,0 +1,42 @@
+package pathextractor
+
+import (
+	"os"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+)
+
+// ComposeLayerCache creates the on-disk layer cache for manifest layers and rendered Helm charts, bounded to the
+// configured size. The layer cache must be shared by all consumers, so that its size budget applies to all of them.
+func ComposeLayerCache(flagVar *flags.FlagVar, logger logr.Logger, bootstrapFailedExitCode int) *layercache.Cache {
+	maxSize, err := flagVar.GetLayerCacheMaxSize()
+	if err != nil {
+		logger.Error(err, "invalid layer cache size")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	layerCache, err := layercache.NewCache(flagVar.LayerCacheDirectory, maxSize, metrics.NewLayerCacheMetrics())
+	if err != nil {
+		logger.Error(err, "failed to setup layer cache")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return layerCache
+}
+
+// ComposePathExtractor creates the PathExtractor for pulling manifest layers into the given layer cache.
+// If an OCI image layout directory or OCI registry mirrors are configured, the layers are read with the given
+// layerReader instead of being pulled from the registry of the image spec directly.
+func ComposePathExtractor(
+	flagVar *flags.FlagVar,
+	layerCache *layercache.Cache,
+	layerReader img.LayerReader,
+) *img.PathExtractor {
+	if flagVar.OciLayoutDirectory == "" && flagVar.OciRegistryMirrors == "" {
+		return img.NewPathExtractor(layerCache)
+	}
+	return img.NewPathExtractor(layerCache, img.WithLayerReader(layerReader))
+}
@@ -0,0 +1,27 @@
+package resourceprofile
+
+import (
+	"os"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+)
+
+// ComposeResourceProfiles loads the resource profiles for module workloads. It returns nil if no profiles file is
+// configured, in which case module workloads are applied as rendered.
+func ComposeResourceProfiles(flagVar *flags.FlagVar, logger logr.Logger,
+	bootstrapFailedExitCode int,
+) resourceprofile.Profiles {
+	if flagVar.ResourceProfilesFile == "" {
+		return nil
+	}
+	profiles, err := resourceprofile.Load(flagVar.ResourceProfilesFile)
+	if err != nil {
+		logger.Error(err, "failed to load resource profiles")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	logger.Info("loaded resource profiles", "file", flagVar.ResourceProfilesFile, "plans", len(profiles))
+	return profiles
+}
@@ -1,31 +1,129 @@
 package oci
 
 import (
+	"context"
 	"os"
+	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/authn"
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/mirror"
 	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/oci"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/ocidir"
 	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
 	"github.com/kyma-project/lifecycle-manager/internal/setup"
 )
 
+const (
+	httpsSchemePrefix = "https://"
+	httpSchemePrefix  = "http://"
+)
+
+// ComposeRepository creates the repository the component descriptors are read from with the given OCI reader.
 func ComposeRepository(
-	kcl spec.KeyChainLookup,
 	ociRegistry *setup.OCIRegistry,
+	ociRepository ocm.OciRepositoryReader,
 	logger logr.Logger,
 	bootstrapFailedExitCode int,
 ) *ocm.RepositoryReader {
-	ociRepository, err := oci.NewRepository(kcl, ociRegistry.IsInsecure())
+	ocmRepository, err := ocm.NewRepository(ociRegistry.GetReference(), ociRepository)
 	if err != nil {
 		logger.Error(err, "failed to create OCI repository")
 		os.Exit(bootstrapFailedExitCode)
 	}
-	ocmRepository, err := ocm.NewRepository(ociRegistry.GetReference(), ociRepository)
+	return ocmRepository
+}
+
+// RepositoryReader reads the component descriptors and the manifest layers. Layers are pulled with the keychain of
+// the Manifest they belong to.
+type RepositoryReader interface {
+	ocm.OciRepositoryReader
+	PullLayerWithKeyChain(ctx context.Context, ref string, keyChain authn.Keychain) (containerregistryv1.Layer, error)
+}
+
+// ComposeRepositoryReader creates the reader for OCI artifacts shared by the component descriptor repository and
+// the manifest layer pulls. If an OCI image layout directory is configured, artifacts are read from that directory.
+// If OCI registry mirrors are configured, artifacts are read from the OCI registry and the mirrors in order.
+// Otherwise, they are read from the OCI registry only.
+//
+//nolint:ireturn // constructor functions can return interfaces
+func ComposeRepositoryReader(
+	kcl spec.KeyChainLookup,
+	kcpClient client.Client,
+	ociRegistry *setup.OCIRegistry,
+	flagVar *flags.FlagVar,
+	logger logr.Logger,
+	bootstrapFailedExitCode int,
+) RepositoryReader {
+	if flagVar.OciLayoutDirectory != "" {
+		logger.Info("reading OCI artifacts from OCI image layout", "directory", flagVar.OciLayoutDirectory)
+		ociDirRepository, err := ocidir.NewRepository(flagVar.OciLayoutDirectory)
+		if err != nil {
+			logger.Error(err, "failed to create OCI image layout repository")
+			os.Exit(bootstrapFailedExitCode)
+		}
+		return ociDirRepository
+	}
+	ociRepository, err := oci.NewRepository(kcl, ociRegistry.IsInsecure())
 	if err != nil {
 		logger.Error(err, "failed to create OCI repository")
 		os.Exit(bootstrapFailedExitCode)
 	}
-	return ocmRepository
+	mirrors, err := flagVar.GetOciRegistryMirrors()
+	if err != nil {
+		logger.Error(err, "invalid OCI registry mirrors")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	if len(mirrors) == 0 {
+		return ociRepository
+	}
+
+	registries := []mirror.Registry{{Reference: ociRegistry.GetReference(), Reader: ociRepository}}
+	for _, ociRegistryMirror := range mirrors {
+		registries = append(registries,
+			composeMirrorRegistry(kcpClient, ociRegistryMirror, logger, bootstrapFailedExitCode))
+	}
+	logger.Info("reading OCI artifacts with registry mirrors", "mirrors", len(mirrors))
+	mirrorRepository, err := mirror.NewRepositoryReader(registries,
+		metrics.NewRegistryMirrorMetrics(),
+		mirror.WithTimeout(flagVar.OciRegistryMirrorTimeout),
+		mirror.WithCooldown(flagVar.OciRegistryMirrorCooldown),
+	)
+	if err != nil {
+		logger.Error(err, "failed to create OCI registry mirror repository")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return mirrorRepository
+}
+
+func composeMirrorRegistry(
+	kcpClient client.Client,
+	ociRegistryMirror flags.OciRegistryMirror,
+	logger logr.Logger,
+	bootstrapFailedExitCode int,
+) mirror.Registry {
+	var kcl spec.KeyChainLookup = keychainprovider.NewDefaultKeyChainProvider()
+	if ociRegistryMirror.CredSecretName != "" {
+		kcl = keychainprovider.NewFromSecretKeyChainProvider(kcpClient, types.NamespacedName{
+			Namespace: shared.DefaultControlPlaneNamespace,
+			Name:      ociRegistryMirror.CredSecretName,
+		})
+	}
+	reference, insecure := strings.CutPrefix(ociRegistryMirror.Registry, httpSchemePrefix)
+	reference = strings.TrimPrefix(reference, httpsSchemePrefix)
+	ociRepository, err := oci.NewRepository(kcl, insecure)
+	if err != nil {
+		logger.Error(err, "failed to create OCI repository for registry mirror", "mirror", reference)
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return mirror.Registry{Reference: reference, Reader: ociRepository}
 }
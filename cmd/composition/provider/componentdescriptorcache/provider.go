@@ -1,27 +1,38 @@
 package componentdescriptorcache
 
 import (
+	"os"
+
 	"github.com/go-logr/logr"
 
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/oci"
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/service/componentdescriptor"
 	descriptorcache "github.com/kyma-project/lifecycle-manager/internal/descriptor/cache"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
-	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/verification"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm"
 	"github.com/kyma-project/lifecycle-manager/internal/setup"
 )
 
 // ComposeCachedDescriptorProvider manages creation of a new instance of the cached ComponentDescriptor provider
-// including all of its dependencies (OCI repository, component descriptor service, descriptor cache).
+// including all of its dependencies (OCM repository, component descriptor service, descriptor cache) on top of
+// the given OCI repository reader.
+// The descriptor cache is bounded by the configured number of entries and TTL, and persisted to the configured
+// directory, if any. If a descriptor signature Secret is configured, fetched and persisted descriptors are verified
+// against the trusted keys of that Secret.
 func ComposeCachedDescriptorProvider(
-	kcl spec.KeyChainLookup,
+	ociRepository ocm.OciRepositoryReader,
 	ociRegistry *setup.OCIRegistry,
+	secretRepository verification.SecretRepository,
+	flagVar *flags.FlagVar,
 	logger logr.Logger,
 	bootstrapFailedExitCode int,
 ) *provider.CachedDescriptorProvider {
 	ocmDescriptorRepository := oci.ComposeRepository(
-		kcl,
 		ociRegistry,
+		ociRepository,
 		logger,
 		bootstrapFailedExitCode,
 	)
@@ -30,8 +41,41 @@ func ComposeCachedDescriptorProvider(
 		logger,
 		bootstrapFailedExitCode,
 	)
+	signatureSecretName := flagVar.DescriptorSignatureSecret
+	if signatureSecretName == "" {
+		descriptorCache := composeDescriptorCache(flagVar, logger, bootstrapFailedExitCode)
+		return provider.NewCachedDescriptorProvider(ocmDescriptorService, descriptorCache)
+	}
+	logger.Info("verifying component descriptor signatures", "secret", signatureSecretName)
+	verifier := verification.NewSignatureVerifier(secretRepository, signatureSecretName)
+	descriptorCache := composeDescriptorCache(flagVar, logger, bootstrapFailedExitCode,
+		descriptorcache.WithVerifier(verifier))
 	return provider.NewCachedDescriptorProvider(
 		ocmDescriptorService,
-		descriptorcache.NewDescriptorCache(),
+		descriptorCache,
+		provider.WithVerifier(verifier),
+	)
+}
+
+func composeDescriptorCache(
+	flagVar *flags.FlagVar,
+	logger logr.Logger,
+	bootstrapFailedExitCode int,
+	persistentOpts ...func(*descriptorcache.PersistentDescriptorCache) *descriptorcache.PersistentDescriptorCache,
+) provider.DescriptorCache {
+	memoryCache := descriptorcache.NewDescriptorCache(
+		descriptorcache.WithMaxEntries(flagVar.DescriptorCacheMaxEntries),
+		descriptorcache.WithTTL(flagVar.DescriptorCacheTTL),
+		descriptorcache.WithMetrics(metrics.NewDescriptorCacheMetrics()),
 	)
+	if flagVar.DescriptorCacheDirectory == "" {
+		return memoryCache
+	}
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(flagVar.DescriptorCacheDirectory,
+		memoryCache, logger.WithName("descriptor-cache"), persistentOpts...)
+	if err != nil {
+		logger.Error(err, "failed to setup persistent descriptor cache")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return persistentCache
 }
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	manifestrendercmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/manifest/render"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
+	kymaplansvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/plan"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/parser"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/render"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
+	"github.com/kyma-project/lifecycle-manager/internal/service/skrclient"
+)
+
+// ComposeKymaPlanService wires the service computing the dry-run plan of a Kyma. It renders the
+// manifest layers with the same transforms as the Manifest controller, so that the planned pruned
+// resources match the ones removed during the actual reconciliation.
+func ComposeKymaPlanService(kcpClient client.Client,
+	keyChainLookup spec.KeyChainLookup,
+	pathExtractor spec.PathExtractor,
+	chartRenderer spec.ChartRenderer,
+	skrClient *skrclient.Service,
+	secretRepo render.SecretRepository,
+	skrImagePullSecretName string,
+	restrictedDefaultModules []string,
+	resourceProfiles resourceprofile.Profiles,
+) *kymaplansvc.Service {
+	specResolver := spec.NewResolver(keyChainLookup, pathExtractor, chartRenderer)
+	renderService := manifestrendercmpse.ComposeRenderService(
+		parser.NewCachedManifestParser(parser.DefaultInMemoryParseTTL),
+		skrImagePullSecretName, secretRepo, restrictedDefaultModules, resourceProfiles)
+	return kymaplansvc.NewService(kcpClient, specResolver, skrClient, renderService)
+}
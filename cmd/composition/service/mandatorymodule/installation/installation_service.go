@@ -19,8 +19,10 @@ func ComposeInstallationService(clnt client.Client,
 	ociRegistry string,
 	remoteSyncNamespace string,
 	metrics *metrics.MandatoryModulesMetrics,
+	imageDigestResolver parser.ImageDigestResolver,
 ) *installservice.Service {
-	moduleParser := parser.NewParser(clnt, descriptorProvider, remoteSyncNamespace, ociRegistry)
+	moduleParser := parser.NewParser(clnt, descriptorProvider, remoteSyncNamespace, ociRegistry,
+		parser.WithImageDigestResolver(imageDigestResolver))
 	manifestCreator := sync.New(clnt)
 	return installservice.NewService(mrmRepo, mtRepo, moduleParser, manifestCreator, metrics)
 }
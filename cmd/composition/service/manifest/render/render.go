@@ -4,6 +4,7 @@ package render
 import (
 	"slices"
 
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
 	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/parser"
 	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/render"
 )
@@ -14,11 +15,13 @@ import (
 // The SkrImagePullSecret transform is appended when skrImagePullSecretName is non-empty.
 // The DeployerModuleImagePullSecret transform is appended only when the
 // deployer module is configured as a restricted default module — see issue #3345.
+// The ResourceProfile transform is appended when resourceProfiles is non-empty.
 func ComposeRenderService(
 	cachedParser *parser.CachedManifestParser,
 	skrImagePullSecretName string,
 	secretRepo render.SecretRepository,
 	restrictedDefaultModules []string,
+	resourceProfiles resourceprofile.Profiles,
 ) *render.Service {
 	transforms := render.GetDefaultResourceTransforms()
 	if skrImagePullSecretName != "" {
@@ -29,5 +32,8 @@ func ComposeRenderService(
 		transforms = append(transforms,
 			render.CreateDeployerModuleImagePullSecretTransform(secretRepo))
 	}
+	if len(resourceProfiles) > 0 {
+		transforms = append(transforms, render.CreateResourceProfileTransform(resourceProfiles))
+	}
 	return render.NewService(cachedParser, transforms)
 }
@@ -0,0 +1,18 @@
+package modulerollout
+
+import (
+	"time"
+
+	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
+	mrmrepo "github.com/kyma-project/lifecycle-manager/internal/repository/modulereleasemeta"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerollout"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
+)
+
+func ComposeModuleRolloutService(kymaRepo *kymarepo.Repository,
+	mrmRepo *mrmrepo.Repository,
+	kymaRequeueSource *watch.KymaRequeueSource,
+	checkInterval time.Duration,
+) *modulerollout.Service {
+	return modulerollout.NewService(kymaRepo, mrmRepo, kymaRequeueSource, checkInterval)
+}
@@ -3,10 +3,21 @@ package watch
 import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
+	mrmrepo "github.com/kyma-project/lifecycle-manager/internal/repository/modulereleasemeta"
 	"github.com/kyma-project/lifecycle-manager/internal/watch"
 )
 
-func ComposeTemplateChangeHandlerMapFunc(kymaRepo *kymarepo.Repository) handler.MapFunc {
-	return watch.NewTemplateChangeHandler(kymaRepo).Watch()
+// ComposeTemplateChangeHandler creates the event handler requeueing Kymas on ModuleTemplate changes. If a
+// descriptorProvider is given, the cached descriptor of a ModuleTemplate is invalidated when its descriptor changes.
+func ComposeTemplateChangeHandler(kymaRepo *kymarepo.Repository, mrmRepo *mrmrepo.Repository,
+	descriptorProvider *provider.CachedDescriptorProvider,
+) handler.EventHandler {
+	if descriptorProvider == nil {
+		return watch.NewTemplateChangeHandler(kymaRepo).Watch()
+	}
+	return watch.NewTemplateChangeHandler(kymaRepo,
+		watch.WithDescriptorInvalidation(mrmRepo, descriptorProvider),
+	).Watch()
 }
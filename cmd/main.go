@@ -50,13 +50,19 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api"
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	driftcmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/manifest/drift"
+	imagedigestcmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/manifest/imagedigest"
+	pathextractorcmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/manifest/pathextractor"
+	resourceprofilecmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/manifest/resourceprofile"
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/oci"
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/provider/componentdescriptorcache"
 	kymadeletioncmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/kyma/deletion"
 	kymalookupcmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/kyma/lookup"
+	kymaplancmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/kyma/plan"
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/service/mandatorymodule/deletion"
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/service/mandatorymodule/installation"
 	manifestrendercmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/manifest/render"
+	modulerolloutcmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/modulerollout"
 	skrsynccmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/service/skrsync"
 	"github.com/kyma-project/lifecycle-manager/cmd/composition/service/skrwebhook"
 	watchcmpse "github.com/kyma-project/lifecycle-manager/cmd/composition/watch"
@@ -66,16 +72,19 @@ import (
 	kymadeletionctrl "github.com/kyma-project/lifecycle-manager/internal/controller/kyma/deletion"
 	"github.com/kyma-project/lifecycle-manager/internal/controller/mandatorymodule"
 	manifestctrl "github.com/kyma-project/lifecycle-manager/internal/controller/manifest"
+	modulerolloutctrl "github.com/kyma-project/lifecycle-manager/internal/controller/modulerollout"
 	watcherctrl "github.com/kyma-project/lifecycle-manager/internal/controller/watcher"
 	"github.com/kyma-project/lifecycle-manager/internal/crd"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
 	gatewaysecretclient "github.com/kyma-project/lifecycle-manager/internal/gatewaysecret/client"
 	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/helm"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/labelsremoval"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/manifestclient"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
@@ -90,6 +99,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/service/accessmanager"
 	kymadeletionsvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/deletion"
 	kymalookupsvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/lookup"
+	kymaplansvc "github.com/kyma-project/lifecycle-manager/internal/service/kyma/plan"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator/fromerror"
@@ -99,6 +109,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/service/skrclient"
 	skrclientcache "github.com/kyma-project/lifecycle-manager/internal/service/skrclient/cache"
 	"github.com/kyma-project/lifecycle-manager/internal/setup"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
 	mrmwatch "github.com/kyma-project/lifecycle-manager/internal/watch/modulereleasemeta"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
@@ -261,16 +272,35 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 
 	ociRegistry := oci.ComposeRegistry(kcpClientWithoutCache, flagVar, logger, bootstrapFailedExitCode)
 
-	descriptorProvider := componentdescriptorcache.ComposeCachedDescriptorProvider(
+	ociRepository := oci.ComposeRepositoryReader(
 		keychainLookupFromFlag(mgr.GetClient(), flagVar),
+		mgr.GetClient(),
 		ociRegistry,
+		flagVar,
+		logger,
+		bootstrapFailedExitCode,
+	)
+
+	descriptorProvider := componentdescriptorcache.ComposeCachedDescriptorProvider(
+		ociRepository,
+		ociRegistry,
+		secretRepo,
+		flagVar,
 		logger,
 		bootstrapFailedExitCode,
 	)
 
+	layerCache := pathextractorcmpse.ComposeLayerCache(flagVar, logger, bootstrapFailedExitCode)
+	pathExtractor := pathextractorcmpse.ComposePathExtractor(flagVar, layerCache, ociRepository)
+	chartRenderer := helm.NewRenderer(layerCache)
+
+	imageDigestResolver := imagedigestcmpse.ComposeImageDigestResolver(keychainLookupFromFlag(mgr.GetClient(), flagVar),
+		flagVar, logger, bootstrapFailedExitCode)
+	resourceProfiles := resourceprofilecmpse.ComposeResourceProfiles(flagVar, logger, bootstrapFailedExitCode)
+
 	kymaMetrics := metrics.NewKymaMetrics(sharedMetrics)
 	mandatoryModulesMetrics := metrics.NewMandatoryModulesMetrics()
-	maintenanceWindow := initMaintenanceWindow(flagVar.MinMaintenanceWindowSize, logger)
+	maintenanceWindow := initMaintenanceWindow(mgr, flagVar, logger)
 	metrics.NewFipsMetrics().Update()
 
 	kymaRepo := kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace)
@@ -279,7 +309,8 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	manifestRepo := manifestrepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace)
 
 	mrmEventHandler := watchcmpse.ComposeMrmEventHandler(kymaRepo, flagVar.ModuleUpgradeRolloutMaxDelay)
-	mtEventHandlerMapFunc := watchcmpse.ComposeTemplateChangeHandlerMapFunc(kymaRepo)
+	kymaRequeueSource := watch.NewKymaRequeueSource()
+	mtEventHandler := watchcmpse.ComposeTemplateChangeHandler(kymaRepo, mrmRepo, descriptorProvider)
 	mandatoryMrmEventHandler := watchcmpse.ComposeMandatoryMrmEventHandler(kymaRepo,
 		flagVar.ModuleUpgradeRolloutMaxDelay)
 
@@ -292,16 +323,21 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 		skrWebhookManager,
 	)
 
+	skrClient := skrclient.NewService(mgr.GetConfig().QPS, mgr.GetConfig().Burst, accessManagerService)
 	kymaLookupSvc := kymalookupcmpse.ComposeKymaLookupService(kymaRepo)
+	kymaPlanSvc := kymaplancmpse.ComposeKymaPlanService(kcpClient, keychainLookupFromFlag(kcpClient, flagVar),
+		pathExtractor, chartRenderer, skrClient, secretRepo, flagVar.SkrImagePullSecret,
+		flagVar.GetRestrictedDefaultModules(), resourceProfiles)
 
 	setupKymaReconciler(mgr, descriptorProvider, skrContextProvider, remoteClientCache, eventRecorder, flagVar, options,
 		skrWebhookManager, kymaMetrics, logger, maintenanceWindow, ociRegistry.GetReference(), kymaDeletionSvc,
-		kymaLookupSvc, mtEventHandlerMapFunc, mrmEventHandler)
-	setupManifestReconciler(mgr, flagVar, options, sharedMetrics, mandatoryModulesMetrics, accessManagerService, logger,
-		eventRecorder, kymaRepo, secretRepo)
+		kymaLookupSvc, kymaPlanSvc, mtEventHandler, mrmEventHandler, kymaRequeueSource, imageDigestResolver)
+	setupManifestReconciler(mgr, flagVar, options, sharedMetrics, mandatoryModulesMetrics, skrClient, logger,
+		eventRecorder, kymaRepo, secretRepo, pathExtractor, chartRenderer, resourceProfiles)
 	setupMandatoryModuleReconciler(mgr, descriptorProvider, mrmRepo, mtRepo, flagVar, options, mandatoryModulesMetrics,
-		logger, ociRegistry.GetReference(), mandatoryMrmEventHandler)
+		logger, ociRegistry.GetReference(), mandatoryMrmEventHandler, imageDigestResolver)
 	setupMandatoryModuleDeletionReconciler(mgr, eventRecorder, mrmRepo, manifestRepo, flagVar, options, logger)
+	setupModuleRolloutReconciler(mgr, kymaRepo, mrmRepo, kymaRequeueSource, flagVar, options, logger)
 
 	if flagVar.EnableWebhooks {
 		// enable conversion webhook for CRDs here
@@ -320,19 +356,22 @@ func setupManager(flagVar *flags.FlagVar, cacheOptions cache.Options, scheme *ma
 	}
 }
 
-func initMaintenanceWindow(minWindowSize time.Duration, logger logr.Logger) maintenancewindows.MaintenanceWindow {
-	maintenanceWindowsMetrics := metrics.NewMaintenanceWindowMetrics()
-	maintenanceWindow, err := maintenancewindows.InitializeMaintenanceWindow(logger,
+func initMaintenanceWindow(mgr manager.Manager, flagVar *flags.FlagVar,
+	logger logr.Logger,
+) maintenancewindows.MaintenanceWindow {
+	policy := maintenancewindows.NewReloadablePolicy(logger,
 		maintenanceWindowPoliciesDirectory,
 		maintenanceWindowPolicyName,
-		minWindowSize)
-	if err != nil {
-		maintenanceWindowsMetrics.RecordConfigReadSuccess(false)
+		flagVar.MaintenancePolicyReloadInterval,
+		metrics.NewMaintenanceWindowMetrics())
+	if err := policy.Reload(); err != nil {
 		logger.Error(err, "unable to set maintenance windows policy")
-	} else {
-		maintenanceWindowsMetrics.RecordConfigReadSuccess(true)
 	}
-	return maintenanceWindow
+	if err := mgr.Add(policy); err != nil {
+		logger.Error(err, "unable to add maintenance windows policy reload to the manager")
+		os.Exit(bootstrapFailedExitCode)
+	}
+	return maintenancewindows.NewMaintenanceWindow(policy, flagVar.MinMaintenanceWindowSize)
 }
 
 //nolint:ireturn // the implementation is not a part of the public API
@@ -428,8 +467,9 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 	flagVar *flags.FlagVar, options ctrlruntime.Options,
 	skrWebhookManager *watcher.SkrWebhookManifestManager, kymaMetrics *metrics.KymaMetrics,
 	setupLog logr.Logger, maintenanceWindow maintenancewindows.MaintenanceWindow, ociRegistry string,
-	kymaDeletionSvc *kymadeletionsvc.Service, kymaLookupSvc *kymalookupsvc.Service,
-	mtEventHandlerMapFunc handler.MapFunc, mrmEventHandler *mrmwatch.EventHandler,
+	kymaDeletionSvc *kymadeletionsvc.Service, kymaLookupSvc *kymalookupsvc.Service, kymaPlanSvc *kymaplansvc.Service,
+	mtEventHandler handler.EventHandler, mrmEventHandler *mrmwatch.EventHandler,
+	kymaRequeueSource *watch.KymaRequeueSource, imageDigestResolver parser.ImageDigestResolver,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
@@ -437,7 +477,8 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 	options.MaxConcurrentReconciles = flagVar.MaxConcurrentKymaReconciles
 
 	moduleTemplateInfoLookup := moduletemplateinfolookup.NewWithMaintenanceWindowDecorator(maintenanceWindow,
-		moduletemplateinfolookup.NewLookup(mgr.GetClient()))
+		moduletemplateinfolookup.NewWithRolloutDecorator(
+			moduletemplateinfolookup.NewWithRevocationDecorator(moduletemplateinfolookup.NewLookup(mgr.GetClient()))))
 
 	kcpClient := mgr.GetClient()
 	moduleStatusGen := generator.NewModuleStatusGenerator(fromerror.GenerateModuleStatusFromError)
@@ -468,6 +509,8 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 		SkrSyncService:       skrSyncService,
 		ModulesStatusHandler: modulesStatusHandler,
 		SKRWebhookManager:    skrWebhookManager,
+		PlanService:          kymaPlanSvc,
+		ImageDigestResolver:  imageDigestResolver,
 		RateLimiter:          options.RateLimiter,
 		RequeueIntervals: queue.RequeueIntervals{
 			Success: flagVar.KymaRequeueSuccessInterval,
@@ -490,8 +533,9 @@ func setupKymaReconciler(mgr ctrl.Manager, descriptorProvider *provider.CachedDe
 			ListenerAddr:   flagVar.KymaListenerAddr,
 			IstioNamespace: flagVar.IstioNamespace,
 		},
-		mtEventHandlerMapFunc,
+		mtEventHandler,
 		mrmEventHandler,
+		kymaRequeueSource,
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Kyma")
 		os.Exit(1)
@@ -503,11 +547,14 @@ func setupManifestReconciler(mgr ctrl.Manager,
 	options ctrlruntime.Options,
 	sharedMetrics *metrics.SharedMetrics,
 	mandatoryModulesMetrics *metrics.MandatoryModulesMetrics,
-	accessManagerService *accessmanager.Service,
+	skrClient *skrclient.Service,
 	setupLog logr.Logger,
 	event event.Event,
 	kymaRepo *kymarepo.Repository,
 	secretRepo *secretrepo.Repository,
+	pathExtractor *img.PathExtractor,
+	chartRenderer *helm.Renderer,
+	resourceProfiles resourceprofile.Profiles,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
@@ -517,19 +564,24 @@ func setupManifestReconciler(mgr ctrl.Manager,
 	manifestClient := manifestclient.NewManifestClient(event, mgr.GetClient())
 	orphanDetectionClient := kymaRepo
 	orphanDetectionService := orphan.NewDetectionService(orphanDetectionClient)
-	specResolver := spec.NewResolver(keychainLookupFromFlag(mgr.GetClient(), flagVar), img.NewPathExtractor())
+	specResolver := spec.NewResolver(keychainLookupFromFlag(mgr.GetClient(), flagVar), pathExtractor,
+		chartRenderer)
 	clientCache := skrclientcache.NewService()
-	skrClient := skrclient.NewService(mgr.GetConfig().QPS, mgr.GetConfig().Burst, accessManagerService)
 
 	kcpClient := mgr.GetClient()
 	cachedManifestParser := parser.NewCachedManifestParser(parser.DefaultInMemoryParseTTL)
 	renderService := manifestrendercmpse.ComposeRenderService(cachedManifestParser, flagVar.SkrImagePullSecret,
-		secretRepo, flagVar.GetRestrictedDefaultModules())
+		secretRepo, flagVar.GetRestrictedDefaultModules(), resourceProfiles)
 	statefulChecker := statecheck.NewStatefulSetStateCheck()
 	deploymentChecker := statecheck.NewDeploymentStateCheck()
-	customStateCheck := statecheck.NewManagerStateCheck(statefulChecker, deploymentChecker)
+	healthCheck := statecheck.NewDefaultHealthCheck(statefulChecker, deploymentChecker,
+		flagVar.HealthReportMaxEntries)
+	moduleCRStateCheck := statecheck.NewCustomStateCheck()
 	managedLabelRemovalService := labelsremoval.NewManagedByLabelRemovalService(manifestClient)
 
+	manifestMetrics := metrics.NewManifestMetrics(sharedMetrics)
+	driftDetection := driftcmpse.ComposeDriftDetection(flagVar, manifestMetrics)
+
 	if err := manifestctrl.SetupWithManager(mgr, options, queue.RequeueIntervals{
 		Success: flagVar.ManifestRequeueSuccessInterval,
 		Busy:    flagVar.ManifestRequeueBusyInterval,
@@ -538,9 +590,9 @@ func setupManifestReconciler(mgr ctrl.Manager,
 		Jitter: queue.NewRequeueJitter(flagVar.ManifestRequeueJitterProbability,
 			flagVar.ManifestRequeueJitterPercentage),
 	}, options.RateLimiter,
-		metrics.NewManifestMetrics(sharedMetrics), mandatoryModulesMetrics, manifestClient, orphanDetectionService,
-		specResolver, clientCache, skrClient, kcpClient, renderService, customStateCheck,
-		managedLabelRemovalService); err != nil {
+		manifestMetrics, mandatoryModulesMetrics, manifestClient, orphanDetectionService,
+		specResolver, clientCache, skrClient, kcpClient, renderService, healthCheck, moduleCRStateCheck,
+		managedLabelRemovalService, driftDetection); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Manifest")
 		os.Exit(bootstrapFailedExitCode)
 	}
@@ -595,6 +647,7 @@ func setupMandatoryModuleReconciler(mgr ctrl.Manager,
 	setupLog logr.Logger,
 	ociRegistry string,
 	mandatoryMrmEventHandler *mrmwatch.EventHandler,
+	imageDigestResolver parser.ImageDigestResolver,
 ) {
 	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
 		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
@@ -602,7 +655,7 @@ func setupMandatoryModuleReconciler(mgr ctrl.Manager,
 	options.MaxConcurrentReconciles = flagVar.MaxConcurrentMandatoryModuleReconciles
 
 	installationService := installation.ComposeInstallationService(mgr.GetClient(), mrmRepo, mtRepo, descriptorProvider,
-		ociRegistry, flagVar.RemoteSyncNamespace, metrics)
+		ociRegistry, flagVar.RemoteSyncNamespace, metrics, imageDigestResolver)
 	installationReconciler := mandatorymodule.NewInstallationReconciler(installationService,
 		queue.RequeueIntervals{
 			Success: flagVar.MandatoryModuleRequeueSuccessInterval,
@@ -644,6 +697,28 @@ func setupMandatoryModuleDeletionReconciler(mgr ctrl.Manager,
 	}
 }
 
+func setupModuleRolloutReconciler(mgr ctrl.Manager,
+	kymaRepo *kymarepo.Repository,
+	mrmRepo *mrmrepo.Repository,
+	kymaRequeueSource *watch.KymaRequeueSource,
+	flagVar *flags.FlagVar,
+	options ctrlruntime.Options,
+	setupLog logr.Logger,
+) {
+	options.RateLimiter = internal.RateLimiter(flagVar.FailureBaseDelay,
+		flagVar.FailureMaxDelay, flagVar.RateLimiterFrequency, flagVar.RateLimiterBurst)
+	options.CacheSyncTimeout = flagVar.CacheSyncTimeout
+
+	rolloutService := modulerolloutcmpse.ComposeModuleRolloutService(kymaRepo, mrmRepo, kymaRequeueSource,
+		flagVar.ModuleRolloutCheckInterval)
+	rolloutReconciler := modulerolloutctrl.NewReconciler(rolloutService)
+
+	if err := rolloutReconciler.SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ModuleRollout")
+		os.Exit(bootstrapFailedExitCode)
+	}
+}
+
 func verifyModuleReleaseMetasForRestrictedDefaultModules(ctx context.Context,
 	kcpClientWithoutCache client.Client,
 	restrictedDefaultModules []string,
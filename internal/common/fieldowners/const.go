@@ -11,6 +11,9 @@ const (
 	LegacyLifecycleManager  = client.FieldOwner(shared.OperatorName)
 	CustomResourceFinalizer = client.FieldOwner("resource.kyma-project.io/finalizer")
 	DeclarativeApplier      = client.FieldOwner("declarative.kyma-project.io/applier")
+	// KeptFieldsApplier manages the fields of module resources whose changes the drift policy keeps, once the
+	// DeclarativeApplier stops applying them.
+	KeptFieldsApplier       = client.FieldOwner("declarative.kyma-project.io/kept-fields")
 	ModuleCatalogSync       = client.FieldOwner("catalog-sync")
 	KymaSyncContextProvider = client.FieldOwner("kyma-sync-context")
 )
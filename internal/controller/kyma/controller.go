@@ -45,8 +45,10 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/result/kyma/usecase"
 	"github.com/kyma-project/lifecycle-manager/internal/service/accessmanager"
 	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/parser"
+	"github.com/kyma-project/lifecycle-manager/internal/service/moduledeprecation"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/dependency"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 	"github.com/kyma-project/lifecycle-manager/pkg/status"
@@ -66,6 +68,8 @@ const (
 	updateSpecError   event.Reason = "UpdateSpecError"
 	updateStatusError event.Reason = "UpdateStatusError"
 	patchStatusError  event.Reason = "PatchStatus"
+	moduleRollback    event.Reason = "ModuleRollback"
+	moduleDeprecated  event.Reason = "ModuleDeprecated"
 )
 
 type DeletionMetricWriter interface {
@@ -94,6 +98,10 @@ type ModuleStatusHandler interface {
 	UpdateModuleStatuses(ctx context.Context, kyma *v1beta2.Kyma, modules modulecommon.Modules) error
 }
 
+type PlanService interface {
+	Plan(ctx context.Context, kyma *v1beta2.Kyma, modules modulecommon.Modules) (*v1beta2.ReconcilePlan, error)
+}
+
 type SkrSyncService interface {
 	SyncCRDs(ctx context.Context, kyma *v1beta2.Kyma) error
 	SyncImagePullSecret(ctx context.Context, kyma types.NamespacedName) error
@@ -120,6 +128,8 @@ type Reconciler struct {
 	SkrSyncService       SkrSyncService
 	ModulesStatusHandler ModuleStatusHandler
 	SKRWebhookManager    SKRWebhookManager
+	PlanService          PlanService
+	ImageDigestResolver  parser.ImageDigestResolver
 
 	Metrics        *metrics.KymaMetrics
 	RemoteCatalog  *remote.RemoteCatalog
@@ -203,7 +213,9 @@ func (r *Reconciler) ValidateDefaultChannel(kyma *v1beta2.Kyma) error {
 	return nil
 }
 
-func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1beta2.Kyma) error {
+func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1beta2.Kyma,
+	modules modulecommon.Modules,
+) error {
 	moduleStatus := kyma.GetNoLongerExistingModuleStatus()
 	var err error
 	if len(moduleStatus) == 0 {
@@ -214,6 +226,12 @@ func (r *Reconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1
 		if moduleStatus.Manifest == nil {
 			continue
 		}
+		// Modules still required by enabled modules are kept until their dependents are removed.
+		if requiredErr := dependency.RequiredByOtherModules(modules, moduleStatus.Name); requiredErr != nil {
+			moduleStatus.State = shared.StateWarning
+			moduleStatus.Message = requiredErr.Error()
+			continue
+		}
 		err = r.deleteManifest(ctx, moduleStatus.Manifest)
 	}
 
@@ -645,21 +663,36 @@ func (r *Reconciler) updateKyma(ctx context.Context, kyma *v1beta2.Kyma) error {
 
 func (r *Reconciler) reconcileManifests(ctx context.Context, kyma *v1beta2.Kyma) error {
 	templates := r.TemplateLookup.GetRegularTemplates(ctx, kyma)
-	prsr := parser.NewParser(r.Client, r.DescriptorProvider, r.Config.RemoteSyncNamespace, r.Config.OCIRegistry)
-	modules := prsr.GenerateModulesFromTemplates(kyma, templates)
+	prsr := parser.NewParser(r.Client, r.DescriptorProvider, r.Config.RemoteSyncNamespace, r.Config.OCIRegistry,
+		parser.WithImageDigestResolver(r.ImageDigestResolver))
+	modules := prsr.GenerateModulesFromTemplates(ctx, kyma, templates)
+
+	// In dry-run, the changes to the Manifests are only reported in the status and not applied.
+	if kyma.IsDryRun() {
+		plan, err := r.PlanService.Plan(ctx, kyma, modules)
+		if err != nil {
+			return fmt.Errorf("failed to plan manifests: %w", err)
+		}
+		kyma.Status.Plan = plan
+		return nil
+	}
+	kyma.Status.Plan = nil
 
 	runner := sync.New(r)
 	if err := runner.ReconcileManifests(ctx, kyma, modules); err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
+	r.recordDeprecations(kyma, modules)
 
+	previousVersions := moduleVersions(kyma)
 	err := r.ModulesStatusHandler.UpdateModuleStatuses(ctx, kyma, modules)
 	if err != nil {
 		return fmt.Errorf("failed to update module statuses: %w", err)
 	}
+	r.recordRollbacks(kyma, modules, previousVersions)
 
 	// If module get removed from kyma, the module deletion happens here.
-	if err := r.DeleteNoLongerExistingModules(ctx, kyma); err != nil {
+	if err := r.DeleteNoLongerExistingModules(ctx, kyma, modules); err != nil {
 		return fmt.Errorf("error while syncing conditions during deleting non exists modules: %w", err)
 	}
 	return nil
@@ -707,6 +740,59 @@ func (r *Reconciler) deleteOrphanedCertificate(ctx context.Context, kymaName str
 	return nil
 }
 
+// recordRollbacks emits an event for the modules rolled back to a lower version once the module status reports the
+// version for the first time, that is, once its Manifest was synced.
+func (r *Reconciler) recordRollbacks(kyma *v1beta2.Kyma, modules modulecommon.Modules,
+	previousVersions map[string]string,
+) {
+	currentVersions := moduleVersions(kyma)
+	for _, module := range modules {
+		if module.TemplateInfo == nil || module.TemplateInfo.Err != nil || module.TemplateInfo.RollbackFrom == "" {
+			continue
+		}
+		version := module.TemplateInfo.Spec.Version
+		if currentVersions[module.ModuleName] != version || previousVersions[module.ModuleName] == version {
+			continue
+		}
+		r.Event.Normal(kyma, moduleRollback, fmt.Sprintf("module %s rolled back from version %s to %s",
+			module.ModuleName, module.TemplateInfo.RollbackFrom, version))
+	}
+}
+
+func moduleVersions(kyma *v1beta2.Kyma) map[string]string {
+	versions := make(map[string]string, len(kyma.Status.Modules))
+	for _, module := range kyma.Status.Modules {
+		versions[module.Name] = module.Version
+	}
+	return versions
+}
+
+// recordDeprecations reports the deprecated modules and channels in use by the Kyma in its conditions and metrics.
+// The warning events are only emitted once the deprecation shows up in the ModuleDeprecation condition for the first
+// time.
+func (r *Reconciler) recordDeprecations(kyma *v1beta2.Kyma, modules modulecommon.Modules) {
+	var notices []moduledeprecation.Notice
+	var deprecatedModules []metrics.DeprecatedModule
+	for _, module := range modules {
+		if module.TemplateInfo == nil || module.TemplateInfo.Err != nil {
+			continue
+		}
+		for _, notice := range module.TemplateInfo.Deprecations {
+			notices = append(notices, notice)
+			deprecatedModules = append(deprecatedModules, metrics.DeprecatedModule{
+				ModuleName: notice.ModuleName,
+				Channel:    notice.Channel,
+				SunsetDate: notice.SunsetDate,
+			})
+		}
+	}
+
+	for _, notice := range moduledeprecation.Announce(kyma, notices) {
+		r.Event.Warning(kyma, moduleDeprecated, fmt.Errorf("%w: %s", moduledeprecation.ErrDeprecated, notice.Message()))
+	}
+	r.Metrics.SetDeprecatedModules(kyma.Name, deprecatedModules)
+}
+
 func useLegacyKymaDeletion() bool {
 	envValue, isDefined := os.LookupEnv("ENABLE_LEGACY_KYMA_DELETION")
 	return isDefined && envValue == "true"
@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/controller"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
 	mrmwatch "github.com/kyma-project/lifecycle-manager/internal/watch/modulereleasemeta"
 )
 
@@ -29,8 +30,9 @@ const controllerName = "kyma"
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager,
 	opts ctrlruntime.Options,
 	settings SetupOptions,
-	mtEventHandlerMapFunc handler.MapFunc,
+	mtEventHandler handler.EventHandler,
 	mrmEventHandler *mrmwatch.EventHandler,
+	kymaRequeueSource *watch.KymaRequeueSource,
 ) error {
 	runnableListener := watcherevent.NewSKREventListener(
 		settings.ListenerAddr,
@@ -45,7 +47,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager,
 		Named(controllerName).
 		WithOptions(opts).
 		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})).
-		Watches(&v1beta2.ModuleTemplate{}, handler.EnqueueRequestsFromMapFunc(mtEventHandlerMapFunc)).
+		Watches(&v1beta2.ModuleTemplate{}, mtEventHandler).
 		Watches(&v1beta2.ModuleReleaseMeta{}, mrmEventHandler).
 		Watches(&apicorev1.Secret{}, handler.Funcs{}).
 		Watches(&v1beta2.Manifest{},
@@ -53,6 +55,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager,
 				handler.OnlyControllerOwner()), builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
 		WatchesRawSource(source.Channel(controller.AdaptEvents(runnableListener.ReceivedEvents),
 			CreateSkrEventHandler(&kymaNameLookupAdapter{r.LookupService}))).
+		WatchesRawSource(source.Channel(kymaRequeueSource.Events(), &handler.EnqueueRequestForObject{})).
 		Complete(r); err != nil {
 		return fmt.Errorf("failed to setup manager for kyma controller: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/client-go/util/workqueue"
@@ -15,6 +16,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/finalizer"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
@@ -32,7 +34,8 @@ import (
 )
 
 var (
-	errManagerInErrorState            = errors.New("manager is in error state")
+	errResourcesInErrorState          = errors.New("module resources are in error state")
+	errModuleCRInErrorState           = errors.New("module CR is in error state according to custom state check")
 	errStateRequireUpdate             = errors.New("manifest state requires update")
 	errResourceSyncDiffInSameOCILayer = errors.New("resource syncTarget diff detected but in " +
 		"same oci layer, prevent sync resource to be deleted")
@@ -107,9 +110,16 @@ type ResourceRenderService interface {
 }
 
 // StateCheck reports the aggregated readiness state of a set of resources
-// applied to the SKR.
+// applied to the SKR, together with the health of the individual resources.
 type StateCheck interface {
-	GetState(ctx context.Context, clnt client.Client, resources []client.Object) (shared.State, error)
+	GetState(ctx context.Context, clnt client.Client, resources []client.Object) (shared.State,
+		[]shared.ResourceHealth, error)
+}
+
+// ModuleCRStateCheck maps the default module CR (or the manager) to a state
+// using the CustomStateCheck rules declared in the Manifest.
+type ModuleCRStateCheck interface {
+	GetState(ctx context.Context, clnt client.Client, manifest *v1beta2.Manifest) (shared.State, error)
 }
 
 // ManagedByLabelRemoval handles the cleanup of the managed-by label from
@@ -118,12 +128,19 @@ type ManagedByLabelRemoval interface {
 	RemoveManagedByLabel(ctx context.Context, manifest *v1beta2.Manifest, skrClient client.Client) error
 }
 
+// DriftDetection creates the collectors that report the fields of the synced resources managed by unknown field
+// managers in the Manifest status.
+type DriftDetection interface {
+	NewCollector(manifest *v1beta2.Manifest) skrresources.ManagedFieldsCollector
+}
+
 type Reconciler struct {
 	requeueIntervals queue.RequeueIntervals
 	rateLimiter      workqueue.TypedRateLimiter[ctrl.Request]
 	kcpClient        client.Client
 	renderService    ResourceRenderService
-	customStateCheck StateCheck
+	healthCheck      StateCheck
+	moduleCRState    ModuleCRStateCheck
 
 	manifestMetrics            *metrics.ManifestMetrics
 	mandatoryModuleMetrics     *metrics.MandatoryModulesMetrics
@@ -133,6 +150,7 @@ type Reconciler struct {
 	orphanDetectionService     OrphanDetectionService
 	skrClientCache             SKRClientCache
 	skrClient                  SKRClient
+	driftDetection             DriftDetection
 }
 
 func NewReconciler(requeueIntervals queue.RequeueIntervals,
@@ -146,15 +164,18 @@ func NewReconciler(requeueIntervals queue.RequeueIntervals,
 	skrClient SKRClient,
 	kcpClient client.Client,
 	renderService ResourceRenderService,
-	stateCheck StateCheck,
+	healthCheck StateCheck,
+	moduleCRStateCheck ModuleCRStateCheck,
 	managedLabelRemovalService ManagedByLabelRemoval,
+	driftDetection DriftDetection,
 ) *Reconciler {
 	return &Reconciler{
 		requeueIntervals:           requeueIntervals,
 		rateLimiter:                rateLimiter,
 		kcpClient:                  kcpClient,
 		renderService:              renderService,
-		customStateCheck:           stateCheck,
+		healthCheck:                healthCheck,
+		moduleCRState:              moduleCRStateCheck,
 		manifestMetrics:            manifestMetrics,
 		mandatoryModuleMetrics:     mandatoryModulesMetrics,
 		specResolver:               specResolver,
@@ -163,6 +184,7 @@ func NewReconciler(requeueIntervals queue.RequeueIntervals,
 		orphanDetectionService:     orphanDetectionService,
 		skrClientCache:             clientCache,
 		skrClient:                  skrClient,
+		driftDetection:             driftDetection,
 	}
 }
 
@@ -213,6 +235,8 @@ func (r *Reconciler) install(ctx context.Context, req ctrl.Request,
 	}
 
 	target, current, err := r.renderResourcesForInstall(ctx, skrClient, manifest, spec)
+	// the rendered resources are all that is needed from the installation layer, so it can be evicted again
+	spec.Release()
 	if err != nil {
 		// The inject-data-from-kcp transform marks an unrecoverable misconfiguration
 		// of the deployer module's secret wiring. Surface it as StateError so the
@@ -297,7 +321,7 @@ func (r *Reconciler) prepareReconcile(ctx context.Context, req ctrl.Request,
 
 // resolveAndTrackSpec resolves the install spec and persists the initial
 // synced-OCI-ref annotation. A non-nil *stepResult signals the caller must
-// return immediately.
+// return immediately; otherwise the caller must release the returned spec.
 func (r *Reconciler) resolveAndTrackSpec(ctx context.Context, req ctrl.Request,
 	manifest *v1beta2.Manifest, manifestStatus shared.Status,
 ) (*spec.Spec, *stepResult) {
@@ -308,6 +332,7 @@ func (r *Reconciler) resolveAndTrackSpec(ctx context.Context, req ctrl.Request,
 	}
 
 	if notContainsSyncedOCIRefAnnotation(manifest) {
+		spec.Release()
 		updateSyncedOCIRefAnnotation(manifest, spec.OCIRef)
 		return nil, stopReconcile(r.updateManifest(ctx, req, manifest, metrics.ManifestInitSyncedOCIRef))
 	}
@@ -330,7 +355,8 @@ func (r *Reconciler) pruneAndSync(ctx context.Context, req ctrl.Request, skrClie
 		return stopReconcile(r.finishReconcile(ctx, manifest, metrics.ManifestPruneDiff, manifestStatus, err))
 	}
 
-	if err := skrresources.SyncResources(ctx, skrClient, manifest, target); err != nil {
+	if err := skrresources.SyncResources(ctx, skrClient, manifest, target,
+		r.driftDetection.NewCollector(manifest)); err != nil {
 		return stopReconcile(r.finishReconcile(ctx, manifest, metrics.ManifestSyncResources, manifestStatus, err))
 	}
 
@@ -355,6 +381,7 @@ func (r *Reconciler) delete(ctx context.Context, req ctrl.Request,
 	}
 
 	target, current, err := r.renderResourcesForDelete(ctx, skrClient, manifest, spec)
+	spec.Release()
 	if err != nil {
 		return r.finishReconcile(ctx, manifest, metrics.ManifestRenderResources, manifestStatus, err)
 	}
@@ -379,7 +406,9 @@ func (r *Reconciler) delete(ctx context.Context, req ctrl.Request,
 		return r.finishReconcile(ctx, manifest, metrics.ManifestPreDelete, manifestStatus, err)
 	}
 
-	if err := skrresources.SyncResources(ctx, skrClient, manifest, target); err != nil {
+	// the resources are about to be deleted, so their drift is not reported anymore
+	if err := skrresources.SyncResources(ctx, skrClient, manifest, target,
+		skrresources.NoopCollector{}); err != nil {
 		return r.finishReconcile(ctx, manifest, metrics.ManifestSyncResources, manifestStatus, err)
 	}
 
@@ -445,6 +474,7 @@ func (r *Reconciler) resolveAndTrackSpecForDelete(ctx context.Context, req ctrl.
 	}
 
 	if notContainsSyncedOCIRefAnnotation(manifest) {
+		spec.Release()
 		updateSyncedOCIRefAnnotation(manifest, spec.OCIRef)
 		return nil, stopReconcile(r.updateManifest(ctx, req, manifest, metrics.ManifestInitSyncedOCIRef))
 	}
@@ -560,6 +590,9 @@ func (r *Reconciler) renderResourcesForDelete(ctx context.Context, skrClient skr
 		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateDeleting).
 			WithOperation("waiting for module crs deletion"))
 		return nil, nil, err
+	case errors.Is(err, modulecr.ErrWaitingForAssociatedResourcesDeletion):
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateDeleting).WithOperation(err.Error()))
+		return nil, nil, err
 	case err != nil:
 		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
 		return nil, nil, err
@@ -575,15 +608,28 @@ func (r *Reconciler) renderResourcesForDelete(ctx context.Context, skrClient skr
 	return target, current, nil
 }
 
+// ensureModuleCRsAllDeleted reports whether the module CRs, the default module CR and the associated resources are
+// all gone. The associated resources are only checked once the default module CR is deleted, as the module's operator
+// may keep them until its default CR is removed.
 func ensureModuleCRsAllDeleted(ctx context.Context, skrClient skrclient.Client, manifest *v1beta2.Manifest) (
 	bool,
 	error,
 ) {
-	if err := modulecr.NewClient(skrClient).CheckModuleCRsDeletion(ctx, manifest); err != nil {
+	moduleCRClient := modulecr.NewClient(skrClient)
+	if err := moduleCRClient.CheckModuleCRsDeletion(ctx, manifest); err != nil {
+		return false, err
+	}
+
+	defaultCRDeleted, err := moduleCRClient.CheckDefaultCRDeletion(ctx, manifest)
+	if err != nil || !defaultCRDeleted {
 		return false, err
 	}
 
-	return modulecr.NewClient(skrClient).CheckDefaultCRDeletion(ctx, manifest)
+	if err := moduleCRClient.CheckAssociatedResourcesDeletion(ctx, manifest); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
 func (r *Reconciler) handleUnmanagedManifest(ctx context.Context, req ctrl.Request,
@@ -615,14 +661,22 @@ func (r *Reconciler) syncDefaultModuleCR(ctx context.Context, skrClient skrclien
 func (r *Reconciler) updateManifestStateAfterSync(ctx context.Context, skrClient skrclient.Client,
 	manifest *v1beta2.Manifest, target []client.Object,
 ) error {
+	resourcesState, health, err := r.checkResourcesState(ctx, skrClient, target)
+	manifest.SetStatus(manifest.GetStatus().WithHealth(health))
 	manifestStatus := manifest.GetStatus()
-	managerState, err := r.checkManagerState(ctx, skrClient, target)
 	if err != nil {
 		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
 		return err
 	}
-	if status.RequireManifestStateUpdateAfterSyncResource(manifest, managerState) {
-		return fmt.Errorf("%w: from %s to %s", errStateRequireUpdate, manifestStatus.State, managerState)
+	if resourcesState == shared.StateReady {
+		resourcesState, err = r.checkModuleCRState(ctx, skrClient, manifest)
+		if err != nil {
+			manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
+			return err
+		}
+	}
+	if status.RequireManifestStateUpdateAfterSyncResource(manifest, resourcesState) {
+		return fmt.Errorf("%w: from %s to %s", errStateRequireUpdate, manifestStatus.State, resourcesState)
 	}
 	return nil
 }
@@ -635,24 +689,55 @@ func (r *Reconciler) updateDeletingState(manifest *v1beta2.Manifest) error {
 	return nil
 }
 
-func (r *Reconciler) checkManagerState(ctx context.Context, clnt skrclient.Client,
+func (r *Reconciler) checkResourcesState(ctx context.Context, clnt skrclient.Client,
 	target []client.Object,
+) (shared.State, []shared.ResourceHealth, error) {
+	resourcesState, health, err := r.healthCheck.GetState(ctx, clnt, target)
+	if err != nil {
+		return shared.StateError, nil, err
+	}
+	if resourcesState == shared.StateError {
+		return shared.StateError, health, fmt.Errorf("%w: %s", errResourcesInErrorState, failingResources(health))
+	}
+	return resourcesState, health, nil
+}
+
+func failingResources(health []shared.ResourceHealth) string {
+	var failing []string
+	for _, resourceHealth := range health {
+		if resourceHealth.State == shared.StateError {
+			failing = append(failing, fmt.Sprintf("%s %s: %s", resourceHealth.Kind,
+				resourceHealth.Name, resourceHealth.Message))
+		}
+	}
+	return strings.Join(failing, "; ")
+}
+
+// checkModuleCRState evaluates the CustomStateCheck rules of the Manifest. It is
+// only consulted once all resources are ready, so a module can never be reported as
+// Ready while its resources are still rolling out.
+func (r *Reconciler) checkModuleCRState(ctx context.Context, clnt skrclient.Client,
+	manifest *v1beta2.Manifest,
 ) (shared.State, error) {
-	managerReadyCheck := r.customStateCheck
-	managerState, err := managerReadyCheck.GetState(ctx, clnt, target)
+	moduleCRState, err := r.moduleCRState.GetState(ctx, clnt, manifest)
 	if err != nil {
 		return shared.StateError, err
 	}
-	if managerState == shared.StateError {
-		return shared.StateError, errManagerInErrorState
+	if moduleCRState == shared.StateError {
+		return shared.StateError, errModuleCRInErrorState
 	}
-	return managerState, nil
+	return moduleCRState, nil
 }
 
 func (r *Reconciler) pruneDiff(ctx context.Context, clnt skrclient.Client, manifest *v1beta2.Manifest,
 	current ResourceList, target []client.Object, spec *spec.Spec,
 ) error {
-	diff := pruneResource(current.Difference(target), "Namespace", shared.DefaultRemoteNamespace)
+	// resources kept by the drift policy are only pruned when the Manifest is deleted
+	var policy driftpolicy.Policy
+	if manifest.GetDeletionTimestamp().IsZero() {
+		policy = manifest.Spec.DriftPolicy
+	}
+	diff := ResourceList(skrresources.PrunedResources(current, target, policy))
 	if len(diff) == 0 {
 		return nil
 	}
@@ -709,15 +794,6 @@ func updateSyncedOCIRefAnnotation(manifest *v1beta2.Manifest, ref string) {
 	manifest.SetAnnotations(annotations)
 }
 
-func pruneResource(diff ResourceList, resourceType string, resourceName string) ResourceList {
-	for index, res := range diff {
-		if res.Kind == resourceType && res.Name == resourceName {
-			return append(diff[:index], diff[index+1:]...)
-		}
-	}
-	return diff
-}
-
 func (r *Reconciler) getTargetClient(ctx context.Context, manifest *v1beta2.Manifest) (skrclient.Client, error) {
 	var err error
 	var clnt *skrclient.SKRClient
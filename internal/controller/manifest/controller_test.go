@@ -5,58 +5,62 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/modulecr"
 )
 
-func makeRes(name, namespace, kind string) shared.Resource {
-	return shared.Resource{
-		Name:      name,
-		Namespace: namespace,
-		GroupVersionKind: apimetav1.GroupVersionKind{
-			Kind: kind,
-		},
-	}
-}
-
-func TestPruneResource(t *testing.T) {
+func TestEnsureModuleCRsAllDeleted_ChecksAssociatedResourcesAfterDefaultCR(t *testing.T) {
 	t.Parallel()
-	kubeNs := makeRes("kube-system", "", "Namespace")
-	service := makeRes("some-service", "default", "Service")
-	kymaNs := makeRes("kyma-system", "", "Namespace")
-	deployment := makeRes("some-deploy", "default", "Deployment")
-	crd := makeRes("btpoperator", "", "CustomResourceDefinition")
-
-	t.Run("contains kyma-system", func(t *testing.T) {
-		t.Parallel()
+	moduleCRGVK := schema.GroupVersionKind{Group: shared.OperatorGroup, Version: "v1alpha1", Kind: "Sample"}
+	associatedGVK := schema.GroupVersionKind{Group: shared.OperatorGroup, Version: "v1alpha1", Kind: "Managed"}
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{moduleCRGVK.GroupVersion()})
+	mapper.Add(moduleCRGVK, meta.RESTScopeNamespace)
+	mapper.Add(associatedGVK, meta.RESTScopeNamespace)
+	skrClient := fake.NewClientBuilder().WithRESTMapper(mapper).Build()
 
-		diff := ResourceList{kubeNs, service, kymaNs, deployment}
-		result := pruneResource(diff, "Namespace", shared.DefaultRemoteNamespace)
+	// Given a default module CR and an associated resource the operator keeps until the default CR is gone
+	defaultCR := &unstructured.Unstructured{}
+	defaultCR.SetGroupVersionKind(moduleCRGVK)
+	defaultCR.SetName("default-resource")
+	defaultCR.SetNamespace(shared.DefaultRemoteNamespace)
+	require.NoError(t, skrClient.Create(t.Context(), defaultCR.DeepCopy()))
 
-		require.Len(t, result, 3)
-		require.NotContains(t, result, kymaNs)
-	})
+	associatedResource := &unstructured.Unstructured{}
+	associatedResource.SetGroupVersionKind(associatedGVK)
+	associatedResource.SetName("managed-resource")
+	associatedResource.SetNamespace(shared.DefaultRemoteNamespace)
+	require.NoError(t, skrClient.Create(t.Context(), associatedResource.DeepCopy()))
 
-	t.Run("prune a crd", func(t *testing.T) {
-		t.Parallel()
+	manifest := &v1beta2.Manifest{ObjectMeta: apimetav1.ObjectMeta{Name: "test-manifest"}}
+	manifest.Spec.Resource = defaultCR
+	manifest.Spec.AssociatedResources = []apimetav1.GroupVersionKind{apimetav1.GroupVersionKind(associatedGVK)}
 
-		diff := ResourceList{kubeNs, service, kymaNs, deployment, crd}
-		result := pruneResource(diff, "CustomResourceDefinition", "btpoperator")
+	// When the default CR still exists
+	// Then the deletion is not blocked by the associated resource, so that the default CR can be removed
+	deleted, err := ensureModuleCRsAllDeleted(t.Context(), skrClient, manifest)
+	require.NoError(t, err)
+	require.False(t, deleted)
 
-		require.Len(t, result, 4)
-		require.NotContains(t, result, crd)
-	})
+	// When the default CR is removed but the associated resource still exists
+	require.NoError(t, skrClient.Delete(t.Context(), defaultCR.DeepCopy()))
 
-	t.Run("does not contain kyma-system", func(t *testing.T) {
-		t.Parallel()
+	// Then the deletion waits for the associated resource
+	deleted, err = ensureModuleCRsAllDeleted(t.Context(), skrClient, manifest)
+	require.ErrorIs(t, err, modulecr.ErrWaitingForAssociatedResourcesDeletion)
+	require.False(t, deleted)
 
-		diff := ResourceList{kubeNs, service, deployment}
-		result := pruneResource(diff, "Namespace", shared.DefaultRemoteNamespace)
+	// When the operator removed the associated resource
+	require.NoError(t, skrClient.Delete(t.Context(), associatedResource.DeepCopy()))
 
-		require.Len(t, result, 3)
-		require.Contains(t, result, kubeNs)
-		require.Contains(t, result, service)
-		require.Contains(t, result, deployment)
-	})
+	// Then all module CRs are deleted
+	deleted, err = ensureModuleCRsAllDeleted(t.Context(), skrClient, manifest)
+	require.NoError(t, err)
+	require.True(t, deleted)
 }
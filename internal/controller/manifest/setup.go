@@ -37,8 +37,10 @@ func SetupWithManager(mgr manager.Manager,
 	skrClient SKRClient,
 	kcpClient client.Client,
 	renderService ResourceRenderService,
-	customStateCheck StateCheck,
+	healthCheck StateCheck,
+	moduleCRStateCheck ModuleCRStateCheck,
 	managedLabelRemovalService ManagedByLabelRemoval,
+	driftDetection DriftDetection,
 ) error {
 	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta2.Manifest{}).
@@ -50,7 +52,7 @@ func SetupWithManager(mgr manager.Manager,
 		Complete(NewReconciler(
 			requeueIntervals, rateLimiter, manifestMetrics, mandatoryModulesMetrics, manifestClient,
 			orphanDetectionService, specResolver, skrClientCache, skrClient, kcpClient, renderService,
-			customStateCheck, managedLabelRemovalService)); err != nil {
+			healthCheck, moduleCRStateCheck, managedLabelRemovalService, driftDetection)); err != nil {
 		return fmt.Errorf("failed to setup manager for manifest controller: %w", err)
 	}
 
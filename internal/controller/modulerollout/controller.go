@@ -0,0 +1,36 @@
+package modulerollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+type RolloutService interface {
+	UpdateRollouts(ctx context.Context, mrm *v1beta2.ModuleReleaseMeta) (time.Duration, error)
+}
+
+type Reconciler struct {
+	rolloutService RolloutService
+}
+
+func NewReconciler(rolloutService RolloutService) *Reconciler {
+	return &Reconciler{
+		rolloutService: rolloutService,
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, mrm *v1beta2.ModuleReleaseMeta) (ctrl.Result, error) {
+	if !mrm.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	requeueAfter, err := r.rolloutService.UpdateRollouts(ctx, mrm)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("module rollout reconciliation failed: %w", err)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
@@ -0,0 +1,66 @@
+package modulerollout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/controller/modulerollout"
+)
+
+func TestReconciler_Reconcile_RequeuesAfterRolloutInterval(t *testing.T) {
+	t.Parallel()
+
+	rolloutService := &rolloutServiceStub{requeueAfter: time.Minute}
+	reconciler := modulerollout.NewReconciler(rolloutService)
+
+	result, err := reconciler.Reconcile(context.Background(), &v1beta2.ModuleReleaseMeta{})
+
+	require.NoError(t, err)
+	require.True(t, rolloutService.called)
+	require.Equal(t, ctrl.Result{RequeueAfter: time.Minute}, result)
+}
+
+func TestReconciler_Reconcile_WhenUpdateRolloutsFails_ReturnsError(t *testing.T) {
+	t.Parallel()
+
+	rolloutErr := errors.New("rollout error")
+	reconciler := modulerollout.NewReconciler(&rolloutServiceStub{err: rolloutErr})
+
+	result, err := reconciler.Reconcile(context.Background(), &v1beta2.ModuleReleaseMeta{})
+
+	require.ErrorIs(t, err, rolloutErr)
+	require.Equal(t, ctrl.Result{}, result)
+}
+
+func TestReconciler_Reconcile_WhenMrmIsDeleting_SkipsRollouts(t *testing.T) {
+	t.Parallel()
+
+	rolloutService := &rolloutServiceStub{}
+	reconciler := modulerollout.NewReconciler(rolloutService)
+	mrm := &v1beta2.ModuleReleaseMeta{}
+	mrm.SetDeletionTimestamp(&apimetav1.Time{Time: time.Now()})
+
+	result, err := reconciler.Reconcile(context.Background(), mrm)
+
+	require.NoError(t, err)
+	require.False(t, rolloutService.called)
+	require.Equal(t, ctrl.Result{}, result)
+}
+
+type rolloutServiceStub struct {
+	called       bool
+	requeueAfter time.Duration
+	err          error
+}
+
+func (s *rolloutServiceStub) UpdateRollouts(_ context.Context, _ *v1beta2.ModuleReleaseMeta) (time.Duration, error) {
+	s.called = true
+	return s.requeueAfter, s.err
+}
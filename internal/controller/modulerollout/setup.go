@@ -0,0 +1,26 @@
+package modulerollout
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlruntime "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const controllerName = "module-rollout"
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts ctrlruntime.Options) error {
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta2.ModuleReleaseMeta{}).
+		Named(controllerName).
+		WithOptions(opts).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(reconcile.AsReconciler[*v1beta2.ModuleReleaseMeta](mgr.GetClient(), r)); err != nil {
+		return fmt.Errorf("failed to setup manager for module rollout controller: %w", err)
+	}
+	return nil
+}
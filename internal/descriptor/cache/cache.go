@@ -1,34 +1,173 @@
 package cache
 
 import (
+	"container/list"
 	"sync"
+	"time"
 
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
 )
 
+const (
+	DefaultMaxEntries = 1000
+	DefaultTTL        = 24 * time.Hour
+)
+
+type Metrics interface {
+	RecordHit()
+	RecordMiss()
+	RecordSize(entries int)
+}
+
+// DescriptorCache holds component descriptors in memory. It is bounded to a maximum number of entries, evicting the
+// least recently used entry when it is full, and entries expire after a TTL, so that descriptors re-pushed under an
+// existing version are eventually reloaded.
 type DescriptorCache struct {
-	cache sync.Map
+	maxEntries int
+	ttl        time.Duration
+	metrics    Metrics
+	// onEvict is called with the cache lock held for entries removed because of the bound or the TTL
+	onEvict func(key DescriptorKey)
+
+	mu      sync.Mutex
+	entries map[DescriptorKey]*list.Element
+	// lru orders the entries from the most recently to the least recently used one
+	lru *list.List
+}
+
+type entry struct {
+	key        DescriptorKey
+	descriptor *types.Descriptor
+	expiresAt  time.Time
+}
+
+func NewDescriptorCache(opts ...func(*DescriptorCache) *DescriptorCache) *DescriptorCache {
+	descriptorCache := &DescriptorCache{
+		maxEntries: DefaultMaxEntries,
+		ttl:        DefaultTTL,
+		metrics:    noopMetrics{},
+		entries:    map[DescriptorKey]*list.Element{},
+		lru:        list.New(),
+	}
+	for _, opt := range opts {
+		descriptorCache = opt(descriptorCache)
+	}
+	return descriptorCache
 }
 
-func NewDescriptorCache() *DescriptorCache {
-	return &DescriptorCache{
-		cache: sync.Map{},
+// WithMaxEntries bounds the cache to the given number of entries. Zero or less disables the bound.
+func WithMaxEntries(maxEntries int) func(*DescriptorCache) *DescriptorCache {
+	return func(descriptorCache *DescriptorCache) *DescriptorCache {
+		descriptorCache.maxEntries = maxEntries
+		return descriptorCache
+	}
+}
+
+// WithTTL sets the time after which an entry expires. Zero or less disables the expiry.
+func WithTTL(ttl time.Duration) func(*DescriptorCache) *DescriptorCache {
+	return func(descriptorCache *DescriptorCache) *DescriptorCache {
+		descriptorCache.ttl = ttl
+		return descriptorCache
+	}
+}
+
+func WithMetrics(metrics Metrics) func(*DescriptorCache) *DescriptorCache {
+	return func(descriptorCache *DescriptorCache) *DescriptorCache {
+		descriptorCache.metrics = metrics
+		return descriptorCache
 	}
 }
 
 func (d *DescriptorCache) Get(key DescriptorKey) *types.Descriptor {
-	value, ok := d.cache.Load(key)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	element, ok := d.entries[key]
 	if !ok {
+		d.metrics.RecordMiss()
 		return nil
 	}
-	desc, ok := value.(*types.Descriptor)
-	if !ok {
+	cached, _ := element.Value.(*entry)
+	if d.isExpired(cached) {
+		d.evictElement(element)
+		d.metrics.RecordMiss()
+		d.metrics.RecordSize(d.lru.Len())
 		return nil
 	}
+	d.lru.MoveToFront(element)
+	d.metrics.RecordHit()
 
-	return &types.Descriptor{ComponentDescriptor: desc.Copy()}
+	return &types.Descriptor{ComponentDescriptor: cached.descriptor.Copy()}
 }
 
 func (d *DescriptorCache) Set(key DescriptorKey, value *types.Descriptor) {
-	d.cache.Store(key, value)
+	d.set(key, value, time.Now())
+}
+
+// set stores the value as if it was fetched at fetchedAt, which is when its TTL starts.
+func (d *DescriptorCache) set(key DescriptorKey, value *types.Descriptor, fetchedAt time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cached := &entry{key: key, descriptor: value}
+	if d.ttl > 0 {
+		cached.expiresAt = fetchedAt.Add(d.ttl)
+	}
+	if element, ok := d.entries[key]; ok {
+		element.Value = cached
+		d.lru.MoveToFront(element)
+	} else {
+		d.entries[key] = d.lru.PushFront(cached)
+	}
+	d.evict()
+	d.metrics.RecordSize(d.lru.Len())
 }
+
+// Invalidate removes the entry for the given key, so that the descriptor is fetched again on the next access.
+func (d *DescriptorCache) Invalidate(key DescriptorKey) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if element, ok := d.entries[key]; ok {
+		d.remove(element)
+		d.metrics.RecordSize(d.lru.Len())
+	}
+}
+
+// evict removes expired entries and the least recently used entries until the cache fits its bound.
+// It must be called with the cache lock held.
+func (d *DescriptorCache) evict() {
+	for element := d.lru.Back(); element != nil; {
+		previous := element.Prev()
+		cached, _ := element.Value.(*entry)
+		if d.isExpired(cached) || (d.maxEntries > 0 && d.lru.Len() > d.maxEntries) {
+			d.evictElement(element)
+		}
+		element = previous
+	}
+}
+
+func (d *DescriptorCache) evictElement(element *list.Element) {
+	cached, _ := element.Value.(*entry)
+	d.remove(element)
+	if d.onEvict != nil {
+		d.onEvict(cached.key)
+	}
+}
+
+func (d *DescriptorCache) remove(element *list.Element) {
+	cached, _ := d.lru.Remove(element).(*entry)
+	delete(d.entries, cached.key)
+}
+
+func (d *DescriptorCache) isExpired(cached *entry) bool {
+	return !cached.expiresAt.IsZero() && time.Now().After(cached.expiresAt)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) RecordHit() {}
+
+func (noopMetrics) RecordMiss() {}
+
+func (noopMetrics) RecordSize(_ int) {}
@@ -2,6 +2,7 @@ package cache_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"ocm.software/ocm/api/ocm/compdesc"
@@ -64,6 +65,87 @@ func TestGet_ForCacheWithOverwrittenEntry_ReturnsNewEntry(t *testing.T) {
 	assertDescriptorEqual(t, newValue, descriptorCache.Get(descriptorcache.DescriptorKey(originalKey)))
 }
 
+func TestGet_ForExpiredEntry_ReturnsNoEntry(t *testing.T) {
+	metrics := &metricsStub{}
+	descriptorCache := descriptorcache.NewDescriptorCache(
+		descriptorcache.WithTTL(time.Millisecond),
+		descriptorcache.WithMetrics(metrics),
+	)
+	key := descriptorcache.DescriptorKey("key 1")
+	descriptorCache.Set(key, newDescriptor("descriptor 1"))
+
+	time.Sleep(5 * time.Millisecond)
+
+	assert.Nil(t, descriptorCache.Get(key))
+	assert.Equal(t, 1, metrics.misses)
+	assert.Equal(t, 0, metrics.size)
+}
+
+func TestSet_ForFullCache_EvictsLeastRecentlyUsedEntry(t *testing.T) {
+	metrics := &metricsStub{}
+	descriptorCache := descriptorcache.NewDescriptorCache(
+		descriptorcache.WithMaxEntries(2),
+		descriptorcache.WithMetrics(metrics),
+	)
+	first, second, third := descriptorcache.DescriptorKey("key 1"), descriptorcache.DescriptorKey("key 2"),
+		descriptorcache.DescriptorKey("key 3")
+	descriptorCache.Set(first, newDescriptor("descriptor 1"))
+	descriptorCache.Set(second, newDescriptor("descriptor 2"))
+	// reading the first entry makes the second one the least recently used entry
+	assert.NotNil(t, descriptorCache.Get(first))
+
+	descriptorCache.Set(third, newDescriptor("descriptor 3"))
+
+	assert.NotNil(t, descriptorCache.Get(first))
+	assert.Nil(t, descriptorCache.Get(second))
+	assert.NotNil(t, descriptorCache.Get(third))
+	assert.Equal(t, 3, metrics.hits)
+	assert.Equal(t, 1, metrics.misses)
+	assert.Equal(t, 2, metrics.size)
+}
+
+func TestInvalidate_RemovesEntry(t *testing.T) {
+	metrics := &metricsStub{}
+	descriptorCache := descriptorcache.NewDescriptorCache(descriptorcache.WithMetrics(metrics))
+	key, otherKey := descriptorcache.DescriptorKey("key 1"), descriptorcache.DescriptorKey("key 2")
+	descriptorCache.Set(key, newDescriptor("descriptor 1"))
+	descriptorCache.Set(otherKey, newDescriptor("descriptor 2"))
+
+	descriptorCache.Invalidate(key)
+
+	assert.Nil(t, descriptorCache.Get(key))
+	assert.NotNil(t, descriptorCache.Get(otherKey))
+	assert.Equal(t, 1, metrics.size)
+}
+
+func newDescriptor(name string) *types.Descriptor {
+	return &types.Descriptor{
+		ComponentDescriptor: &compdesc.ComponentDescriptor{
+			ComponentSpec: compdesc.ComponentSpec{
+				ObjectMeta: ocmmetav1.ObjectMeta{Name: name},
+			},
+		},
+	}
+}
+
+type metricsStub struct {
+	hits   int
+	misses int
+	size   int
+}
+
+func (m *metricsStub) RecordHit() {
+	m.hits++
+}
+
+func (m *metricsStub) RecordMiss() {
+	m.misses++
+}
+
+func (m *metricsStub) RecordSize(entries int) {
+	m.size = entries
+}
+
 func assertDescriptorEqual(t *testing.T, expected, actual *types.Descriptor) {
 	t.Helper()
 	if expected.Name != actual.Name {
@@ -0,0 +1,255 @@
+package cache
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"ocm.software/ocm/api/ocm/compdesc"
+
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
+)
+
+var ErrPersistedDescriptorMismatch = errors.New("persisted component descriptor changed to another component")
+
+const (
+	descriptorFileSuffix = ".yaml"
+	temporaryFilePrefix  = ".tmp-"
+	descriptorDirPerm    = 0o750
+)
+
+// DescriptorVerifier checks the authenticity of a persisted descriptor before it is served from the cache.
+type DescriptorVerifier interface {
+	Verify(ctx context.Context, descriptor *types.Descriptor) error
+}
+
+// PersistentDescriptorCache keeps the descriptors of an in-memory DescriptorCache in a local directory, for example
+// on a persistent volume, and warms the in-memory cache from that directory when it is created. This avoids
+// fetching all descriptors from the OCI registry at once after a restart. Reads are always served from memory.
+// The directory is bounded like the in-memory cache: descriptors evicted from memory are removed from it as well.
+type PersistentDescriptorCache struct {
+	memory    *DescriptorCache
+	directory string
+	logger    logr.Logger
+	verifier  DescriptorVerifier
+
+	mu sync.Mutex
+	// unverified holds the descriptors found in the directory that are verified when they are first read
+	unverified map[DescriptorKey]persistedDescriptor
+}
+
+type persistedDescriptor struct {
+	path    string
+	modTime time.Time
+}
+
+// NewPersistentDescriptorCache loads the descriptors stored in directory into memory. Descriptors stored longer ago
+// than the TTL of the in-memory cache, and files that can not be decoded, are removed instead.
+func NewPersistentDescriptorCache(directory string, memory *DescriptorCache,
+	logger logr.Logger, opts ...func(*PersistentDescriptorCache) *PersistentDescriptorCache,
+) (*PersistentDescriptorCache, error) {
+	if err := os.MkdirAll(directory, descriptorDirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create descriptor cache directory %s: %w", directory, err)
+	}
+	persistent := &PersistentDescriptorCache{
+		memory:     memory,
+		directory:  directory,
+		logger:     logger,
+		unverified: map[DescriptorKey]persistedDescriptor{},
+	}
+	for _, opt := range opts {
+		persistent = opt(persistent)
+	}
+	memory.onEvict = persistent.remove
+	if err := persistent.warmUp(); err != nil {
+		return nil, err
+	}
+	return persistent, nil
+}
+
+// WithVerifier makes the cache verify each persisted descriptor before it is served, as the files in the directory
+// can be changed outside of the cache. The verification is deferred until a descriptor is first read, as the
+// verifier may depend on the KCP, which is not available while the cache is created. Descriptors failing the
+// verification are removed, so that they are fetched from the OCI registry again.
+func WithVerifier(verifier DescriptorVerifier) func(*PersistentDescriptorCache) *PersistentDescriptorCache {
+	return func(persistent *PersistentDescriptorCache) *PersistentDescriptorCache {
+		persistent.verifier = verifier
+		return persistent
+	}
+}
+
+func (p *PersistentDescriptorCache) Get(key DescriptorKey) *types.Descriptor {
+	if descriptor := p.memory.Get(key); descriptor != nil {
+		return descriptor
+	}
+	return p.loadUnverified(key)
+}
+
+func (p *PersistentDescriptorCache) Set(key DescriptorKey, value *types.Descriptor) {
+	p.dropUnverified(key)
+	p.memory.Set(key, value)
+	if err := p.store(key, value); err != nil {
+		p.logger.Error(err, "failed to persist component descriptor", "key", key)
+	}
+}
+
+// Invalidate removes the descriptor from memory and from the directory. It is meant for descriptors whose content
+// changed, for example when a descriptor is re-pushed under the same version, so that it is fetched again.
+func (p *PersistentDescriptorCache) Invalidate(key DescriptorKey) {
+	p.dropUnverified(key)
+	p.memory.Invalidate(key)
+	p.remove(key)
+}
+
+func (p *PersistentDescriptorCache) remove(key DescriptorKey) {
+	if err := os.Remove(p.filePath(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		p.logger.Error(err, "failed to remove persisted component descriptor", "key", key)
+	}
+}
+
+func (p *PersistentDescriptorCache) store(key DescriptorKey, value *types.Descriptor) error {
+	content, err := compdesc.Encode(value.ComponentDescriptor)
+	if err != nil {
+		return fmt.Errorf("failed to encode component descriptor: %w", err)
+	}
+	tmpFile, err := os.CreateTemp(p.directory, temporaryFilePrefix+"*")
+	if err != nil {
+		return fmt.Errorf("failed to create file in descriptor cache: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, writeErr := tmpFile.Write(content)
+	closeErr := tmpFile.Close()
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		return fmt.Errorf("failed to write component descriptor to descriptor cache: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), p.filePath(key)); err != nil {
+		return fmt.Errorf("failed to move component descriptor into descriptor cache: %w", err)
+	}
+	return nil
+}
+
+func (p *PersistentDescriptorCache) warmUp() error {
+	dirEntries, err := os.ReadDir(p.directory)
+	if err != nil {
+		return fmt.Errorf("failed to read descriptor cache directory %s: %w", p.directory, err)
+	}
+	type storedFile struct {
+		path    string
+		modTime time.Time
+	}
+	stored := make([]storedFile, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		filePath := filepath.Join(p.directory, dirEntry.Name())
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), descriptorFileSuffix) {
+			// left-overs of interrupted writes
+			if strings.HasPrefix(dirEntry.Name(), temporaryFilePrefix) {
+				_ = os.Remove(filePath)
+			}
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		if p.memory.ttl > 0 && time.Since(info.ModTime()) > p.memory.ttl {
+			_ = os.Remove(filePath)
+			continue
+		}
+		stored = append(stored, storedFile{path: filePath, modTime: info.ModTime()})
+	}
+	// the newest descriptors are loaded last, so that they are kept if the in-memory cache is bounded
+	slices.SortFunc(stored, func(a, b storedFile) int {
+		return cmp.Compare(a.modTime.UnixNano(), b.modTime.UnixNano())
+	})
+
+	loaded := 0
+	for _, file := range stored {
+		key, descriptor, err := loadDescriptor(file.path)
+		if err != nil {
+			p.logger.Error(err, "removing unreadable persisted component descriptor", "file", file.path)
+			_ = os.Remove(file.path)
+			continue
+		}
+		loaded++
+		if p.verifier != nil {
+			p.unverified[key] = persistedDescriptor{path: file.path, modTime: file.modTime}
+			continue
+		}
+		// the TTL counts from the time the descriptor was fetched, not from the restart
+		p.memory.set(key, descriptor, file.modTime)
+	}
+	p.logger.Info("warmed up descriptor cache", "directory", p.directory, "descriptors", loaded)
+	return nil
+}
+
+// loadUnverified reads the persisted descriptor for the key, and serves it from memory once it is verified.
+func (p *PersistentDescriptorCache) loadUnverified(key DescriptorKey) *types.Descriptor {
+	p.mu.Lock()
+	persisted, found := p.unverified[key]
+	delete(p.unverified, key)
+	p.mu.Unlock()
+	if !found {
+		return nil
+	}
+	if p.memory.ttl > 0 && time.Since(persisted.modTime) > p.memory.ttl {
+		_ = os.Remove(persisted.path)
+		return nil
+	}
+
+	loadedKey, descriptor, err := loadDescriptor(persisted.path)
+	if err == nil && loadedKey != key {
+		err = fmt.Errorf("%w: %s", ErrPersistedDescriptorMismatch, loadedKey)
+	}
+	if err == nil {
+		err = p.verifier.Verify(context.Background(), descriptor)
+	}
+	if err != nil {
+		p.logger.Error(err, "removing untrusted persisted component descriptor", "file", persisted.path)
+		_ = os.Remove(persisted.path)
+		return nil
+	}
+	p.memory.set(key, descriptor, persisted.modTime)
+	return descriptor
+}
+
+func (p *PersistentDescriptorCache) dropUnverified(key DescriptorKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.unverified, key)
+}
+
+// filePath maps a key to a file name. Keys contain the component name, which includes path separators, so the
+// file is named after the digest of the key instead.
+func (p *PersistentDescriptorCache) filePath(key DescriptorKey) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(p.directory, hex.EncodeToString(sum[:])+descriptorFileSuffix)
+}
+
+func loadDescriptor(filePath string) (DescriptorKey, *types.Descriptor, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+	componentDescriptor, err := types.Deserialize(content)
+	if err != nil {
+		return "", nil, err
+	}
+	ocmId, err := ocmidentity.NewComponentId(componentDescriptor.GetName(), componentDescriptor.GetVersion())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid persisted component descriptor %s: %w", filePath, err)
+	}
+	return GenerateDescriptorKey(*ocmId), &types.Descriptor{ComponentDescriptor: componentDescriptor}, nil
+}
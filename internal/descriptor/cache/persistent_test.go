@@ -0,0 +1,165 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"ocm.software/ocm/api/ocm/compdesc"
+	compdescv2 "ocm.software/ocm/api/ocm/compdesc/versions/v2"
+
+	descriptorcache "github.com/kyma-project/lifecycle-manager/internal/descriptor/cache"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
+)
+
+const (
+	componentName    = testutils.DefaultComponentName
+	componentVersion = "1.0.1"
+)
+
+func TestNewPersistentDescriptorCache_WarmsUpFromStoredDescriptors(t *testing.T) {
+	compdesc.RegisterScheme(&compdescv2.DescriptorVersion{})
+	directory := t.TempDir()
+	key := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, componentVersion))
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+	persistentCache.Set(key, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, componentVersion)})
+
+	restartedCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+
+	descriptor := restartedCache.Get(key)
+	require.NotNil(t, descriptor)
+	assert.Equal(t, componentName, descriptor.GetName())
+	assert.Equal(t, componentVersion, descriptor.GetVersion())
+}
+
+func TestNewPersistentDescriptorCache_RemovesExpiredAndUnreadableDescriptors(t *testing.T) {
+	compdesc.RegisterScheme(&compdescv2.DescriptorVersion{})
+	directory := t.TempDir()
+	key := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, componentVersion))
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+	persistentCache.Set(key, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, componentVersion)})
+	storedFiles, err := filepath.Glob(filepath.Join(directory, "*.yaml"))
+	require.NoError(t, err)
+	require.Len(t, storedFiles, 1)
+	expired := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(storedFiles[0], expired, expired))
+	unreadableFile := filepath.Join(directory, "unreadable.yaml")
+	require.NoError(t, os.WriteFile(unreadableFile, []byte("not a descriptor"), 0o600))
+
+	restartedCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(descriptorcache.WithTTL(time.Hour)), logr.Discard())
+	require.NoError(t, err)
+
+	assert.Nil(t, restartedCache.Get(key))
+	assert.NoFileExists(t, storedFiles[0])
+	assert.NoFileExists(t, unreadableFile)
+}
+
+func TestPersistentDescriptorCache_Invalidate_RemovesStoredDescriptor(t *testing.T) {
+	compdesc.RegisterScheme(&compdescv2.DescriptorVersion{})
+	directory := t.TempDir()
+	key := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, componentVersion))
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+	persistentCache.Set(key, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, componentVersion)})
+
+	persistentCache.Invalidate(key)
+
+	assert.Nil(t, persistentCache.Get(key))
+	restartedCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+	assert.Nil(t, restartedCache.Get(key))
+}
+
+func TestPersistentDescriptorCache_Set_RemovesStoredDescriptorEvictedFromMemory(t *testing.T) {
+	compdesc.RegisterScheme(&compdescv2.DescriptorVersion{})
+	directory := t.TempDir()
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(descriptorcache.WithMaxEntries(1)), logr.Discard())
+	require.NoError(t, err)
+	evictedKey := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, "1.0.0"))
+	persistentCache.Set(evictedKey, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, "1.0.0")})
+	storedFiles, err := filepath.Glob(filepath.Join(directory, "*.yaml"))
+	require.NoError(t, err)
+	require.Len(t, storedFiles, 1)
+
+	key := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, componentVersion))
+	persistentCache.Set(key, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, componentVersion)})
+
+	assert.NoFileExists(t, storedFiles[0])
+	assert.Nil(t, persistentCache.Get(evictedKey))
+	remainingFiles, err := filepath.Glob(filepath.Join(directory, "*.yaml"))
+	require.NoError(t, err)
+	assert.Len(t, remainingFiles, 1)
+}
+
+func TestNewPersistentDescriptorCache_WithVerifier_ServesVerifiedPersistedDescriptor(t *testing.T) {
+	compdesc.RegisterScheme(&compdescv2.DescriptorVersion{})
+	directory := t.TempDir()
+	key := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, componentVersion))
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+	persistentCache.Set(key, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, componentVersion)})
+	verifier := &verifierStub{}
+
+	restartedCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard(), descriptorcache.WithVerifier(verifier))
+	require.NoError(t, err)
+	assert.Zero(t, verifier.calls, "persisted descriptors must be verified when they are read")
+
+	require.NotNil(t, restartedCache.Get(key))
+	require.NotNil(t, restartedCache.Get(key))
+	assert.Equal(t, 1, verifier.calls)
+}
+
+func TestNewPersistentDescriptorCache_WithVerifier_RemovesTamperedPersistedDescriptor(t *testing.T) {
+	compdesc.RegisterScheme(&compdescv2.DescriptorVersion{})
+	directory := t.TempDir()
+	key := descriptorcache.GenerateDescriptorKey(*testutils.MustNewComponentId(componentName, componentVersion))
+	persistentCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard())
+	require.NoError(t, err)
+	persistentCache.Set(key, &types.Descriptor{ComponentDescriptor: compdesc.New(componentName, componentVersion)})
+	storedFiles, err := filepath.Glob(filepath.Join(directory, "*.yaml"))
+	require.NoError(t, err)
+	require.Len(t, storedFiles, 1)
+	tampered := compdesc.New(componentName, componentVersion)
+	tampered.Provider.Name = "attacker"
+	content, err := compdesc.Encode(tampered)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(storedFiles[0], content, 0o600))
+
+	restartedCache, err := descriptorcache.NewPersistentDescriptorCache(directory,
+		descriptorcache.NewDescriptorCache(), logr.Discard(),
+		descriptorcache.WithVerifier(&verifierStub{err: errors.New("signature mismatch")}))
+	require.NoError(t, err)
+
+	assert.Nil(t, restartedCache.Get(key))
+	assert.NoFileExists(t, storedFiles[0])
+}
+
+type verifierStub struct {
+	err   error
+	calls int
+}
+
+func (v *verifierStub) Verify(_ context.Context, _ *types.Descriptor) error {
+	v.calls++
+	return v.err
+}
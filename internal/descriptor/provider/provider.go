@@ -15,6 +15,8 @@ var (
 	ErrNilProvider        = errors.New("OCMIProvider is nil")
 	ErrNilIdentity        = errors.New("component identity is nil")
 	ErrNameOrVersionEmpty = errors.New("component name or version is empty")
+	// ErrUntrustedDescriptor is returned when a fetched descriptor fails the verification of its signatures.
+	ErrUntrustedDescriptor = errors.New("component descriptor is not trusted")
 )
 
 type DescriptorService interface {
@@ -24,18 +26,40 @@ type DescriptorService interface {
 type DescriptorCache interface {
 	Get(key descriptorcache.DescriptorKey) *types.Descriptor
 	Set(key descriptorcache.DescriptorKey, value *types.Descriptor)
+	Invalidate(key descriptorcache.DescriptorKey)
+}
+
+// DescriptorVerifier checks the authenticity of a descriptor before it is accepted by the provider.
+type DescriptorVerifier interface {
+	Verify(ctx context.Context, descriptor *types.Descriptor) error
 }
 
 type CachedDescriptorProvider struct {
 	descriptorCache   DescriptorCache
 	descriptorService DescriptorService
+	verifier          DescriptorVerifier
 }
 
-func NewCachedDescriptorProvider(service DescriptorService, descCache DescriptorCache) *CachedDescriptorProvider {
-	return &CachedDescriptorProvider{
+func NewCachedDescriptorProvider(service DescriptorService, descCache DescriptorCache,
+	opts ...func(*CachedDescriptorProvider) *CachedDescriptorProvider,
+) *CachedDescriptorProvider {
+	descriptorProvider := &CachedDescriptorProvider{
 		descriptorCache:   descCache,
 		descriptorService: service,
 	}
+	for _, opt := range opts {
+		descriptorProvider = opt(descriptorProvider)
+	}
+	return descriptorProvider
+}
+
+// WithVerifier makes the provider verify every descriptor fetched from the descriptor service.
+// Descriptors failing the verification are neither cached nor returned.
+func WithVerifier(verifier DescriptorVerifier) func(*CachedDescriptorProvider) *CachedDescriptorProvider {
+	return func(provider *CachedDescriptorProvider) *CachedDescriptorProvider {
+		provider.verifier = verifier
+		return provider
+	}
 }
 
 // OCMIProvider is a convenience interface to get the OCM identity of a component from objects
@@ -63,6 +87,10 @@ func (c *CachedDescriptorProvider) Add(ocmId ocmidentity.ComponentId) error {
 		return fmt.Errorf("error finding ComponentDescriptor: %w", err)
 	}
 
+	if err := c.verify(ctx, descriptor); err != nil {
+		return err
+	}
+
 	c.descriptorCache.Set(key, descriptor)
 
 	return nil
@@ -86,6 +114,10 @@ func (c *CachedDescriptorProvider) GetDescriptor(ocmId ocmidentity.ComponentId)
 		return nil, fmt.Errorf("error finding ComponentDescriptor: %w", err)
 	}
 
+	if err := c.verify(ctx, descriptor); err != nil {
+		return nil, err
+	}
+
 	return descriptor, nil
 }
 
@@ -104,3 +136,23 @@ func (c *CachedDescriptorProvider) GetDescriptorWithIdentity(ocp OCMIProvider) (
 
 	return c.GetDescriptor(*ocmId)
 }
+
+// Invalidate drops the cached descriptor of the component, so that it is fetched again on the next access.
+func (c *CachedDescriptorProvider) Invalidate(ocmId ocmidentity.ComponentId) {
+	c.descriptorCache.Invalidate(descriptorcache.GenerateDescriptorKey(ocmId))
+}
+
+// VerifiesDescriptors reports whether the provider verifies the descriptors it fetches.
+func (c *CachedDescriptorProvider) VerifiesDescriptors() bool {
+	return c.verifier != nil
+}
+
+func (c *CachedDescriptorProvider) verify(ctx context.Context, descriptor *types.Descriptor) error {
+	if c.verifier == nil {
+		return nil
+	}
+	if err := c.verifier.Verify(ctx, descriptor); err != nil {
+		return fmt.Errorf("%w: %s:%s: %w", ErrUntrustedDescriptor, descriptor.GetName(), descriptor.GetVersion(), err)
+	}
+	return nil
+}
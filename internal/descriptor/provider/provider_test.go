@@ -1,6 +1,7 @@
 package provider_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -161,6 +162,27 @@ func TestGetDescriptor_ReturnsDescriptorFromCache(t *testing.T) {
 	assert.Equal(t, ocmId.Version(), descFromCache.Version)
 }
 
+func TestGetDescriptor_AfterInvalidate_ReturnsDescriptorFromService(t *testing.T) {
+	// given
+	var moduleTemplateFromFile v1beta2.ModuleTemplate
+	builder.ReadComponentDescriptorFromFile("v1beta2_template_operator_new_ocm.yaml", &moduleTemplateFromFile)
+	mockService := &componentdescriptor.FakeService{}
+	mockService.Register(moduleTemplateFromFile.Spec.Descriptor.Raw)
+	descriptorProvider := provider.NewCachedDescriptorProvider(mockService, descriptorcache.NewDescriptorCache())
+	ocmId, err := ocmidentity.NewComponentId("kyma-project.io/module/template-operator", "1.0.0-new-ocm-format")
+	require.NoError(t, err)
+	require.NoError(t, descriptorProvider.Add(*ocmId))
+
+	// when
+	mockService.Clear().Register([]byte("invalid descriptor")) // make the service return junk data
+	descriptorProvider.Invalidate(*ocmId)
+	_, err = descriptorProvider.GetDescriptor(*ocmId) // should come from the service again
+
+	// then
+	require.Error(t, err)
+	assert.ErrorIs(t, err, types.ErrDecode)
+}
+
 func TestGetDescriptorWithIdentity_WithNilProvider_ReturnsErr(t *testing.T) {
 	descriptorProvider := provider.NewCachedDescriptorProvider(nil, nil)
 	_, err := descriptorProvider.GetDescriptorWithIdentity(nil)
@@ -207,6 +229,51 @@ func TestGetDescriptorWithIdentity_OnValidIdentity_ReturnsDescriptor(t *testing.
 	assert.Equal(t, ocmId.Version(), desc.Version)
 }
 
+func TestAddDescriptor_WithVerifier_DoesNotCacheUntrustedDescriptor(t *testing.T) {
+	// given
+	var moduleTemplateFromFile v1beta2.ModuleTemplate
+	builder.ReadComponentDescriptorFromFile("v1beta2_template_operator_new_ocm.yaml", &moduleTemplateFromFile)
+	mockCache := &mockCache{}
+	verifierErr := errors.New("no trusted signature")
+	descriptorProvider := provider.NewCachedDescriptorProvider(
+		(&componentdescriptor.FakeService{}).Register(moduleTemplateFromFile.Spec.Descriptor.Raw),
+		mockCache,
+		provider.WithVerifier(&mockVerifier{err: verifierErr}),
+	)
+	ocmId, err := ocmidentity.NewComponentId("kyma-project.io/module/template-operator", "1.0.0-new-ocm-format")
+	require.NoError(t, err)
+
+	// when
+	err = descriptorProvider.Add(*ocmId)
+
+	// then
+	require.ErrorIs(t, err, provider.ErrUntrustedDescriptor)
+	require.ErrorIs(t, err, verifierErr)
+	assert.Nil(t, mockCache.result)
+}
+
+func TestGetDescriptor_WithVerifier_ReturnsVerifiedDescriptor(t *testing.T) {
+	// given
+	var moduleTemplateFromFile v1beta2.ModuleTemplate
+	builder.ReadComponentDescriptorFromFile("v1beta2_template_operator_new_ocm.yaml", &moduleTemplateFromFile)
+	verifier := &mockVerifier{}
+	descriptorProvider := provider.NewCachedDescriptorProvider(
+		(&componentdescriptor.FakeService{}).Register(moduleTemplateFromFile.Spec.Descriptor.Raw),
+		descriptorcache.NewDescriptorCache(),
+		provider.WithVerifier(verifier),
+	)
+	ocmId, err := ocmidentity.NewComponentId("kyma-project.io/module/template-operator", "1.0.0-new-ocm-format")
+	require.NoError(t, err)
+
+	// when
+	desc, err := descriptorProvider.GetDescriptor(*ocmId)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ocmId.Name(), desc.Name)
+	assert.Equal(t, 1, verifier.calls)
+}
+
 type mockIdentityProvider struct {
 	err   error
 	ocmId *ocmidentity.ComponentId
@@ -233,3 +300,17 @@ func (m *mockCache) Get(key descriptorcache.DescriptorKey) *types.Descriptor {
 func (m *mockCache) Set(key descriptorcache.DescriptorKey, value *types.Descriptor) {
 	m.result = value
 }
+
+func (m *mockCache) Invalidate(key descriptorcache.DescriptorKey) {
+	m.result = nil
+}
+
+type mockVerifier struct {
+	err   error
+	calls int
+}
+
+func (m *mockVerifier) Verify(_ context.Context, _ *types.Descriptor) error {
+	m.calls++
+	return m.err
+}
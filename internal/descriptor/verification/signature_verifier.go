@@ -0,0 +1,137 @@
+// Package verification verifies the authenticity of OCM component descriptors before modules built from them are
+// installed.
+package verification
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"ocm.software/ocm/api/ocm/compdesc"
+	"ocm.software/ocm/api/tech/signing"
+	_ "ocm.software/ocm/api/tech/signing/handlers/rsa" // registers the RSA signature handler
+
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
+)
+
+const (
+	pemTypePublicKey    = "PUBLIC KEY"
+	pemTypeRSAPublicKey = "RSA PUBLIC KEY"
+	pemTypeCertificate  = "CERTIFICATE"
+)
+
+var (
+	ErrNoTrustedSignature = errors.New("descriptor has no signature matching a trusted key")
+	ErrNoTrustedKeys      = errors.New("no trusted keys configured")
+	ErrInvalidTrustedKey  = errors.New("invalid trusted key")
+	ErrCertificateExpired = errors.New("certificate is not within its validity period")
+)
+
+type SecretRepository interface {
+	Get(ctx context.Context, name string) (*apicorev1.Secret, error)
+}
+
+// SignatureVerifier verifies the OCM signatures of component descriptors against the trusted keys stored in a
+// Secret in the KCP. Each data key of the Secret is the name of a signature, and its value is the PEM encoded public
+// key or certificate that signature is verified with. A descriptor is trusted if at least one of its signatures
+// with a matching name is valid. As the signature covers the digests of all resources, a trusted descriptor also
+// pins the content of its layers.
+type SignatureVerifier struct {
+	secretRepository SecretRepository
+	secretName       string
+}
+
+func NewSignatureVerifier(secretRepository SecretRepository, secretName string) *SignatureVerifier {
+	return &SignatureVerifier{
+		secretRepository: secretRepository,
+		secretName:       secretName,
+	}
+}
+
+func (v *SignatureVerifier) Verify(ctx context.Context, descriptor *types.Descriptor) error {
+	secret, err := v.secretRepository.Get(ctx, v.secretName)
+	if err != nil {
+		return fmt.Errorf("failed to get trusted keys from secret %s: %w", v.secretName, err)
+	}
+	registry, err := v.newRegistry(secret.Data)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, signature := range descriptor.Signatures {
+		if registry.GetPublicKey(signature.Name) == nil {
+			continue
+		}
+		if err := compdesc.Verify(descriptor.ComponentDescriptor, registry, signature.Name); err != nil {
+			errs = append(errs, fmt.Errorf("signature %s: %w", signature.Name, err))
+			continue
+		}
+		return nil
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return ErrNoTrustedSignature
+}
+
+func (v *SignatureVerifier) newRegistry(trustedKeys map[string][]byte) (signing.Registry, error) {
+	if len(trustedKeys) == 0 {
+		return nil, fmt.Errorf("%w: secret %s is empty", ErrNoTrustedKeys, v.secretName)
+	}
+	registry := signing.NewRegistry(signing.DefaultHandlers(), signing.NewKeyRegistry())
+	// keys are registered in a stable order, so that errors are reported deterministically
+	names := make([]string, 0, len(trustedKeys))
+	for name := range trustedKeys {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		publicKey, err := parsePublicKey(trustedKeys[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to load trusted key %s: %w", name, err)
+		}
+		registry.RegisterPublicKey(name, publicKey)
+	}
+	return registry, nil
+}
+
+// parsePublicKey returns the public key of a PEM encoded PKIX or PKCS #1 public key or of a certificate.
+// Certificates are only accepted within their validity period.
+func parsePublicKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM data found", ErrInvalidTrustedKey)
+	}
+	switch block.Type {
+	case pemTypePublicKey:
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse public key: %w", ErrInvalidTrustedKey, err)
+		}
+		return publicKey, nil
+	case pemTypeRSAPublicKey:
+		publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse RSA public key: %w", ErrInvalidTrustedKey, err)
+		}
+		return publicKey, nil
+	case pemTypeCertificate:
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse certificate: %w", ErrInvalidTrustedKey, err)
+		}
+		now := time.Now()
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return nil, fmt.Errorf("%w: %s at %s", ErrCertificateExpired, cert.Subject, now.Format(time.RFC3339))
+		}
+		return cert.PublicKey, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported PEM block type %q", ErrInvalidTrustedKey, block.Type)
+	}
+}
@@ -0,0 +1,123 @@
+package verification_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	"ocm.software/ocm/api/ocm/compdesc"
+	ocmmetav1 "ocm.software/ocm/api/ocm/compdesc/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/verification"
+)
+
+const signatureName = "kyma-module-signature"
+
+func TestSignatureVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})
+
+	tests := []struct {
+		name       string
+		secret     *apicorev1.Secret
+		secretErr  error
+		signatures []string
+		expected   error
+	}{
+		{
+			name:      "secret can not be fetched",
+			secretErr: errSecretNotFound,
+			expected:  errSecretNotFound,
+		},
+		{
+			name:     "secret without keys",
+			secret:   &apicorev1.Secret{},
+			expected: verification.ErrNoTrustedKeys,
+		},
+		{
+			name:     "secret with a key that is not PEM encoded",
+			secret:   secretWithKey([]byte("not a key")),
+			expected: verification.ErrInvalidTrustedKey,
+		},
+		{
+			name:     "secret with an expired certificate",
+			secret:   secretWithKey(newCertificatePEM(t, privateKey, time.Now().Add(-time.Hour))),
+			expected: verification.ErrCertificateExpired,
+		},
+		{
+			name:     "descriptor without signatures",
+			secret:   secretWithKey(publicKeyPEM),
+			expected: verification.ErrNoTrustedSignature,
+		},
+		{
+			name:       "descriptor signed with an unknown key",
+			secret:     secretWithKey(publicKeyPEM),
+			signatures: []string{"unknown-signature"},
+			expected:   verification.ErrNoTrustedSignature,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			verifier := verification.NewSignatureVerifier(
+				&secretRepositoryStub{secret: testCase.secret, err: testCase.secretErr}, "module-signature-keys")
+
+			err := verifier.Verify(t.Context(), newDescriptor(testCase.signatures...))
+
+			require.ErrorIs(t, err, testCase.expected)
+		})
+	}
+}
+
+var errSecretNotFound = errors.New("secret not found")
+
+type secretRepositoryStub struct {
+	secret *apicorev1.Secret
+	err    error
+}
+
+func (s *secretRepositoryStub) Get(_ context.Context, _ string) (*apicorev1.Secret, error) {
+	return s.secret, s.err
+}
+
+func secretWithKey(key []byte) *apicorev1.Secret {
+	return &apicorev1.Secret{Data: map[string][]byte{signatureName: key}}
+}
+
+func newDescriptor(signatureNames ...string) *types.Descriptor {
+	descriptor := &compdesc.ComponentDescriptor{}
+	for _, name := range signatureNames {
+		descriptor.Signatures = append(descriptor.Signatures, ocmmetav1.Signature{Name: name})
+	}
+	return &types.Descriptor{ComponentDescriptor: descriptor}
+}
+
+func newCertificatePEM(t *testing.T, privateKey *rsa.PrivateKey, notAfter time.Time) []byte {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kyma-modules"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+}
@@ -0,0 +1,68 @@
+package imagerewrite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/imagerewrite"
+)
+
+const testDigest = "sha256:c4bb6cae028ee580295d527663d62d5b1ac23a70dd73f7efed2c7ecbdc48a834"
+
+func TestPodContainerImageDigestRewriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("PinContainerImageKeepingHostAndPath", func(t *testing.T) {
+		t.Parallel()
+		// given
+		targetImages, err := imagerewrite.AsImageReferences([]string{
+			"private-registry.com/prod/template-operator:1.0.3@" + testDigest,
+			"private-registry.com/prod/foo-image:1.2.3@" + testDigest,
+		})
+		require.NoError(t, err, "Failed to create target images from provided image references")
+
+		deploymentResource, err := parseToUnstructured(testDeploymentSingleContainerWithEnvs)
+		require.NoError(t, err, "Failed to parse test deployment to unstructured")
+		unmodifiedYAML := mustYAML(deploymentResource) // Store the original YAML for comparison later
+		containerResource := getFirstContainer(t, deploymentResource)
+
+		// when
+		err = (&imagerewrite.PodContainerImageDigestRewriter{}).Rewrite(targetImages, containerResource)
+		require.NoError(t, err, "Failed to pin container images")
+		err = setFirstContainer(t, deploymentResource, containerResource)
+		require.NoError(t, err, "Failed to set first container in deployment resource")
+
+		// then
+		cp := newChangesComparator(t, asLines(unmodifiedYAML), asLines(mustYAML(deploymentResource)))
+		cp.verify(
+			valueOf(
+				"the image in the container",
+			).shouldChangeFrom("image: europe-docker.pkg.dev/kyma-project/prod/template-operator:1.0.3").
+				to("image: europe-docker.pkg.dev/kyma-project/prod/template-operator:1.0.3@" + testDigest),
+		)
+	})
+
+	t.Run("IgnoreTargetImagesWithoutDigest", func(t *testing.T) {
+		t.Parallel()
+		// given
+		targetImages, err := imagerewrite.AsImageReferences([]string{
+			"private-registry.com/prod/template-operator:1.0.3",
+		})
+		require.NoError(t, err, "Failed to create target images from provided image references")
+
+		deploymentResource, err := parseToUnstructured(testDeploymentNoEnvsContainer)
+		require.NoError(t, err, "Failed to parse test deployment to unstructured")
+		unmodifiedYAML := mustYAML(deploymentResource) // Store the original YAML for comparison later
+		containerResource := getFirstContainer(t, deploymentResource)
+
+		// when
+		err = (&imagerewrite.PodContainerImageDigestRewriter{}).Rewrite(targetImages, containerResource)
+		require.NoError(t, err, "Failed to pin container images")
+		err = setFirstContainer(t, deploymentResource, containerResource)
+		require.NoError(t, err, "Failed to set first container in deployment resource")
+
+		// then
+		require.YAMLEq(t, unmodifiedYAML, mustYAML(deploymentResource), "Deployment should not be modified")
+	})
+}
@@ -3,6 +3,7 @@ package imagerewrite
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -16,11 +17,12 @@ type ImageRewriter interface {
 
 // ResourceRewriter rewrites the host and path of the images in the kubernetes resources
 // based on the localized images specified in the manifest.
-// The target resource must represent a Kubernetes object that contains a Pod template,
-// such as a Deployment or StatefulSet.
+// The target resource must represent a Kubernetes object that contains a Pod spec, such as a Pod
+// or a workload with a Pod template, see IsSupportedKind.
 // The rewriter modifies images specified in the following places:
-//   - all pod template container images
-//   - all pod template environment variables that contain image references
+//   - all pod container, init container and ephemeral container images
+//   - all pod container, init container and ephemeral container environment variables
+//     that contain image references
 type ResourceRewriter struct {
 	rewriters []ImageRewriter
 }
@@ -31,7 +33,7 @@ func (r *ResourceRewriter) WithRewriters(rewriters ...ImageRewriter) *ResourceRe
 }
 
 // ReplaceImages replaces images in the given Kubernetes resource with the target images.
-// It supports only Deployment and StatefulSet kinds, it ignores any unsupported resources.
+// It ignores resources of unsupported kinds.
 func (r *ResourceRewriter) ReplaceImages(
 	resource *unstructured.Unstructured,
 	targetImages []*DockerImageReference,
@@ -40,42 +42,23 @@ func (r *ResourceRewriter) ReplaceImages(
 		return nil
 	}
 
-	deploymentOrSimilar := resource
-	podContainersGetter := func() ([]*unstructured.Unstructured, error) {
-		containers, err := getPodContainers(deploymentOrSimilar)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get pod containers: %w", err)
-		}
-		return containers, nil
-	}
-	podContainersSetter := func(containers []*unstructured.Unstructured) error {
-		if err := setPodContainers(deploymentOrSimilar, containers); err != nil {
-			return fmt.Errorf("failed to set pod containers: %w", err)
+	for _, field := range containerFields {
+		podContainersGetter := func() ([]*unstructured.Unstructured, error) {
+			containers, err := getPodContainers(resource, field)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pod %s: %w", field, err)
+			}
+			return containers, nil
 		}
-		return nil
-	}
-
-	err := r.rewriteContainers(podContainersGetter, podContainersSetter, targetImages)
-	if err != nil {
-		return fmt.Errorf("failed to rewrite pod containers: %w", err)
-	}
-
-	podInitContainersGetter := func() ([]*unstructured.Unstructured, error) {
-		containers, err := getPodInitContainers(deploymentOrSimilar)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get pod init containers: %w", err)
+		podContainersSetter := func(containers []*unstructured.Unstructured) error {
+			if err := setPodContainers(resource, field, containers); err != nil {
+				return fmt.Errorf("failed to set pod %s: %w", field, err)
+			}
+			return nil
 		}
-		return containers, nil
-	}
-	podInitContainersSetter := func(containers []*unstructured.Unstructured) error {
-		if err := setPodInitContainers(deploymentOrSimilar, containers); err != nil {
-			return fmt.Errorf("failed to set pod init containers: %w", err)
+		if err := r.rewriteContainers(podContainersGetter, podContainersSetter, targetImages); err != nil {
+			return fmt.Errorf("failed to rewrite pod %s: %w", field, err)
 		}
-		return nil
-	}
-	err = r.rewriteContainers(podInitContainersGetter, podInitContainersSetter, targetImages)
-	if err != nil {
-		return fmt.Errorf("failed to rewrite pod init containers: %w", err)
 	}
 
 	return nil
@@ -111,50 +94,34 @@ func (r *ResourceRewriter) rewriteContainers(
 	return nil
 }
 
-// getPodContainers retrieves the containers from a Kubernetes resource that has a Pod template.
+// getPodContainers retrieves the containers in the given field of the Pod spec of a Kubernetes resource,
+// for example "containers" or "initContainers".
 // It returns a slice of unstructured.Unstructured representing the Pod containers.
-// deploymentOrSimilar is expected to be a Deployment, StatefulSet, or similar resource that contains a Pod template.
-func getPodContainers(deploymentOrSimilar *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+// resource is expected to be of a supported kind, see IsSupportedKind.
+func getPodContainers(resource *unstructured.Unstructured, field string) ([]*unstructured.Unstructured, error) {
 	return getContainersGeneric(func() ([]any, bool, error) {
-		return unstructured.NestedSlice(deploymentOrSimilar.Object, "spec", "template", "spec", "containers")
+		return unstructured.NestedSlice(resource.Object, podContainersPath(resource.GetKind(), field)...)
 	})
 }
 
-func getPodInitContainers(deploymentOrSimilar *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
-	return getContainersGeneric(func() ([]any, bool, error) {
-		return unstructured.NestedSlice(deploymentOrSimilar.Object, "spec", "template", "spec", "initContainers")
-	})
-}
-
-func setPodContainers(deploymentOrSimilar *unstructured.Unstructured, containers []*unstructured.Unstructured) error {
-	return setContainersGeneric(containers, func(containerObjects []any) error {
-		return unstructured.SetNestedSlice(
-			deploymentOrSimilar.Object,
-			containerObjects,
-			"spec",
-			"template",
-			"spec",
-			"containers",
-		)
-	})
-}
-
-func setPodInitContainers(
-	deploymentOrSimilar *unstructured.Unstructured,
+func setPodContainers(
+	resource *unstructured.Unstructured,
+	field string,
 	containers []*unstructured.Unstructured,
 ) error {
 	return setContainersGeneric(containers, func(containerObjects []any) error {
 		return unstructured.SetNestedSlice(
-			deploymentOrSimilar.Object,
+			resource.Object,
 			containerObjects,
-			"spec",
-			"template",
-			"spec",
-			"initContainers",
+			podContainersPath(resource.GetKind(), field)...,
 		)
 	})
 }
 
+func podContainersPath(kind, field string) []string {
+	return append(slices.Clone(podSpecPaths[kind]), field)
+}
+
 func getContainersGeneric(getNestedSliceFn func() ([]any, bool, error)) ([]*unstructured.Unstructured, error) {
 	containers, found, err := getNestedSliceFn()
 	if err != nil {
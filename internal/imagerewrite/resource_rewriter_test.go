@@ -19,14 +19,18 @@ func TestResourceRewriter(t *testing.T) {
 	t.Run("UnsupportedKind", func(t *testing.T) {
 		t.Parallel()
 		// given
-		cronJobResource, err := parseToUnstructured(testCronJob)
+		localizedImages, err := imagerewrite.AsImageReferences([]string{
+			"private-registry.com/prod/busybox:1.28",
+		})
+		require.NoError(t, err, "Failed to create target images from provided image references")
+		configMapResource, err := parseToUnstructured(testConfigMap)
 		require.NoError(t, err, "Failed to parse test ConfigMap to unstructured")
-		unmodifiedYAML := mustYAML(cronJobResource) // Store the original YAML for comparison later
+		unmodifiedYAML := mustYAML(configMapResource) // Store the original YAML for comparison later
 		// when
-		err = resourceRewriter.ReplaceImages(cronJobResource, nil)
+		err = resourceRewriter.ReplaceImages(configMapResource, localizedImages)
 		// then
 		require.NoError(t, err, "Unexpected error when re-writing unsupported resource kind")
-		rewrittenYAML := mustYAML(cronJobResource)
+		rewrittenYAML := mustYAML(configMapResource)
 
 		require.YAMLEq(
 			t,
@@ -324,4 +328,100 @@ func TestResourceRewriter(t *testing.T) {
 				to("image: really-private-registry.com/first/init-container/other-init-image:1.1.1"),
 		)
 	})
+
+	t.Run("CronJobRewriteAll", func(t *testing.T) {
+		t.Parallel()
+		// given
+		localizedImages, err := imagerewrite.AsImageReferences([]string{
+			"private-registry.com/prod/busybox:1.28",
+		})
+		require.NoError(t, err, "Failed to create target images from provided image references")
+
+		cronJobResource, err := parseToUnstructured(testCronJob)
+
+		require.NoError(t, err, "Failed to parse test CronJob to unstructured")
+		unmodifiedYAML := mustYAML(cronJobResource) // Store the original YAML for comparison later
+
+		// when
+		err = resourceRewriter.ReplaceImages(cronJobResource, localizedImages)
+		// then
+		require.NoError(t, err, "Failed to rewrite container images")
+
+		cp := newChangesComparator(t, asLines(unmodifiedYAML), asLines(mustYAML(cronJobResource)))
+		cp.verify(
+			valueOf(
+				"the image in the job template container",
+			).shouldChangeFrom("image: busybox:1.28").
+				to("image: private-registry.com/prod/busybox:1.28"),
+		)
+	})
+
+	t.Run("DaemonSetWithInitContainerRewriteAll", func(t *testing.T) {
+		t.Parallel()
+		// given
+		localizedImages, err := imagerewrite.AsImageReferences([]string{
+			"private-registry.com/prod/node-agent:2.0.0",
+			"private-registry.com/prod/init-image:1.0.0",
+			"private-registry.com/prod/helper-image:1.0.0",
+		})
+		require.NoError(t, err, "Failed to create target images from provided image references")
+
+		daemonSetResource, err := parseToUnstructured(testDaemonSetWithInitContainer)
+
+		require.NoError(t, err, "Failed to parse test DaemonSet to unstructured")
+		unmodifiedYAML := mustYAML(daemonSetResource) // Store the original YAML for comparison later
+
+		// when
+		err = resourceRewriter.ReplaceImages(daemonSetResource, localizedImages)
+		// then
+		require.NoError(t, err, "Failed to rewrite container images")
+
+		cp := newChangesComparator(t, asLines(unmodifiedYAML), asLines(mustYAML(daemonSetResource)))
+		cp.verify(
+			valueOf(
+				"the image in the container",
+			).shouldChangeFrom("image: europe-docker.pkg.dev/kyma-project/prod/node-agent:2.0.0").
+				to("image: private-registry.com/prod/node-agent:2.0.0"),
+			valueOf(
+				"the env var in the init container",
+			).shouldChangeFrom("value: example.com/myrepo/helper-image:1.0.0").
+				to("value: private-registry.com/prod/helper-image:1.0.0"),
+			valueOf(
+				"the image in the init container",
+			).shouldChangeFrom("image: example.com/myrepo/init-image:1.0.0").
+				to("image: private-registry.com/prod/init-image:1.0.0"),
+		)
+	})
+
+	t.Run("PodWithEphemeralContainerRewriteAll", func(t *testing.T) {
+		t.Parallel()
+		// given
+		localizedImages, err := imagerewrite.AsImageReferences([]string{
+			"private-registry.com/prod/migrate:0.1.0",
+			"private-registry.com/prod/busybox:1.28",
+		})
+		require.NoError(t, err, "Failed to create target images from provided image references")
+
+		podResource, err := parseToUnstructured(testPodWithEphemeralContainer)
+
+		require.NoError(t, err, "Failed to parse test Pod to unstructured")
+		unmodifiedYAML := mustYAML(podResource) // Store the original YAML for comparison later
+
+		// when
+		err = resourceRewriter.ReplaceImages(podResource, localizedImages)
+		// then
+		require.NoError(t, err, "Failed to rewrite container images")
+
+		cp := newChangesComparator(t, asLines(unmodifiedYAML), asLines(mustYAML(podResource)))
+		cp.verify(
+			valueOf(
+				"the image in the container",
+			).shouldChangeFrom("image: europe-docker.pkg.dev/kyma-project/prod/migrate:0.1.0").
+				to("image: private-registry.com/prod/migrate:0.1.0"),
+			valueOf(
+				"the image in the ephemeral container",
+			).shouldChangeFrom("image: busybox:1.28").
+				to("image: private-registry.com/prod/busybox:1.28"),
+		)
+	})
 }
@@ -3,6 +3,7 @@ package imagerewrite
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/distribution/reference"
@@ -18,8 +19,34 @@ var (
 	ErrFailedToSetNewEnvListInPodContainer = errors.New("failed to set new env list in pod container")
 )
 
+// podTemplateSpecPath is the path of the Pod spec in workloads with a Pod template.
+var podTemplateSpecPath = []string{"spec", "template", "spec"}
+
+// podSpecPaths maps the supported kinds to the path of the Pod spec in their resources.
+var podSpecPaths = map[string][]string{
+	"Pod":                   {"spec"},
+	"Deployment":            podTemplateSpecPath,
+	"StatefulSet":           podTemplateSpecPath,
+	"DaemonSet":             podTemplateSpecPath,
+	"ReplicaSet":            podTemplateSpecPath,
+	"ReplicationController": podTemplateSpecPath,
+	"Job":                   podTemplateSpecPath,
+	"CronJob":               {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// containerFields are the fields of a Pod spec holding the containers whose images are rewritten.
+var containerFields = []string{"containers", "initContainers", "ephemeralContainers"}
+
+// IsSupportedKind reports whether resources of the given kind contain a Pod spec with images to rewrite.
 func IsSupportedKind(kind string) bool {
-	return kind == "Deployment" || kind == "StatefulSet"
+	_, ok := podSpecPaths[kind]
+	return ok
+}
+
+// PodSpecPath returns the path of the Pod spec in resources of the given kind and whether the kind is supported.
+func PodSpecPath(kind string) ([]string, bool) {
+	path, ok := podSpecPaths[kind]
+	return slices.Clone(path), ok
 }
 
 // NameAndTag represents the Docker image name and tag in the format <image>:<tag>.
@@ -155,6 +182,44 @@ func (r *PodContainerImageRewriter) Rewrite(
 	return nil
 }
 
+// PodContainerImageDigestRewriter is a rewriter that pins the image of a pod container to the digest
+// of the target image matching it in name and tag. The host and path of the image are kept, so that it can
+// be combined with the PodContainerImageRewriter. Images that already have a digest are not changed.
+type PodContainerImageDigestRewriter struct{}
+
+func (r *PodContainerImageDigestRewriter) Rewrite(
+	targetImages []*DockerImageReference,
+	podContainer *unstructured.Unstructured,
+) error {
+	existingImageValue, found, err := unstructured.NestedString(podContainer.Object, "image")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrFindingImageInPodContainer, err.Error())
+	}
+	if !found {
+		return nil
+	}
+
+	existingImage, err := NewDockerImageReference(existingImageValue)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidImageReference, err.Error())
+	}
+	if len(existingImage.Digest) > 0 {
+		return nil
+	}
+
+	for _, targetImage := range targetImages {
+		if len(targetImage.Digest) > 0 && targetImage.Matches(existingImage.NameAndTag) {
+			existingImage.Digest = targetImage.Digest
+			if err := unstructured.SetNestedField(podContainer.Object, existingImage.String(), "image"); err != nil {
+				return fmt.Errorf("%w: %v", ErrFailedToSetNewImageInPodContainer, err.Error())
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
 // PodContainerEnvsRewriter is a rewriter that rewrites container env vars in a Kubernetes manifest.
 type PodContainerEnvsRewriter struct{}
 
@@ -169,6 +169,56 @@ spec:
           restartPolicy: OnFailure
 `
 
+const testConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: images
+data:
+  image: busybox:1.28
+`
+
+// testDaemonSetWithInitContainer is a DaemonSet with a single container and an init container.
+const testDaemonSetWithInitContainer = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: node-agent
+spec:
+  selector:
+    matchLabels:
+      app: node-agent
+  template:
+    metadata:
+      labels:
+        app: node-agent
+    spec:
+      containers:
+      - name: agent
+        image: europe-docker.pkg.dev/kyma-project/prod/node-agent:2.0.0
+      initContainers:
+      - name: init
+        image: example.com/myrepo/init-image:1.0.0
+        env:
+        - name: HELPER_IMAGE
+          value: example.com/myrepo/helper-image:1.0.0
+`
+
+// testPodWithEphemeralContainer is a bare Pod with a single container and an ephemeral container.
+const testPodWithEphemeralContainer = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: migration
+spec:
+  containers:
+  - name: migrate
+    image: europe-docker.pkg.dev/kyma-project/prod/migrate:0.1.0
+  ephemeralContainers:
+  - name: debugger
+    image: busybox:1.28
+`
+
 // changesComparator is used to make comparison between original and rewritten object in a YAML format easier.
 // It compares YAMLs line-by-line, assuming that the overall structure is identical.
 type changesComparator struct {
@@ -335,7 +385,7 @@ func diffLines(lines1, lines2 []string) []int {
 // getFirstContainer retrieves the first container from a deployment-like resource.
 func getFirstContainer(t *testing.T, deployment *unstructured.Unstructured) *unstructured.Unstructured {
 	t.Helper()
-	res, err := imagerewrite.GetPodContainers(deployment)
+	res, err := imagerewrite.GetPodContainers(deployment, "containers")
 	require.NoError(t, err, "Failed to get containers from deployment resource")
 	return res[0]
 }
@@ -347,10 +397,10 @@ func setFirstContainer(
 	container *unstructured.Unstructured,
 ) error {
 	t.Helper()
-	containers, err := imagerewrite.GetPodContainers(deployment)
+	containers, err := imagerewrite.GetPodContainers(deployment, "containers")
 	require.NoError(t, err, "Failed to get containers from deployment resource")
 	containers[0] = container
-	return imagerewrite.SetPodContainers(deployment, containers)
+	return imagerewrite.SetPodContainers(deployment, "containers", containers)
 }
 
 // reorder is a helper function to reorder the input slice to
@@ -3,11 +3,11 @@ package maintenancewindows
 import (
 	"errors"
 	"fmt"
-	"os"
+	"slices"
+	"strings"
 	"time"
 
-	"github.com/go-logr/logr"
-
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
 )
@@ -15,8 +15,14 @@ import (
 var (
 	ErrNoMaintenanceWindowPolicyConfigured = errors.New("no maintenance window policy configured")
 	ErrPolicyFileNotFound                  = errors.New("maintenance window policy file not found")
+	ErrInvalidMaintenanceWindow            = errors.New("invalid maintenance window of the runtime")
 )
 
+// windowTimeFormats are the formats of the times of the day of a maintenance window, the Gardener format first.
+var windowTimeFormats = []string{"150405-0700", "15:04:05Z07:00"}
+
+var weekDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
 type MaintenanceWindowPolicy interface {
 	Resolve(runtime *resolver.Runtime, opts ...any) (*resolver.ResolvedWindow, error)
 }
@@ -28,51 +34,12 @@ type MaintenanceWindow struct {
 	minDuration             resolver.MinWindowSize
 }
 
-func InitializeMaintenanceWindow(log logr.Logger,
-	policiesDirectory,
-	policyName string,
-	minWindowSize time.Duration,
-) (MaintenanceWindow, error) {
-	if err := os.Setenv(resolver.PolicyPathENV, policiesDirectory); err != nil {
-		return MaintenanceWindow{
-			MaintenanceWindowPolicy: nil,
-		}, fmt.Errorf("failed to set the policy path env variable, %w", err)
-	}
-
-	policyFilePath := fmt.Sprintf("%s/%s.json", policiesDirectory, policyName)
-	if !MaintenancePolicyFileExists(policyFilePath) {
-		log.Error(ErrPolicyFileNotFound, "maintenance windows policy file does not exist")
-		return MaintenanceWindow{
-			MaintenanceWindowPolicy: nil,
-		}, fmt.Errorf("maintenance windows policy file does not exist, %w", ErrPolicyFileNotFound)
-	}
-
-	maintenancePolicyPool, err := resolver.GetMaintenancePolicyPool()
-	if err != nil {
-		return MaintenanceWindow{
-			MaintenanceWindowPolicy: nil,
-		}, fmt.Errorf("failed to get maintenance policy pool, %w", err)
-	}
-
-	maintenancePolicy, err := resolver.GetMaintenancePolicy(maintenancePolicyPool, policyName)
-	if err != nil {
-		return MaintenanceWindow{
-			MaintenanceWindowPolicy: nil,
-		}, fmt.Errorf("failed to get maintenance window policy, %w", err)
-	}
-
+// NewMaintenanceWindow returns the MaintenanceWindow that resolves the windows with the policy.
+func NewMaintenanceWindow(policy MaintenanceWindowPolicy, minWindowSize time.Duration) MaintenanceWindow {
 	return MaintenanceWindow{
-		MaintenanceWindowPolicy: maintenancePolicy,
+		MaintenanceWindowPolicy: policy,
 		minDuration:             resolver.MinWindowSize(minWindowSize),
-	}, nil
-}
-
-func MaintenancePolicyFileExists(policyFilePath string) bool {
-	if _, err := os.Stat(policyFilePath); os.IsNotExist(err) {
-		return false
 	}
-
-	return true
 }
 
 // IsRequired determines if a maintenance window is required to update the given module.
@@ -101,11 +68,9 @@ func (mw MaintenanceWindow) IsActive(kyma *v1beta2.Kyma) (bool, error) {
 		return false, ErrNoMaintenanceWindowPolicyConfigured
 	}
 
-	runtime := &resolver.Runtime{
-		GlobalAccountID: kyma.GetGlobalAccount(),
-		Region:          kyma.GetRegion(),
-		PlatformRegion:  kyma.GetPlatformRegion(),
-		Plan:            kyma.GetPlan(),
+	runtime, err := runtimeOf(kyma)
+	if err != nil {
+		return false, err
 	}
 
 	resolvedWindow, err := mw.MaintenanceWindowPolicy.Resolve(runtime,
@@ -122,3 +87,66 @@ func (mw MaintenanceWindow) IsActive(kyma *v1beta2.Kyma) (bool, error) {
 
 	return false, nil
 }
+
+// runtimeOf describes the runtime of the Kyma for the policy, including the maintenance window chosen for it, as
+// propagated to the labels and annotations of the Kyma during provisioning.
+func runtimeOf(kyma *v1beta2.Kyma) (*resolver.Runtime, error) {
+	runtime := &resolver.Runtime{
+		InstanceID:      kyma.GetInstanceID(),
+		RuntimeID:       kyma.GetRuntimeID(),
+		GlobalAccountID: kyma.GetGlobalAccount(),
+		SubAccountID:    kyma.GetSubAccountID(),
+		ShootName:       kyma.GetShootName(),
+		Plan:            kyma.GetPlan(),
+		Region:          kyma.GetRegion(),
+		PlatformRegion:  kyma.GetPlatformRegion(),
+	}
+
+	begin, hasBegin := kyma.Annotations[shared.MaintenanceWindowBeginAnnotation]
+	end, hasEnd := kyma.Annotations[shared.MaintenanceWindowEndAnnotation]
+	if !hasBegin && !hasEnd {
+		return runtime, nil
+	}
+	if !hasBegin || !hasEnd {
+		return nil, fmt.Errorf("%w: both %s and %s must be set", ErrInvalidMaintenanceWindow,
+			shared.MaintenanceWindowBeginAnnotation, shared.MaintenanceWindowEndAnnotation)
+	}
+
+	var err error
+	if runtime.MaintenanceWindowBegin, err = parseWindowTime(begin); err != nil {
+		return nil, err
+	}
+	if runtime.MaintenanceWindowEnd, err = parseWindowTime(end); err != nil {
+		return nil, err
+	}
+	if runtime.MaintenanceDays, err = parseWindowDays(kyma.Annotations[shared.MaintenanceDaysAnnotation]); err != nil {
+		return nil, err
+	}
+	return runtime, nil
+}
+
+func parseWindowTime(value string) (time.Time, error) {
+	for _, format := range windowTimeFormats {
+		if parsed, err := time.Parse(format, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: time %q is neither in the format HHMMSS+ZZZZ nor HH:MM:SSZ",
+		ErrInvalidMaintenanceWindow, value)
+}
+
+func parseWindowDays(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var days []string
+	for day := range strings.SplitSeq(value, ",") {
+		day = strings.TrimSpace(day)
+		if !slices.Contains(weekDays, day) {
+			return nil, fmt.Errorf("%w: unknown day %q, expected one of %s", ErrInvalidMaintenanceWindow, day,
+				strings.Join(weekDays, ", "))
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
@@ -2,11 +2,9 @@ package maintenancewindows_test
 
 import (
 	"errors"
-	"fmt"
 	"testing"
 	"time"
 
-	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -18,117 +16,6 @@ import (
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils/random"
 )
 
-func TestMaintenancePolicyFileExists_FileNotExists(t *testing.T) {
-	got := maintenancewindows.MaintenancePolicyFileExists("testdata/file.json")
-
-	require.False(t, got)
-}
-
-func TestMaintenancePolicyFileExists_FileExists(t *testing.T) {
-	got := maintenancewindows.MaintenancePolicyFileExists("testdata/policy.json")
-
-	require.True(t, got)
-}
-
-func TestInitializeMaintenanceWindowsPolicy_FileNotExist(t *testing.T) {
-	got, err := maintenancewindows.InitializeMaintenanceWindow(logr.Logger{},
-		"testdata",
-		"policy-1",
-		20*time.Minute)
-
-	require.Nil(t, got.MaintenanceWindowPolicy)
-	require.ErrorContains(t, err, maintenancewindows.ErrPolicyFileNotFound.Error())
-}
-
-func TestInitializeMaintenanceWindowsPolicy_DirectoryNotExist(t *testing.T) {
-	got, err := maintenancewindows.InitializeMaintenanceWindow(logr.Logger{},
-		"files",
-		"policy",
-		20*time.Minute)
-
-	require.Nil(t, got.MaintenanceWindowPolicy)
-	require.ErrorContains(t, err, maintenancewindows.ErrPolicyFileNotFound.Error())
-}
-
-func TestInitializeMaintenanceWindowsPolicy_InvalidPolicy(t *testing.T) {
-	got, err := maintenancewindows.InitializeMaintenanceWindow(logr.Logger{},
-		"testdata",
-		"invalid-policy",
-		20*time.Minute)
-
-	require.Nil(t, got.MaintenanceWindowPolicy)
-	require.ErrorContains(t, err, "failed to get maintenance window policy")
-}
-
-func TestInitializeMaintenanceWindowsPolicy_WhenFileExists_CorrectPolicyIsRead(t *testing.T) {
-	got, err := maintenancewindows.InitializeMaintenanceWindow(logr.Logger{},
-		"testdata",
-		"policy",
-		20*time.Minute)
-	require.NoError(t, err)
-
-	ruleOneBeginTime, err := parseTime("01:00:00+00:00")
-	require.NoError(t, err)
-	ruleOneEndTime, err := parseTime("01:00:00+00:00")
-	require.NoError(t, err)
-
-	ruleTwoBeginTime, err := parseTime("21:00:00+00:00")
-	require.NoError(t, err)
-	ruleTwoEndTime, err := parseTime("00:00:00+00:00")
-	require.NoError(t, err)
-
-	defaultBeginTime, err := parseTime("21:00:00+00:00")
-	require.NoError(t, err)
-	defaultEndTime, err := parseTime("23:00:00+00:00")
-	require.NoError(t, err)
-
-	expectedPolicy := &resolver.MaintenanceWindowPolicy{
-		Rules: []resolver.MaintenancePolicyRule{
-			{
-				Match: resolver.MaintenancePolicyMatch{
-					Plan: func() *resolver.Regexp { r := resolver.NewRegexp("trial|free"); return &r }(),
-				},
-				Windows: resolver.MaintenanceWindows{
-					{
-						Days:  []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
-						Begin: resolver.WindowTime(ruleOneBeginTime),
-						End:   resolver.WindowTime(ruleOneEndTime),
-					},
-				},
-			},
-			{
-				Match: resolver.MaintenancePolicyMatch{
-					Region: func() *resolver.Regexp { r := resolver.NewRegexp("europe|eu-|uksouth"); return &r }(),
-				},
-				Windows: resolver.MaintenanceWindows{
-					{
-						Days:  []string{"Sat"},
-						Begin: resolver.WindowTime(ruleTwoBeginTime),
-						End:   resolver.WindowTime(ruleTwoEndTime),
-					},
-				},
-			},
-		},
-		Default: resolver.MaintenanceWindow{
-			Days:  []string{"Sat"},
-			Begin: resolver.WindowTime(defaultBeginTime),
-			End:   resolver.WindowTime(defaultEndTime),
-		},
-	}
-
-	require.NoError(t, err)
-	require.Equal(t, expectedPolicy, got.MaintenanceWindowPolicy)
-}
-
-func parseTime(value string) (time.Time, error) {
-	t, err := time.Parse("15:04:05Z07:00", value)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
-	}
-
-	return t, nil
-}
-
 var installedModuleStatus = v1beta2.ModuleStatus{
 	Name:    "module-name",
 	Version: "1.0.0",
@@ -281,13 +168,21 @@ func Test_IsActive_PassesRuntimeArgumentCorrectly(t *testing.T) {
 	}
 
 	runtime := resolver.Runtime{
+		InstanceID:      random.Name(),
+		RuntimeID:       random.Name(),
 		GlobalAccountID: random.Name(),
+		SubAccountID:    random.Name(),
+		ShootName:       random.Name(),
 		Region:          random.Name(),
 		PlatformRegion:  random.Name(),
 		Plan:            random.Name(),
 	}
 	kyma := builder.NewKymaBuilder().
+		WithLabel(shared.InstanceIDLabel, runtime.InstanceID).
+		WithLabel(shared.RuntimeIDLabel, runtime.RuntimeID).
 		WithLabel(shared.GlobalAccountIDLabel, runtime.GlobalAccountID).
+		WithLabel(shared.SubAccountIDLabel, runtime.SubAccountID).
+		WithLabel(shared.ShootNameLabel, runtime.ShootName).
 		WithLabel(shared.RegionLabel, runtime.Region).
 		WithLabel(shared.PlatformRegionLabel, runtime.PlatformRegion).
 		WithLabel(shared.PlanLabel, runtime.Plan).
@@ -300,6 +195,72 @@ func Test_IsActive_PassesRuntimeArgumentCorrectly(t *testing.T) {
 	assert.Equal(t, runtime, receivedRuntime)
 }
 
+func Test_IsActive_PassesMaintenanceWindowOfRuntime(t *testing.T) {
+	receivedRuntime := resolver.Runtime{}
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{
+		MaintenanceWindowPolicy: maintenanceWindowRuntimeArgStub{
+			receivedRuntime: &receivedRuntime,
+		},
+	}
+	kyma := builder.NewKymaBuilder().
+		WithAnnotation(shared.MaintenanceWindowBeginAnnotation, "220000+0100").
+		WithAnnotation(shared.MaintenanceWindowEndAnnotation, "02:00:00Z").
+		WithAnnotation(shared.MaintenanceDaysAnnotation, "Sat, Sun").
+		Build()
+
+	_, err := maintenanceWindow.IsActive(kyma)
+
+	require.NoError(t, err)
+	assert.Equal(t, 22, receivedRuntime.MaintenanceWindowBegin.Hour())
+	_, offset := receivedRuntime.MaintenanceWindowBegin.Zone()
+	assert.Equal(t, int(time.Hour.Seconds()), offset)
+	assert.Equal(t, 2, receivedRuntime.MaintenanceWindowEnd.Hour())
+	assert.Equal(t, []string{"Sat", "Sun"}, receivedRuntime.MaintenanceDays)
+}
+
+func Test_IsActive_Returns_Error_WhenMaintenanceWindowOfRuntimeIsInvalid(t *testing.T) {
+	maintenanceWindow := maintenancewindows.MaintenanceWindow{
+		MaintenanceWindowPolicy: maintenanceWindowActiveStub{},
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+	}{
+		{
+			name:        "missing end",
+			annotations: map[string]string{shared.MaintenanceWindowBeginAnnotation: "220000+0000"},
+		},
+		{
+			name: "invalid time",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "22h",
+				shared.MaintenanceWindowEndAnnotation:   "020000+0000",
+			},
+		},
+		{
+			name: "invalid day",
+			annotations: map[string]string{
+				shared.MaintenanceWindowBeginAnnotation: "220000+0000",
+				shared.MaintenanceWindowEndAnnotation:   "020000+0000",
+				shared.MaintenanceDaysAnnotation:        "Saturday",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			kymaBuilder := builder.NewKymaBuilder()
+			for key, value := range testCase.annotations {
+				kymaBuilder = kymaBuilder.WithAnnotation(key, value)
+			}
+
+			result, err := maintenanceWindow.IsActive(kymaBuilder.Build())
+
+			assert.False(t, result)
+			require.ErrorIs(t, err, maintenancewindows.ErrInvalidMaintenanceWindow)
+		})
+	}
+}
+
 func Test_IsActive_Returns_False_And_Error_WhenNoPolicyConfigured(t *testing.T) {
 	maintenanceWindow := maintenancewindows.MaintenanceWindow{
 		MaintenanceWindowPolicy: nil,
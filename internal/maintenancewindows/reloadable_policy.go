@@ -0,0 +1,119 @@
+package maintenancewindows
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+type PolicyReadMetrics interface {
+	RecordConfigReadSuccess(success bool)
+}
+
+// ReloadablePolicy is the maintenance window policy read from the policy file, which is read again whenever the file
+// changes, so that changed maintenance windows apply without restarting Lifecycle Manager. A changed policy that
+// cannot be read is rejected, and the policy read last stays in use.
+type ReloadablePolicy struct {
+	log               logr.Logger
+	policiesDirectory string
+	policyName        string
+	interval          time.Duration
+	metrics           PolicyReadMetrics
+
+	policy atomic.Pointer[resolver.MaintenanceWindowPolicy]
+
+	mu       sync.Mutex
+	lastRead map[string][]byte
+}
+
+func NewReloadablePolicy(log logr.Logger, policiesDirectory, policyName string, interval time.Duration,
+	metrics PolicyReadMetrics,
+) *ReloadablePolicy {
+	return &ReloadablePolicy{
+		log:               log,
+		policiesDirectory: policiesDirectory,
+		policyName:        policyName,
+		interval:          interval,
+		metrics:           metrics,
+	}
+}
+
+// Resolve resolves the maintenance window with the policy read last.
+func (p *ReloadablePolicy) Resolve(runtime *resolver.Runtime, opts ...any) (*resolver.ResolvedWindow, error) {
+	policy := p.policy.Load()
+	if policy == nil {
+		return nil, ErrNoMaintenanceWindowPolicyConfigured
+	}
+	return policy.Resolve(runtime, opts...)
+}
+
+// Reload reads the policy file again if it changed since it was read last, and replaces the policy if it can be
+// read. The outcome is recorded in the metrics.
+func (p *ReloadablePolicy) Reload() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pool, readErr := resolver.ReadMaintenancePolicyPool(p.policiesDirectory)
+	policyFiles := map[string][]byte{}
+	for _, fileName := range resolver.PolicyFileNames(p.policyName) {
+		if data, found := pool[fileName]; found {
+			policyFiles[fileName] = *data
+		}
+	}
+	if p.lastRead != nil && maps.EqualFunc(policyFiles, p.lastRead, bytes.Equal) {
+		return nil
+	}
+	if len(policyFiles) == 0 {
+		err := fmt.Errorf("%w: %s in %s", ErrPolicyFileNotFound, p.policyName, p.policiesDirectory)
+		if readErr != nil {
+			err = fmt.Errorf("%w: %w", err, readErr)
+		}
+		return p.reject(policyFiles, err)
+	}
+
+	policy, err := resolver.GetMaintenancePolicy(pool, p.policyName)
+	if err != nil {
+		return p.reject(policyFiles, fmt.Errorf("failed to get maintenance window policy, %w", err))
+	}
+	p.policy.Store(policy)
+	p.lastRead = policyFiles
+	p.metrics.RecordConfigReadSuccess(true)
+	return nil
+}
+
+// Start reads the policy file again in the interval until the context is done.
+func (p *ReloadablePolicy) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := p.Reload(); err != nil {
+				p.log.Error(err, "rejected the maintenance window policy, keeping the policy read last")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false, so that the policy is also kept up to date in replicas that are not the leader.
+func (p *ReloadablePolicy) NeedLeaderElection() bool {
+	return false
+}
+
+// reject records the policy files as read, so that the error is only reported once per change of the files, and
+// keeps the policy read last.
+func (p *ReloadablePolicy) reject(policyFiles map[string][]byte, err error) error {
+	p.lastRead = policyFiles
+	p.metrics.RecordConfigReadSuccess(false)
+	return err
+}
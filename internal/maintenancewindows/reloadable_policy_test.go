@@ -0,0 +1,108 @@
+package maintenancewindows_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/maintenancewindows"
+	"github.com/kyma-project/lifecycle-manager/maintenancewindows/resolver"
+)
+
+const (
+	jsonPolicy = `{"default": {"begin": "2099-01-01T00:00:00Z", "end": "2099-01-01T04:00:00Z"}}`
+	yamlPolicy = `
+default:
+  begin: 2099-02-01T00:00:00Z
+  end: 2099-02-01T04:00:00Z
+`
+)
+
+func TestReloadablePolicy_Reload_ReplacesPolicyWhenFileChanges(t *testing.T) {
+	directory := t.TempDir()
+	metrics := &policyReadMetricsStub{}
+	policy := maintenancewindows.NewReloadablePolicy(logr.Discard(), directory, "policy", time.Minute, metrics)
+
+	writePolicyFile(t, directory, "policy.json", jsonPolicy)
+	require.NoError(t, policy.Reload())
+	assert.Equal(t, "2099-01-01T00:00:00Z", resolveBegin(t, policy))
+	assert.True(t, metrics.success)
+
+	require.NoError(t, os.Remove(filepath.Join(directory, "policy.json")))
+	writePolicyFile(t, directory, "policy.yaml", yamlPolicy)
+	require.NoError(t, policy.Reload())
+	assert.Equal(t, "2099-02-01T00:00:00Z", resolveBegin(t, policy))
+	assert.True(t, metrics.success)
+}
+
+func TestReloadablePolicy_Reload_KeepsPolicyWhenChangedPolicyIsInvalid(t *testing.T) {
+	directory := t.TempDir()
+	metrics := &policyReadMetricsStub{}
+	policy := maintenancewindows.NewReloadablePolicy(logr.Discard(), directory, "policy", time.Minute, metrics)
+	writePolicyFile(t, directory, "policy.json", jsonPolicy)
+	require.NoError(t, policy.Reload())
+
+	writePolicyFile(t, directory, "policy.json", `{"default": `)
+	require.Error(t, policy.Reload())
+	assert.False(t, metrics.success)
+	assert.Equal(t, "2099-01-01T00:00:00Z", resolveBegin(t, policy))
+
+	// the rejected policy is only reported once
+	require.NoError(t, policy.Reload())
+}
+
+func TestReloadablePolicy_Reload_ReturnsErrorWhenPolicyFileDoesNotExist(t *testing.T) {
+	metrics := &policyReadMetricsStub{success: true}
+	policy := maintenancewindows.NewReloadablePolicy(logr.Discard(), filepath.Join(t.TempDir(), "missing"), "policy",
+		time.Minute, metrics)
+
+	require.ErrorIs(t, policy.Reload(), maintenancewindows.ErrPolicyFileNotFound)
+	assert.False(t, metrics.success)
+
+	_, err := policy.Resolve(&resolver.Runtime{})
+	require.ErrorIs(t, err, maintenancewindows.ErrNoMaintenanceWindowPolicyConfigured)
+}
+
+func TestReloadablePolicy_Start_ReloadsPolicyInInterval(t *testing.T) {
+	directory := t.TempDir()
+	policy := maintenancewindows.NewReloadablePolicy(logr.Discard(), directory, "policy", 10*time.Millisecond,
+		&policyReadMetricsStub{})
+	writePolicyFile(t, directory, "policy.json", jsonPolicy)
+	require.NoError(t, policy.Reload())
+
+	go func() {
+		assert.NoError(t, policy.Start(t.Context()))
+	}()
+	writePolicyFile(t, directory, "policy.json",
+		`{"default": {"begin": "2099-03-01T00:00:00Z", "end": "2099-03-01T04:00:00Z"}}`)
+
+	assert.Eventually(t, func() bool {
+		window, err := policy.Resolve(&resolver.Runtime{})
+		return err == nil && window.Begin.Format(time.RFC3339) == "2099-03-01T00:00:00Z"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func writePolicyFile(t *testing.T, directory, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(directory, name), []byte(content), 0o600))
+}
+
+func resolveBegin(t *testing.T, policy *maintenancewindows.ReloadablePolicy) string {
+	t.Helper()
+	window, err := policy.Resolve(&resolver.Runtime{})
+	require.NoError(t, err)
+	return window.Begin.Format(time.RFC3339)
+}
+
+type policyReadMetricsStub struct {
+	success bool
+}
+
+func (m *policyReadMetricsStub) RecordConfigReadSuccess(success bool) {
+	m.success = success
+}
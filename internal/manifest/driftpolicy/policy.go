@@ -0,0 +1,205 @@
+// Package driftpolicy evaluates the drift rules of a Manifest, which determine whether changes in the remote cluster
+// to the fields of module resources are reverted, kept, or kept and reported.
+package driftpolicy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var ErrInvalidPath = errors.New("invalid drift rule path")
+
+// Policy is the ordered list of drift rules of a Manifest, in which the last rule matching a resource or field
+// applies. Resources and fields without a matching rule are reverted.
+type Policy []v1beta2.DriftRule
+
+// ResourceAction returns the action for the resource as a whole, as set by the last matching rule without paths.
+// Resources with an action other than Revert are only created, but neither updated nor pruned.
+func (p Policy) ResourceAction(obj client.Object) v1beta2.DriftAction {
+	action := v1beta2.DriftActionRevert
+	for _, rule := range p {
+		if len(rule.Paths) == 0 && matches(&rule, obj) {
+			action = rule.Action
+		}
+	}
+	return action
+}
+
+// FieldAction returns the action for the field of the resource at the path, as set by the last matching rule
+// without paths or with a path covering the field.
+func (p Policy) FieldAction(obj client.Object, path fieldpath.Path) v1beta2.DriftAction {
+	action := v1beta2.DriftActionRevert
+	for _, rule := range p {
+		if !matches(&rule, obj) {
+			continue
+		}
+		if len(rule.Paths) == 0 || slices.ContainsFunc(rule.Paths, func(rulePath string) bool {
+			segments, err := ParsePath(rulePath)
+			return err == nil && covers(segments, path)
+		}) {
+			action = rule.Action
+		}
+	}
+	return action
+}
+
+// KeptPaths returns the paths of the rules for the fields of the resource whose changes are kept, in the order of
+// the rules.
+func (p Policy) KeptPaths(obj client.Object) []string {
+	actions := map[string]v1beta2.DriftAction{}
+	var paths []string
+	for _, rule := range p {
+		if !matches(&rule, obj) {
+			continue
+		}
+		for _, path := range rule.Paths {
+			if _, found := actions[path]; !found {
+				paths = append(paths, path)
+			}
+			actions[path] = rule.Action
+		}
+	}
+	return slices.DeleteFunc(paths, func(path string) bool {
+		return actions[path] == v1beta2.DriftActionRevert
+	})
+}
+
+// RemoveKeptFields removes the fields from the target, as rendered from the module manifest, wherever the rules keep
+// changes, so that applying the target neither sets nor takes over these fields. The rules are processed in order,
+// so that a later rule reverting a field restores the rendered value.
+func (p Policy) RemoveKeptFields(target *unstructured.Unstructured) error {
+	rendered := target.DeepCopy()
+	return p.processPaths(target, func(segments []string, action v1beta2.DriftAction) error {
+		if action == v1beta2.DriftActionRevert {
+			return copyField(target, rendered, segments)
+		}
+		unstructured.RemoveNestedField(target.Object, segments...)
+		return nil
+	})
+}
+
+// KeptFields returns an object with the identity of the live resource and only its fields whose changes the rules
+// keep. The rules are processed in order, so that a later rule reverting a field removes it again.
+func (p Policy) KeptFields(live *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	kept := &unstructured.Unstructured{}
+	kept.SetGroupVersionKind(live.GroupVersionKind())
+	kept.SetName(live.GetName())
+	kept.SetNamespace(live.GetNamespace())
+	err := p.processPaths(live, func(segments []string, action v1beta2.DriftAction) error {
+		if action == v1beta2.DriftActionRevert {
+			unstructured.RemoveNestedField(kept.Object, segments...)
+			return nil
+		}
+		return copyField(kept, live, segments)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return kept, nil
+}
+
+// processPaths calls process for each path of the rules matching the object, in the order of the rules.
+func (p Policy) processPaths(obj *unstructured.Unstructured,
+	process func(segments []string, action v1beta2.DriftAction) error,
+) error {
+	for _, rule := range p {
+		if !matches(&rule, obj) {
+			continue
+		}
+		for _, path := range rule.Paths {
+			segments, err := ParsePath(path)
+			if err != nil {
+				return err
+			}
+			if err := process(segments, rule.Action); err != nil {
+				return fmt.Errorf("failed to process field %s of %s/%s: %w",
+					path, obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// ParsePath splits the path of a drift rule into the names of its fields, for example "spec.replicas" into "spec"
+// and "replicas" and "data[config.yaml]" into "data" and "config.yaml". A leading dot is optional.
+func ParsePath(path string) ([]string, error) {
+	remaining := strings.TrimPrefix(path, ".")
+	var segments []string
+	for remaining != "" {
+		var segment string
+		if bracketed, found := strings.CutPrefix(remaining, "["); found {
+			end := strings.Index(bracketed, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("%w %q: missing closing bracket", ErrInvalidPath, path)
+			}
+			segment, remaining = bracketed[:end], bracketed[end+1:]
+		} else {
+			end := strings.IndexAny(remaining, ".[")
+			if end < 0 {
+				end = len(remaining)
+			}
+			segment, remaining = remaining[:end], remaining[end:]
+		}
+		if segment == "" {
+			return nil, fmt.Errorf("%w %q: empty field name", ErrInvalidPath, path)
+		}
+		segments = append(segments, segment)
+		if next, found := strings.CutPrefix(remaining, "."); found {
+			if next == "" {
+				return nil, fmt.Errorf("%w %q: trailing dot", ErrInvalidPath, path)
+			}
+			remaining = next
+		} else if remaining != "" && !strings.HasPrefix(remaining, "[") {
+			return nil, fmt.Errorf("%w %q: unexpected %q", ErrInvalidPath, path, remaining)
+		}
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%w %q: empty path", ErrInvalidPath, path)
+	}
+	return segments, nil
+}
+
+func matches(rule *v1beta2.DriftRule, obj client.Object) bool {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return rule.Group == gvk.Group && rule.Kind == gvk.Kind &&
+		(rule.Name == "" || rule.Name == obj.GetName()) &&
+		(rule.Namespace == "" || rule.Namespace == obj.GetNamespace())
+}
+
+// covers returns whether the field at the path is the field of the segments or one of its children.
+func covers(segments []string, path fieldpath.Path) bool {
+	if len(path) < len(segments) {
+		return false
+	}
+	for i, segment := range segments {
+		if path[i].FieldName == nil || *path[i].FieldName != segment {
+			return false
+		}
+	}
+	return true
+}
+
+func copyField(target, source *unstructured.Unstructured, segments []string) error {
+	value, found, err := unstructured.NestedFieldNoCopy(source.Object, segments...)
+	if err != nil {
+		return fmt.Errorf("failed to read field: %w", err)
+	}
+	if !found {
+		unstructured.RemoveNestedField(target.Object, segments...)
+		return nil
+	}
+	value = machineryruntime.DeepCopyJSONValue(value)
+	if err := unstructured.SetNestedField(target.Object, value, segments...); err != nil {
+		return fmt.Errorf("failed to set field: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package driftpolicy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
+)
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path     string
+		segments []string
+	}{
+		{"spec.replicas", []string{"spec", "replicas"}},
+		{".spec.replicas", []string{"spec", "replicas"}},
+		{"data[config.yaml]", []string{"data", "config.yaml"}},
+		{"metadata.annotations[example.com/tuned].value", []string{"metadata", "annotations", "example.com/tuned",
+			"value"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.path, func(t *testing.T) {
+			t.Parallel()
+			segments, err := driftpolicy.ParsePath(testCase.path)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.segments, segments)
+		})
+	}
+}
+
+func TestParsePath_Invalid(t *testing.T) {
+	t.Parallel()
+	for _, path := range []string{"", ".", "spec.", "spec..replicas", "data[config.yaml", "data[]", "data[a]b"} {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+			_, err := driftpolicy.ParsePath(path)
+			require.ErrorIs(t, err, driftpolicy.ErrInvalidPath)
+		})
+	}
+}
+
+func TestPolicy_ResourceAction(t *testing.T) {
+	t.Parallel()
+	policy := driftpolicy.Policy{
+		{Kind: "ConfigMap", Action: v1beta2.DriftActionReport},
+		{Kind: "ConfigMap", Name: "tuned-config", Namespace: "kyma-system", Action: v1beta2.DriftActionIgnore},
+		{Kind: "ConfigMap", Name: "module-config", Action: v1beta2.DriftActionRevert},
+		{Kind: "ConfigMap", Name: "other-config", Paths: []string{"data"}, Action: v1beta2.DriftActionRevert},
+	}
+
+	assert.Equal(t, v1beta2.DriftActionIgnore, policy.ResourceAction(configMap("kyma-system", "tuned-config")))
+	assert.Equal(t, v1beta2.DriftActionReport, policy.ResourceAction(configMap("default", "tuned-config")))
+	assert.Equal(t, v1beta2.DriftActionRevert, policy.ResourceAction(configMap("kyma-system", "module-config")))
+	assert.Equal(t, v1beta2.DriftActionReport, policy.ResourceAction(configMap("kyma-system", "other-config")))
+	assert.Equal(t, v1beta2.DriftActionRevert, policy.ResourceAction(deployment(3)))
+}
+
+func TestPolicy_FieldAction(t *testing.T) {
+	t.Parallel()
+	policy := driftpolicy.Policy{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec"}, Action: v1beta2.DriftActionReport},
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.replicas"}, Action: v1beta2.DriftActionIgnore},
+		{Kind: "ConfigMap", Paths: []string{"data[config.yaml]"}, Action: v1beta2.DriftActionIgnore},
+	}
+
+	assert.Equal(t, v1beta2.DriftActionIgnore,
+		policy.FieldAction(deployment(3), fieldpath.MakePathOrDie("spec", "replicas")))
+	assert.Equal(t, v1beta2.DriftActionReport,
+		policy.FieldAction(deployment(3), fieldpath.MakePathOrDie("spec", "template", "spec")))
+	assert.Equal(t, v1beta2.DriftActionRevert,
+		policy.FieldAction(deployment(3), fieldpath.MakePathOrDie("metadata", "labels", "team")))
+	assert.Equal(t, v1beta2.DriftActionIgnore,
+		policy.FieldAction(configMap("kyma-system", "tuned-config"), fieldpath.MakePathOrDie("data", "config.yaml")))
+	assert.Equal(t, v1beta2.DriftActionRevert,
+		policy.FieldAction(configMap("kyma-system", "tuned-config"), fieldpath.MakePathOrDie("data", "config")))
+}
+
+func TestPolicy_KeptPaths(t *testing.T) {
+	t.Parallel()
+	policy := driftpolicy.Policy{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.replicas", "spec.paused"},
+			Action: v1beta2.DriftActionIgnore},
+		{Group: "apps", Kind: "Deployment", Paths: []string{"metadata.labels"}, Action: v1beta2.DriftActionReport},
+		{Group: "apps", Kind: "Deployment", Name: "manager", Paths: []string{"spec.paused"},
+			Action: v1beta2.DriftActionRevert},
+	}
+
+	assert.Equal(t, []string{"spec.replicas", "metadata.labels"}, policy.KeptPaths(deployment(3)))
+	assert.Empty(t, policy.KeptPaths(configMap("kyma-system", "tuned-config")))
+}
+
+func TestPolicy_RemoveKeptFields(t *testing.T) {
+	t.Parallel()
+	policy := driftpolicy.Policy{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec"}, Action: v1beta2.DriftActionIgnore},
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.paused"}, Action: v1beta2.DriftActionRevert},
+		{Group: "apps", Kind: "Deployment", Paths: []string{"metadata.labels"}, Action: v1beta2.DriftActionIgnore},
+	}
+	target := deployment(1)
+	require.NoError(t, unstructured.SetNestedField(target.Object, true, "spec", "paused"))
+	target.SetLabels(map[string]string{"app": "manager"})
+
+	require.NoError(t, policy.RemoveKeptFields(target))
+
+	_, found, _ := unstructured.NestedInt64(target.Object, "spec", "replicas")
+	assert.False(t, found)
+	paused, _, _ := unstructured.NestedBool(target.Object, "spec", "paused")
+	assert.True(t, paused)
+	assert.Empty(t, target.GetLabels())
+	assert.Equal(t, "manager", target.GetName())
+}
+
+func TestPolicy_KeptFields(t *testing.T) {
+	t.Parallel()
+	policy := driftpolicy.Policy{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec"}, Action: v1beta2.DriftActionIgnore},
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.paused"}, Action: v1beta2.DriftActionRevert},
+	}
+	live := deployment(5)
+	require.NoError(t, unstructured.SetNestedField(live.Object, true, "spec", "paused"))
+	live.SetLabels(map[string]string{"app": "manager"})
+
+	kept, err := policy.KeptFields(live)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "manager", "namespace": "kyma-system"},
+		"spec":       map[string]any{"replicas": int64(5)},
+	}, kept.Object)
+}
+
+func deployment(replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "manager", "namespace": "kyma-system"},
+			"spec":       map[string]any{"replicas": replicas},
+		},
+	}
+}
+
+func configMap(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+		},
+	}
+}
@@ -0,0 +1,127 @@
+// Package helm renders Helm chart layers in-process into a single multi-document YAML file, so that the
+// rendered resources pass through the same transforms and server-side apply as a raw manifest layer.
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"helm.sh/helm/v4/pkg/chart/common"
+	chartutil "helm.sh/helm/v4/pkg/chart/common/util"
+	"helm.sh/helm/v4/pkg/chart/v2/loader"
+	"helm.sh/helm/v4/pkg/engine"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
+)
+
+const (
+	renderedFilePrefix = "rendered"
+	notesFileSuffix    = "NOTES.txt"
+	testsDirectory     = "tests"
+	documentSeparator  = "---\n"
+)
+
+var ErrChartRendering = errors.New("failed to render helm chart")
+
+// Renderer renders Helm charts with the Helm template engine. The rendered output is stored in a dedicated entry of
+// the layer cache and reused as long as the chart, release and values do not change.
+type Renderer struct {
+	layerCache *layercache.Cache
+}
+
+func NewRenderer(layerCache *layercache.Cache) *Renderer {
+	return &Renderer{layerCache: layerCache}
+}
+
+// RenderChart renders the chart archive or directory at chartPath for a release with the given name and namespace
+// and returns the path of the rendered YAML. CRDs from the crds directory of the chart are placed first. Chart notes
+// and chart tests are not rendered, and as no cluster is consulted, the default capabilities of the Helm engine apply.
+// The rendered YAML stays in place until the returned func is called.
+func (r *Renderer) RenderChart(chartPath, releaseName, namespace string,
+	values map[string]any,
+) (string, func(), error) {
+	digest, err := renderDigest(chartPath, releaseName, namespace, values)
+	if err != nil {
+		return "", nil, err
+	}
+	renderedPath, release, err := r.layerCache.Fetch(fmt.Sprintf("%s-%s", renderedFilePrefix, digest),
+		fmt.Sprintf("%s-%s.yaml", renderedFilePrefix, releaseName),
+		func() (io.ReadCloser, error) {
+			rendered, err := render(chartPath, releaseName, namespace, values)
+			if err != nil {
+				return nil, err
+			}
+			return io.NopCloser(strings.NewReader(rendered)), nil
+		})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch rendered chart: %w", err)
+	}
+	return renderedPath, release, nil
+}
+
+func render(chartPath, releaseName, namespace string, values map[string]any) (string, error) {
+	chrt, err := loader.Load(chartPath)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to load chart %s: %w", ErrChartRendering, chartPath, err)
+	}
+
+	renderValues, err := chartutil.ToRenderValues(chrt, values, common.ReleaseOptions{
+		Name:      releaseName,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
+	}, common.DefaultCapabilities)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to compose values: %w", ErrChartRendering, err)
+	}
+
+	templates, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrChartRendering, err)
+	}
+
+	var rendered strings.Builder
+	for _, crd := range chrt.CRDObjects() {
+		rendered.WriteString(documentSeparator)
+		rendered.Write(crd.File.Data)
+		rendered.WriteString("\n")
+	}
+	// templates are written in a stable order to keep the rendered output reproducible
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		content := templates[name]
+		if isNotesOrTest(name) || strings.TrimSpace(content) == "" {
+			continue
+		}
+		rendered.WriteString(documentSeparator)
+		rendered.WriteString(content)
+		rendered.WriteString("\n")
+	}
+	return rendered.String(), nil
+}
+
+func isNotesOrTest(templateName string) bool {
+	return strings.HasSuffix(templateName, notesFileSuffix) ||
+		path.Base(path.Dir(templateName)) == testsDirectory
+}
+
+func renderDigest(chartPath, releaseName, namespace string, values map[string]any) (string, error) {
+	// maps are marshalled with sorted keys, so the digest is stable
+	content, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal values: %w", err)
+	}
+	sum := sha256.Sum256(slices.Concat([]byte(chartPath+"/"+releaseName+"/"+namespace+"/"), content))
+	return hex.EncodeToString(sum[:]), nil
+}
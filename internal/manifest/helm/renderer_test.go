@@ -0,0 +1,127 @@
+package helm_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/helm"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
+)
+
+const (
+	chartYAML = `apiVersion: v2
+name: sample
+version: 1.0.0
+`
+	valuesYAML = `replicas: 1
+`
+	deploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}-manager
+  namespace: {{ .Release.Namespace }}
+spec:
+  replicas: {{ .Values.replicas }}
+`
+	testTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ .Release.Name }}-test-connection
+`
+	crdYAML = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: samples.operator.kyma-project.io
+`
+)
+
+func TestRenderer_RenderChart(t *testing.T) {
+	chartPath := writeChart(t)
+
+	renderedPath, release, err := newRenderer(t).RenderChart(chartPath, "template-operator", "kyma-system",
+		map[string]any{"replicas": 3})
+	require.NoError(t, err)
+	defer release()
+
+	rendered, err := os.ReadFile(renderedPath)
+	require.NoError(t, err)
+	content := string(rendered)
+	assert.Contains(t, content, "name: template-operator-manager")
+	assert.Contains(t, content, "namespace: kyma-system")
+	assert.Contains(t, content, "replicas: 3")
+	assert.NotContains(t, content, "test-connection")
+	assert.NotContains(t, content, "Thank you")
+	assert.Less(t, strings.Index(content, "CustomResourceDefinition"), strings.Index(content, "Deployment"),
+		"CRDs must be rendered before templates")
+	assert.NotEqual(t, filepath.Dir(chartPath), filepath.Dir(renderedPath),
+		"the rendered chart must not be stored next to the chart")
+}
+
+func TestRenderer_RenderChart_ReusesRenderedChartForSameValues(t *testing.T) {
+	chartPath := writeChart(t)
+	renderer := newRenderer(t)
+
+	first, releaseFirst, err := renderer.RenderChart(chartPath, "template-operator", "kyma-system",
+		map[string]any{"replicas": 2})
+	require.NoError(t, err)
+	releaseFirst()
+	second, releaseSecond, err := renderer.RenderChart(chartPath, "template-operator", "kyma-system",
+		map[string]any{"replicas": 2})
+	require.NoError(t, err)
+	releaseSecond()
+	third, releaseThird, err := renderer.RenderChart(chartPath, "template-operator", "kyma-system",
+		map[string]any{"replicas": 4})
+	require.NoError(t, err)
+	releaseThird()
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, third)
+}
+
+func TestRenderer_RenderChart_ReturnsErrorForInvalidTemplate(t *testing.T) {
+	chartPath := writeChart(t)
+	require.NoError(t, os.WriteFile(filepath.Join(chartPath, "templates", "broken.yaml"),
+		[]byte("{{ .Values.replicas "), 0o600))
+
+	_, _, err := newRenderer(t).RenderChart(chartPath, "template-operator", "kyma-system", nil)
+
+	require.ErrorIs(t, err, helm.ErrChartRendering)
+}
+
+func writeChart(t *testing.T) string {
+	t.Helper()
+	chartPath := filepath.Join(t.TempDir(), "sample")
+	files := map[string]string{
+		"Chart.yaml":                           chartYAML,
+		"values.yaml":                          valuesYAML,
+		"templates/deployment.yaml":            deploymentTemplate,
+		"templates/NOTES.txt":                  "Thank you for installing {{ .Chart.Name }}.",
+		"templates/tests/test-connection.yaml": testTemplate,
+		"crds/samples.yaml":                    crdYAML,
+	}
+	for name, content := range files {
+		filePath := filepath.Join(chartPath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o750))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+	return chartPath
+}
+
+func newRenderer(t *testing.T) *helm.Renderer {
+	t.Helper()
+	layerCache, err := layercache.NewCache(t.TempDir(), 0, layerCacheMetricsStub{})
+	require.NoError(t, err)
+	return helm.NewRenderer(layerCache)
+}
+
+type layerCacheMetricsStub struct{}
+
+func (layerCacheMetricsStub) RecordHit()         {}
+func (layerCacheMetricsStub) RecordMiss()        {}
+func (layerCacheMetricsStub) RecordEviction()    {}
+func (layerCacheMetricsStub) RecordSize(_ int64) {}
@@ -0,0 +1,62 @@
+// Package imagedigest resolves the digests of the images of module versions in their registries.
+package imagedigest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type DigestReader interface {
+	Digest(ctx context.Context, ref string) (string, error)
+}
+
+// Resolver resolves the digests of image references and caches them per module version. Images are expected
+// not to change within a module version, so each image is resolved once, and later tag moves in the registry
+// do not change the images installed for that module version. The cache grows with the number of module
+// versions in use and is dropped on restart.
+type Resolver struct {
+	digestReader DigestReader
+
+	mu      sync.Mutex
+	digests map[cacheKey]string
+}
+
+type cacheKey struct {
+	moduleVersion  string
+	imageReference string
+}
+
+func NewResolver(digestReader DigestReader) *Resolver {
+	return &Resolver{
+		digestReader: digestReader,
+		digests:      map[cacheKey]string{},
+	}
+}
+
+// Resolve returns the digest of the image reference used by the given module version, for example
+// "sha256:c4bb6c...". The module version identifies the module and its version, for example
+// "kyma-project.io/module/template-operator:1.0.5".
+func (r *Resolver) Resolve(ctx context.Context, moduleVersion, imageReference string) (string, error) {
+	key := cacheKey{moduleVersion: moduleVersion, imageReference: imageReference}
+	r.mu.Lock()
+	digest, found := r.digests[key]
+	r.mu.Unlock()
+	if found {
+		return digest, nil
+	}
+
+	digest, err := r.digestReader.Digest(ctx, imageReference)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve digest of image %s: %w", imageReference, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// a concurrent resolution may have won the race, its digest is kept so that all callers see the same one
+	if cached, found := r.digests[key]; found {
+		return cached, nil
+	}
+	r.digests[key] = digest
+	return digest, nil
+}
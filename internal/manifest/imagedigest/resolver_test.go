@@ -0,0 +1,73 @@
+package imagedigest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/imagedigest"
+)
+
+const image = "europe-docker.pkg.dev/kyma-project/prod/template-operator:1.0.5"
+
+func TestResolve_ResolvesOncePerModuleVersion(t *testing.T) {
+	reader := &digestReaderStub{digests: []string{"sha256:1", "sha256:2"}}
+	resolver := imagedigest.NewResolver(reader)
+
+	first, err := resolver.Resolve(t.Context(), "template-operator:1.0.5", image)
+	require.NoError(t, err)
+	// the tag has moved in the registry, but the module version keeps its digest
+	second, err := resolver.Resolve(t.Context(), "template-operator:1.0.5", image)
+	require.NoError(t, err)
+
+	assert.Equal(t, "sha256:1", first)
+	assert.Equal(t, "sha256:1", second)
+	assert.Equal(t, 1, reader.calls)
+}
+
+func TestResolve_ResolvesAgainForOtherModuleVersion(t *testing.T) {
+	reader := &digestReaderStub{digests: []string{"sha256:1", "sha256:2"}}
+	resolver := imagedigest.NewResolver(reader)
+
+	_, err := resolver.Resolve(t.Context(), "template-operator:1.0.5", image)
+	require.NoError(t, err)
+	digest, err := resolver.Resolve(t.Context(), "template-operator:1.0.6", image)
+	require.NoError(t, err)
+
+	assert.Equal(t, "sha256:2", digest)
+	assert.Equal(t, 2, reader.calls)
+}
+
+func TestResolve_OnError_DoesNotCache(t *testing.T) {
+	reader := &digestReaderStub{err: errRegistry}
+	resolver := imagedigest.NewResolver(reader)
+
+	_, err := resolver.Resolve(t.Context(), "template-operator:1.0.5", image)
+	require.ErrorIs(t, err, errRegistry)
+
+	reader.err = nil
+	reader.digests = []string{"sha256:1"}
+	digest, err := resolver.Resolve(t.Context(), "template-operator:1.0.5", image)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1", digest)
+}
+
+var errRegistry = errors.New("registry unavailable")
+
+type digestReaderStub struct {
+	digests []string
+	err     error
+	calls   int
+}
+
+func (s *digestReaderStub) Digest(_ context.Context, _ string) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	digest := s.digests[s.calls]
+	s.calls++
+	return digest, nil
+}
@@ -21,22 +21,45 @@ import (
 	"github.com/kyma-project/lifecycle-manager/pkg/common"
 )
 
+const (
+	blobDigestNormalisation = "genericBlobDigest/v1"
+	blobDigestHashAlgorithm = "SHA-256"
+	blobDigestPrefix        = "sha256:"
+)
+
 var (
 	ErrAccessTypeNotSupported           = errors.New("access type not supported")
 	ErrComponentNameMappingNotSupported = errors.New("componentNameMapping not supported")
+	ErrLayerDigestMismatch              = errors.New("layer digest does not match the descriptor")
+	ErrLayerDigestMissing               = errors.New("layer has no digest it can be verified against")
 )
 
 func Parse(
 	descriptor *compdesc.ComponentDescriptor,
 ) (Layers, error) {
+	return parse(descriptor, false)
+}
+
+// ParseWithLayerDigests is like Parse, but fails for layers without a SHA-256 digest of their plain blob content,
+// so that every layer is bound to the signed descriptor. It is used when descriptor signatures are verified.
+func ParseWithLayerDigests(
+	descriptor *compdesc.ComponentDescriptor,
+) (Layers, error) {
+	return parse(descriptor, true)
+}
+
+func parse(descriptor *compdesc.ComponentDescriptor, requireLayerDigests bool) (Layers, error) {
 	ctx := descriptor.GetEffectiveRepositoryContext()
 	if ctx == nil {
 		return Layers{}, nil
 	}
-	return parseDescriptor(ctx, descriptor)
+	return parseDescriptor(ctx, descriptor, requireLayerDigests)
 }
 
-func parseDescriptor(ctx *runtime.UnstructuredTypedObject, descriptor *compdesc.ComponentDescriptor) (Layers, error) {
+func parseDescriptor(ctx *runtime.UnstructuredTypedObject,
+	descriptor *compdesc.ComponentDescriptor,
+	requireLayerDigests bool,
+) (Layers, error) {
 	repo, err := cpi.DefaultContext().RepositoryTypes().Convert(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while decoding the repository context into an OCI registry: %w", err)
@@ -45,7 +68,7 @@ func parseDescriptor(ctx *runtime.UnstructuredTypedObject, descriptor *compdesc.
 	if !ok {
 		return nil, common.ErrTypeAssert
 	}
-	layersByName, err := parseLayersByName(typedRepo, descriptor)
+	layersByName, err := parseLayersByName(typedRepo, descriptor, requireLayerDigests)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +76,10 @@ func parseDescriptor(ctx *runtime.UnstructuredTypedObject, descriptor *compdesc.
 	return layersByName, nil
 }
 
-func parseLayersByName(repo *genericocireg.RepositorySpec, descriptor *compdesc.ComponentDescriptor) (Layers, error) {
+func parseLayersByName(repo *genericocireg.RepositorySpec,
+	descriptor *compdesc.ComponentDescriptor,
+	requireLayerDigests bool,
+) (Layers, error) {
 	layers := Layers{}
 	for _, resource := range descriptor.Resources {
 		access := resource.Access
@@ -74,6 +100,9 @@ func parseLayersByName(repo *genericocireg.RepositorySpec, descriptor *compdesc.
 			if !ok {
 				return nil, common.ErrTypeAssert
 			}
+			if err := verifyLayerDigest(&resource, accessSpec, requireLayerDigests); err != nil {
+				return nil, err
+			}
 			ociRef, err = getOCIRef(repo, descriptor, accessSpec)
 			if err != nil {
 				return nil, fmt.Errorf("building the digest url: %w", err)
@@ -104,6 +133,27 @@ func parseLayersByName(repo *genericocireg.RepositorySpec, descriptor *compdesc.
 	return layers, nil
 }
 
+// verifyLayerDigest ensures that a local blob is pulled with the digest recorded for the resource. As access
+// specifications are not covered by descriptor signatures, but resource digests are, this check binds the pulled
+// layer to the signed content. Resources without a digest of the plain blob content can not be checked, which is
+// an error if layer digests are required.
+func verifyLayerDigest(resource *compdesc.Resource, accessSpec *localblob.AccessSpec, required bool) error {
+	digest := resource.Digest
+	if digest == nil || digest.NormalisationAlgorithm != blobDigestNormalisation ||
+		digest.HashAlgorithm != blobDigestHashAlgorithm {
+		if required {
+			return fmt.Errorf("%w: resource %s has no %s digest of normalisation %s", ErrLayerDigestMissing,
+				resource.Name, blobDigestHashAlgorithm, blobDigestNormalisation)
+		}
+		return nil
+	}
+	if accessSpec.LocalReference != blobDigestPrefix+digest.Value {
+		return fmt.Errorf("%w: resource %s references %q, but has digest %s%s", ErrLayerDigestMismatch,
+			resource.Name, accessSpec.LocalReference, blobDigestPrefix, digest.Value)
+	}
+	return nil
+}
+
 func getOCIRef(
 	repo *genericocireg.RepositorySpec,
 	descriptor *compdesc.ComponentDescriptor,
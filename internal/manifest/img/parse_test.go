@@ -1,6 +1,7 @@
 package img_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -73,3 +74,46 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_OnLayerDigestMismatch_ReturnsErr(t *testing.T) {
+	var moduleTemplateFromFile v1beta2.ModuleTemplate
+	builder.ReadComponentDescriptorFromFile("v1beta2_template_operator_new_ocm.yaml", &moduleTemplateFromFile)
+	// the signed digest of the raw-manifest resource no longer matches the blob it references
+	rawDescriptor := bytes.Replace(moduleTemplateFromFile.Spec.Descriptor.Raw,
+		[]byte(`"d2cc278224a71384b04963a83e784da311a268a2b3fa8732bc31e70ca0c5bc52`),
+		[]byte(`"0000278224a71384b04963a83e784da311a268a2b3fa8732bc31e70ca0c5bc52`), 1)
+	ocmId, err := ocmidentity.NewComponentId("kyma-project.io/module/template-operator", "1.0.0-new-ocm-format")
+	require.NoError(t, err)
+	descriptor, err := provider.NewCachedDescriptorProvider(
+		componentdescriptor.NewFakeService(rawDescriptor),
+		descriptorcache.NewDescriptorCache(),
+	).GetDescriptor(*ocmId)
+	require.NoError(t, err)
+
+	_, err = img.Parse(descriptor.ComponentDescriptor)
+
+	require.ErrorIs(t, err, img.ErrLayerDigestMismatch)
+}
+
+func TestParseWithLayerDigests_OnMissingLayerDigest_ReturnsErr(t *testing.T) {
+	var moduleTemplateFromFile v1beta2.ModuleTemplate
+	builder.ReadComponentDescriptorFromFile("v1beta2_template_operator_new_ocm.yaml", &moduleTemplateFromFile)
+	ocmId, err := ocmidentity.NewComponentId("kyma-project.io/module/template-operator", "1.0.0-new-ocm-format")
+	require.NoError(t, err)
+	descriptor, err := provider.NewCachedDescriptorProvider(
+		componentdescriptor.NewFakeService(moduleTemplateFromFile.Spec.Descriptor.Raw),
+		descriptorcache.NewDescriptorCache(),
+	).GetDescriptor(*ocmId)
+	require.NoError(t, err)
+	for i := range descriptor.Resources {
+		if descriptor.Resources[i].Name == string(v1beta2.RawManifestLayer) {
+			descriptor.Resources[i].Digest = nil
+		}
+	}
+
+	_, err = img.Parse(descriptor.ComponentDescriptor)
+	require.NoError(t, err)
+
+	_, err = img.ParseWithLayerDigests(descriptor.ComponentDescriptor)
+	require.ErrorIs(t, err, img.ErrLayerDigestMissing)
+}
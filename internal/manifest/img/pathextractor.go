@@ -6,10 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/fs"
-	"os"
-	"path"
-	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -19,165 +15,185 @@ import (
 	"ocm.software/ocm/api/ocm/extensions/repositories/genericocireg/componentmapping"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
-	"github.com/kyma-project/lifecycle-manager/internal/manifest/filemutex"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 )
 
 var (
 	ErrImageLayerPull       = errors.New("failed to pull layer")
 	ErrInvalidImageSpecType = fmt.Errorf("invalid image spec type provided,"+
 		" only '%s' '%s' are allowed", v1beta2.OciRefType, v1beta2.OciDirType)
-	ErrTaintedArchive          = errors.New("content filepath tainted")
 	ErrInvalidArchiveStructure = errors.New("tar archive has invalid structure, expected a single file")
+	ErrHelmChartNotArchived    = fmt.Errorf("helm chart layer must be a gzipped chart archive of type '%s'",
+		v1beta2.OciRefType)
 )
 
+// LayerReader reads layers from a source other than the remote registry referenced by the image spec. The keychain
+// of the Manifest is passed on to authenticate against the registry referenced by the image spec.
+type LayerReader interface {
+	PullLayerWithKeyChain(ctx context.Context, ref string, keyChain authn.Keychain) (containerregistryv1.Layer, error)
+}
+
 type PathExtractor struct {
-	fileMutexCache *filemutex.MutexCache
+	layerCache  *layercache.Cache
+	layerReader LayerReader
+}
+
+// NewPathExtractor creates a PathExtractor storing the pulled layers in the given layer cache.
+func NewPathExtractor(layerCache *layercache.Cache,
+	opts ...func(*PathExtractor) *PathExtractor,
+) *PathExtractor {
+	extractor := &PathExtractor{layerCache: layerCache}
+	for _, opt := range opts {
+		extractor = opt(extractor)
+	}
+	return extractor
 }
 
-func NewPathExtractor() *PathExtractor {
-	return &PathExtractor{fileMutexCache: filemutex.NewMutexCache(nil)}
+// WithLayerReader makes the PathExtractor read all layers with the given reader, for example from an OCI image
+// layout directory, instead of pulling them from the registry of the image spec.
+func WithLayerReader(layerReader LayerReader) func(*PathExtractor) *PathExtractor {
+	return func(extractor *PathExtractor) *PathExtractor {
+		extractor.layerReader = layerReader
+		return extractor
+	}
 }
 
+// GetPathFromRawManifest returns the path of the raw manifest layer. The path stays valid until the returned func
+// is called.
 func (p PathExtractor) GetPathFromRawManifest(
 	ctx context.Context,
 	imageSpec v1beta2.ImageSpec,
 	keyChain authn.Keychain,
-) (string, error) {
+) (string, func(), error) {
+	return p.getPathFromLayer(ctx, imageSpec, keyChain, v1beta2.RawManifestLayer)
+}
+
+// GetPathFromConfig returns the path of the config layer, which provides the default values for rendering
+// a Helm chart layer. The path stays valid until the returned func is called.
+func (p PathExtractor) GetPathFromConfig(
+	ctx context.Context,
+	imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+) (string, func(), error) {
+	return p.getPathFromLayer(ctx, imageSpec, keyChain, v1beta2.ConfigLayer)
+}
+
+// GetPathFromHelmChart returns the path of the gzipped chart archive of a Helm chart layer. The path stays valid
+// until the returned func is called.
+func (p PathExtractor) GetPathFromHelmChart(
+	ctx context.Context,
+	imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+) (string, func(), error) {
+	if imageSpec.Type != v1beta2.OciRefType {
+		return "", nil, ErrHelmChartNotArchived
+	}
+	// the chart archive is kept gzipped, as the uncompressed layer content is a plain tar the chart loader rejects
+	return p.fetchLayer(ctx, imageSpec, keyChain, string(v1beta2.HelmChartLayer)+".tgz",
+		containerregistryv1.Layer.Compressed, false)
+}
+
+func (p PathExtractor) getPathFromLayer(
+	ctx context.Context,
+	imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+	layerName v1beta2.LayerName,
+) (string, func(), error) {
 	switch imageSpec.Type {
 	case v1beta2.OciRefType:
-		return p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(v1beta2.RawManifestLayer)+".yaml")
+		return p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(layerName)+".yaml")
 	case v1beta2.OciDirType:
-		tarFile, err := p.GetPathForFetchedLayer(ctx, imageSpec, keyChain, string(v1beta2.RawManifestLayer)+".tar")
-		if err != nil {
-			return "", err
-		}
-		extractedFile, err := p.ExtractLayer(tarFile)
-		if err != nil {
-			return "", err
-		}
-		return extractedFile, nil
+		return p.fetchLayer(ctx, imageSpec, keyChain, string(layerName)+".yaml",
+			containerregistryv1.Layer.Uncompressed, true)
 	default:
-		return "", ErrInvalidImageSpecType
+		return "", nil, ErrInvalidImageSpecType
 	}
 }
 
+// GetPathForFetchedLayer returns the path of the uncompressed layer stored as filename. The path stays valid until
+// the returned func is called.
 func (p PathExtractor) GetPathForFetchedLayer(ctx context.Context,
 	imageSpec v1beta2.ImageSpec,
 	keyChain authn.Keychain,
 	filename string,
-) (string, error) {
+) (string, func(), error) {
+	return p.fetchLayer(ctx, imageSpec, keyChain, filename, containerregistryv1.Layer.Uncompressed, false)
+}
+
+// fetchLayer stores the layer content returned by readBlob as filename in the layer cache entry of the image. Layers
+// that must stay compressed, such as Helm chart archives, are read with containerregistryv1.Layer.Compressed. If
+// extract is set, the layer is a tar archive, and the single file it contains is stored instead. The entry is held
+// until the returned func is called, so that the file is neither evicted nor replaced while it is in use.
+func (p PathExtractor) fetchLayer(ctx context.Context,
+	imageSpec v1beta2.ImageSpec,
+	keyChain authn.Keychain,
+	filename string,
+	readBlob func(containerregistryv1.Layer) (io.ReadCloser, error),
+	extract bool,
+) (string, func(), error) {
 	imageRef := fmt.Sprintf("%s/%s/%s@%s", imageSpec.Repo, componentmapping.ComponentDescriptorNamespace,
 		imageSpec.Name, imageSpec.Ref,
 	)
 
-	installPath := getFsChartPath(imageSpec)
-	manifestPath := path.Join(installPath, filename)
-
-	fileMutex, err := p.fileMutexCache.GetLocker(installPath)
-	if err != nil {
-		return "", fmt.Errorf("failed to load locker from cache: %w", err)
-	}
-	fileMutex.Lock()
-	defer fileMutex.Unlock()
-
-	dir, err := os.Open(manifestPath)
-	if err != nil && !errors.Is(err, fs.ErrNotExist) {
-		return "", fmt.Errorf("opening dir for installs caused an error %s: %w", imageRef, err)
-	}
-	if dir != nil {
-		return manifestPath, nil
-	}
-
-	imgLayer, err := pullLayer(ctx, imageRef, keyChain)
-	if err != nil {
-		return "", err
-	}
-
-	// copy uncompressed manifest to install path
-	blobReadCloser, err := imgLayer.Uncompressed()
-	if err != nil {
-		return "", fmt.Errorf("failed fetching blob for layer %s: %w", imageRef, err)
-	}
-	defer blobReadCloser.Close()
-
-	// create dir for uncompressed manifest
-	if err := os.MkdirAll(installPath, fs.ModePerm); err != nil {
-		return "", fmt.Errorf(
-			"failure while creating installPath directory for layer %s: %w",
-			imageRef, err,
-		)
-	}
-	outFile, err := os.Create(manifestPath)
-	if err != nil {
-		return "", fmt.Errorf("file create failed for layer %s: %w", imageRef, err)
-	}
-	if _, err := io.Copy(outFile, blobReadCloser); err != nil {
-		return "", fmt.Errorf("file copy storage failed for layer %s: %w", imageRef, err)
-	}
-	err = io.Closer(outFile).Close()
+	layerPath, release, err := p.layerCache.Fetch(fmt.Sprintf("%s-%s", imageSpec.Name, imageSpec.Ref), filename,
+		func() (io.ReadCloser, error) {
+			imgLayer, err := p.pullLayer(ctx, imageRef, keyChain)
+			if err != nil {
+				return nil, err
+			}
+			blobReadCloser, err := readBlob(imgLayer)
+			if err != nil {
+				return nil, fmt.Errorf("failed fetching blob for layer %s: %w", imageRef, err)
+			}
+			if extract {
+				return singleFileOf(blobReadCloser)
+			}
+			return blobReadCloser, nil
+		})
 	if err != nil {
-		return manifestPath, fmt.Errorf("failed to close io: %w", err)
+		return "", nil, fmt.Errorf("failed to fetch layer %s: %w", imageRef, err)
 	}
-	return manifestPath, nil
+	return layerPath, release, nil
 }
 
-func (p PathExtractor) ExtractLayer(tarPath string) (string, error) {
-	fileMutex, err := p.fileMutexCache.GetLocker(tarPath)
-	if err != nil {
-		return "", fmt.Errorf("failed to load locker from cache: %w", err)
-	}
-	fileMutex.Lock()
-	defer fileMutex.Unlock()
-
-	tarFile, err := os.Open(tarPath)
-	if err != nil {
-		return "", fmt.Errorf("failed to open file: %w", err)
-	}
-	defer tarFile.Close()
-
-	tarReader := tar.NewReader(tarFile)
+// singleFileOf returns the content of the single file of the tar archive, which is closed along with it.
+func singleFileOf(tarReadCloser io.ReadCloser) (io.ReadCloser, error) {
+	tarReader := tar.NewReader(tarReadCloser)
 	for {
 		header, err := tarReader.Next()
 		if errors.Is(err, io.EOF) {
 			break
 		}
 		if err != nil {
-			return "", fmt.Errorf("failed to read tar: %w", err)
+			tarReadCloser.Close()
+			return nil, fmt.Errorf("failed to read tar: %w", err)
 		}
-
-		if header.Typeflag == tar.TypeReg {
-			// On macOS, tar files generated by default include a copyfile that starts with ._.
-			// This condition skips those files.
-			if strings.HasPrefix(header.Name, "._") {
-				continue
-			}
-			extractedFilePath, err := sanitizeArchivePath(filepath.Dir(tarPath), header.Name)
-			if err != nil {
-				return "", fmt.Errorf("failed to sanitize archive path: %w", err)
-			}
-
-			if _, err := os.Stat(extractedFilePath); err == nil {
-				return extractedFilePath, nil
-			}
-
-			outFile, err := os.Create(extractedFilePath)
-			if err != nil {
-				return "", fmt.Errorf("failed to create extracted file: %w", err)
-			}
-			defer outFile.Close()
-
-			if _, err := io.Copy(outFile, tarReader); err != nil { //nolint:gosec // The upstream content is
-				// from managed resources, and the size is controlled, so it is safe from decompression bomb attacks.
-				return "", fmt.Errorf("failed to extract from tar: %w", err)
-			}
-			return extractedFilePath, nil
+		// On macOS, tar files generated by default include a copyfile that starts with ._.
+		// This condition skips those files.
+		if header.Typeflag == tar.TypeReg && !strings.HasPrefix(header.Name, "._") {
+			return struct {
+				io.Reader
+				io.Closer
+			}{tarReader, tarReadCloser}, nil
 		}
 	}
-	return "", ErrInvalidArchiveStructure
+	tarReadCloser.Close()
+	return nil, ErrInvalidArchiveStructure
 }
 
-func pullLayer(ctx context.Context, imageRef string, keyChain authn.Keychain) (containerregistryv1.Layer, error) {
+func (p PathExtractor) pullLayer(ctx context.Context,
+	imageRef string,
+	keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
 	noSchemeImageRef := noSchemeURL(imageRef)
+	if p.layerReader != nil {
+		imgLayer, err := p.layerReader.PullLayerWithKeyChain(ctx, noSchemeImageRef, keyChain)
+		if err != nil {
+			return nil, fmt.Errorf("%s due to: %w", ErrImageLayerPull.Error(), err)
+		}
+		return imgLayer, nil
+	}
+
 	isInsecureLayer, err := regexp.MatchString("^http://", imageRef)
 	if err != nil {
 		return nil, fmt.Errorf("invalid imageRef: %w", err)
@@ -198,20 +214,6 @@ func pullLayer(ctx context.Context, imageRef string, keyChain authn.Keychain) (c
 	return imgLayer, nil
 }
 
-func getFsChartPath(imageSpec v1beta2.ImageSpec) string {
-	return filepath.Join(os.TempDir(), fmt.Sprintf("%s-%s", imageSpec.Name, imageSpec.Ref))
-}
-
-// sanitizeArchivePath ensures the path is within the intended directory to prevent path traversal attacks (gosec:G305).
-func sanitizeArchivePath(dir, path string) (string, error) {
-	joinedPath := filepath.Join(dir, path)
-	if strings.HasPrefix(joinedPath, filepath.Clean(dir)) {
-		return joinedPath, nil
-	}
-
-	return "", fmt.Errorf("%w: %s", ErrTaintedArchive, path)
-}
-
 func noSchemeURL(url string) string {
 	regex := regexp.MustCompile(`^https?://`)
 	return regex.ReplaceAllString(url, "")
@@ -3,68 +3,24 @@ package img_test
 import (
 	"archive/tar"
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
-	"sync"
 	"testing"
-	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
 )
 
-func TestPathExtractor_ExtractLayer(t *testing.T) {
-	content, tarFilePath := generateDummyTarFile(t)
-	pathExtractor := img.NewPathExtractor()
-	numGoroutines := 5
-	resultCh := make(chan string, numGoroutines)
-	modTimeCh := make(chan time.Time, numGoroutines)
-	errCh := make(chan error)
-	var waitGroup sync.WaitGroup
-	for range numGoroutines {
-		waitGroup.Add(1)
-		go extractLayer(&waitGroup, pathExtractor, tarFilePath, errCh, resultCh, modTimeCh)
-	}
-	go func() {
-		waitGroup.Wait()
-		close(resultCh)
-		close(modTimeCh)
-		close(errCh)
-	}()
-	results := make([]string, 0)
-	modTimes := make([]time.Time, 0)
-
-	for err := range errCh {
-		require.NoError(t, err)
-	}
-	for result := range resultCh {
-		results = append(results, result)
-	}
-	for modTime := range modTimeCh {
-		modTimes = append(modTimes, modTime)
-	}
-
-	assert.Len(t, results, numGoroutines)
-	assert.Len(t, modTimes, numGoroutines)
-
-	extractedPath := results[0]
-	info, err := os.Stat(extractedPath)
-	require.NoError(t, err)
-	for i := range numGoroutines {
-		assert.Equal(t, extractedPath, results[i])
-		assert.Equal(t, info.ModTime(), modTimes[i])
-	}
-
-	fileContent, err := os.ReadFile(extractedPath)
-	require.NoError(t, err)
-	assert.Equal(t, content, fileContent)
-}
-
 func TestPathExtractor_FetchLayerToFile(t *testing.T) {
 	const commonRepo = "europe-west3-docker.pkg.dev/sap-kyma-jellyfish-dev/template-operator/component-descriptors"
 
@@ -75,7 +31,7 @@ func TestPathExtractor_FetchLayerToFile(t *testing.T) {
 	}{
 		{
 			"should fetch raw-manifest layer with oci-dir type",
-			"template-operator.yaml",
+			"raw-manifest.yaml",
 			img.Layer{
 				LayerName: "raw-manifest",
 				LayerRepresentation: &img.OCI{
@@ -104,17 +60,102 @@ func TestPathExtractor_FetchLayerToFile(t *testing.T) {
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
-			p := img.NewPathExtractor()
+			p := img.NewPathExtractor(newLayerCache(t))
 			imageSpec, err := testCase.want.ConvertToImageSpec(commonRepo)
 			require.NoError(t, err)
-			extractedFilePath, err := p.GetPathFromRawManifest(t.Context(), *imageSpec, authn.DefaultKeychain)
+			extractedFilePath, release, err := p.GetPathFromRawManifest(t.Context(), *imageSpec, authn.DefaultKeychain)
 			require.NoError(t, err)
+			defer release()
 			assert.Contains(t, extractedFilePath,
 				fmt.Sprintf("%s/%s", imageSpec.Ref, testCase.fileName))
 		})
 	}
 }
 
+func TestPathExtractor_WithLayerReader_ReadsLayerFromReader(t *testing.T) {
+	layerReader := &layerReaderStub{layer: static.NewLayer([]byte("kind: ConfigMap"), types.OCILayer)}
+	pathExtractor := img.NewPathExtractor(newLayerCache(t), img.WithLayerReader(layerReader))
+	layer := img.Layer{
+		LayerName: "raw-manifest",
+		LayerRepresentation: &img.OCI{
+			Name: testutils.DefaultComponentName,
+			Ref:  "sha256:1ea2baf45791beafabfee533031b715af8f7a4ffdfbbf30d318f52f7652c36ca",
+			Type: "oci-ref",
+		},
+	}
+	imageSpec, err := layer.ConvertToImageSpec("http://k3d-kcp-registry.localhost:5000")
+	require.NoError(t, err)
+
+	manifestPath, release, err := pathExtractor.GetPathFromRawManifest(t.Context(), *imageSpec,
+		authn.DefaultKeychain)
+
+	require.NoError(t, err)
+	defer release()
+	content, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap", string(content))
+	assert.NotContains(t, layerReader.ref, "http://")
+	assert.Contains(t, layerReader.ref, "@"+imageSpec.Ref)
+	assert.Equal(t, authn.DefaultKeychain, layerReader.keyChain)
+}
+
+func TestPathExtractor_WithLayerReader_ExtractsSingleFileOfOciDirLayer(t *testing.T) {
+	content, tarFilePath := generateDummyTarFile(t)
+	tarContent, err := os.ReadFile(tarFilePath)
+	require.NoError(t, err)
+	layerReader := &layerReaderStub{layer: static.NewLayer(tarContent, types.OCILayer)}
+	pathExtractor := img.NewPathExtractor(newLayerCache(t), img.WithLayerReader(layerReader))
+	layer := img.Layer{
+		LayerName: "raw-manifest",
+		LayerRepresentation: &img.OCI{
+			Name: testutils.DefaultComponentName,
+			Ref:  "sha256:d2cc278224a71384b04963a83e784da311a268a2b3fa8732bc31e70ca0c5bc52",
+			Type: "oci-dir",
+		},
+	}
+	imageSpec, err := layer.ConvertToImageSpec("http://k3d-kcp-registry.localhost:5000")
+	require.NoError(t, err)
+
+	manifestPath, release, err := pathExtractor.GetPathFromRawManifest(t.Context(), *imageSpec,
+		authn.DefaultKeychain)
+
+	require.NoError(t, err)
+	defer release()
+	extracted, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, content, extracted)
+	assert.Equal(t, "raw-manifest.yaml", filepath.Base(manifestPath))
+}
+
+type layerReaderStub struct {
+	layer    containerregistryv1.Layer
+	ref      string
+	keyChain authn.Keychain
+}
+
+func (l *layerReaderStub) PullLayerWithKeyChain(_ context.Context,
+	ref string,
+	keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
+	l.ref = ref
+	l.keyChain = keyChain
+	return l.layer, nil
+}
+
+func newLayerCache(t *testing.T) *layercache.Cache {
+	t.Helper()
+	layerCache, err := layercache.NewCache(t.TempDir(), 0, &layerCacheMetricsStub{})
+	require.NoError(t, err)
+	return layerCache
+}
+
+type layerCacheMetricsStub struct{}
+
+func (*layerCacheMetricsStub) RecordHit()       {}
+func (*layerCacheMetricsStub) RecordMiss()      {}
+func (*layerCacheMetricsStub) RecordEviction()  {}
+func (*layerCacheMetricsStub) RecordSize(int64) {}
+
 func generateDummyTarFile(t *testing.T) ([]byte, string) {
 	t.Helper()
 	var buf bytes.Buffer
@@ -140,23 +181,3 @@ func generateDummyTarFile(t *testing.T) ([]byte, string) {
 	require.NoError(t, err)
 	return content, tarFilePath
 }
-
-func extractLayer(wg *sync.WaitGroup, extractor *img.PathExtractor, tarFilePath string, errCh chan error,
-	resultCh chan string, modTimeCh chan time.Time,
-) {
-	defer wg.Done()
-	result, err := extractor.ExtractLayer(tarFilePath)
-	if err != nil {
-		errCh <- err
-		return
-	}
-
-	info, err := os.Stat(result)
-	if err != nil {
-		errCh <- err
-		return
-	}
-
-	resultCh <- result
-	modTimeCh <- info.ModTime()
-}
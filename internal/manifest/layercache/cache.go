@@ -0,0 +1,443 @@
+// Package layercache manages the directories of manifest layers pulled from the OCI registry on the local disk.
+// The total size of the cache is bounded; when it is exceeded, the least recently used directories are evicted.
+package layercache
+
+import (
+	"cmp"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+const temporaryFilePattern = ".tmp-*"
+
+var ErrInvalidKey = errors.New("invalid layer cache key")
+
+type Metrics interface {
+	RecordHit()
+	RecordMiss()
+	RecordEviction()
+	RecordSize(bytes int64)
+}
+
+// Cache stores the layers of each OCI ref in a dedicated directory below its root directory. A directory is
+// referenced while it is acquired and is only evicted when it is no longer referenced. The files stored through the
+// cache are checked against their SHA-256 digest whenever they are reused. An entry is acquired exclusively to store
+// files, and shared while its files are read, so that they are neither changed nor removed while in use.
+type Cache struct {
+	directory string
+	maxSize   int64
+	metrics   Metrics
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	// lru orders the entries from the most recently to the least recently used one
+	lru  *list.List
+	size int64
+}
+
+type entry struct {
+	key     string
+	lock    *entryLock
+	refs    int
+	size    int64
+	element *list.Element
+	// digests holds the SHA-256 digests of the files stored through the cache, by file name
+	digests map[string]string
+}
+
+// NewCache creates a cache in the given directory, bounded to maxSize bytes. A maxSize of zero or less disables
+// the eviction. Directories left over in the directory, for example from a previous process, are adopted as
+// least recently used entries, so that they are evicted first.
+func NewCache(directory string, maxSize int64, metrics Metrics) (*Cache, error) {
+	if err := os.MkdirAll(directory, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create layer cache directory %s: %w", directory, err)
+	}
+	cache := &Cache{
+		directory: directory,
+		maxSize:   maxSize,
+		metrics:   metrics,
+		entries:   map[string]*entry{},
+		lru:       list.New(),
+	}
+	if err := cache.adoptExistingEntries(); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// Acquire returns the entry for the given key and locks it exclusively until it is released.
+func (c *Cache) Acquire(key string) (*Entry, error) {
+	dirName, err := entryDirName(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cached, found := c.entries[dirName]
+	if !found {
+		cached = newEntry(dirName, 0)
+		cached.element = c.lru.PushFront(cached)
+		c.entries[dirName] = cached
+	} else {
+		c.lru.MoveToFront(cached.element)
+	}
+	cached.refs++
+	c.mu.Unlock()
+
+	cached.lock.lock()
+	acquired := &Entry{cache: c, entry: cached}
+	if err := os.MkdirAll(acquired.Directory(), 0o750); err != nil {
+		acquired.Release()
+		return nil, fmt.Errorf("failed to create layer cache entry %s: %w", dirName, err)
+	}
+	return acquired, nil
+}
+
+// Fetch returns the path of the file in the entry for the given key, which is stored with the content returned by
+// fetch if it is not cached yet. The entry is held shared until the returned func is called, so that the file stays
+// in place while it is read. The entry must be released before it is fetched again by the same caller, as a waiting
+// writer would otherwise block the caller on itself.
+func (c *Cache) Fetch(key, filename string, fetch func() (io.ReadCloser, error)) (string, func(), error) {
+	shared, err := c.acquireShared(key)
+	if err != nil {
+		return "", nil, err
+	}
+	filePath, found, err := shared.Lookup(filename)
+	if err == nil && found {
+		return filePath, shared.Release, nil
+	}
+	shared.Release()
+	if err != nil {
+		return "", nil, err
+	}
+
+	acquired, err := c.Acquire(key)
+	if err != nil {
+		return "", nil, err
+	}
+	if filePath, err = acquired.fetch(filename, fetch); err != nil {
+		acquired.Release()
+		return "", nil, err
+	}
+	acquired.share()
+	return filePath, acquired.Release, nil
+}
+
+// acquireShared returns the existing entry for the given key and locks it shared until it is released. An entry
+// that does not exist yet is acquired exclusively instead, as there is nothing to read from it.
+func (c *Cache) acquireShared(key string) (*Entry, error) {
+	dirName, err := entryDirName(key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cached, found := c.entries[dirName]
+	if !found {
+		c.mu.Unlock()
+		return c.Acquire(key)
+	}
+	c.lru.MoveToFront(cached.element)
+	cached.refs++
+	c.mu.Unlock()
+
+	cached.lock.rlock()
+	return &Entry{cache: c, entry: cached, shared: true}, nil
+}
+
+func (c *Cache) release(released *entry, shared bool) {
+	size, sizeErr := directorySize(filepath.Join(c.directory, released.key))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if sizeErr == nil {
+		c.size += size - released.size
+		released.size = size
+	}
+	released.refs--
+	if shared {
+		released.lock.runlock()
+	} else {
+		released.lock.unlock()
+	}
+	c.evict()
+	c.metrics.RecordSize(c.size)
+}
+
+// evict removes the least recently used entries that are not referenced until the cache fits its size budget.
+// It must be called with the cache lock held.
+func (c *Cache) evict() {
+	if c.maxSize <= 0 {
+		return
+	}
+	for element := c.lru.Back(); element != nil && c.size > c.maxSize; {
+		candidate, _ := element.Value.(*entry)
+		previous := element.Prev()
+		// the most recently used entry is kept, so that it is not fetched again right away
+		if candidate.refs == 0 && element != c.lru.Front() {
+			if err := os.RemoveAll(filepath.Join(c.directory, candidate.key)); err == nil {
+				c.lru.Remove(element)
+				delete(c.entries, candidate.key)
+				c.size -= candidate.size
+				c.metrics.RecordEviction()
+			}
+		}
+		element = previous
+	}
+}
+
+func (c *Cache) adoptExistingEntries() error {
+	dirEntries, err := os.ReadDir(c.directory)
+	if err != nil {
+		return fmt.Errorf("failed to read layer cache directory %s: %w", c.directory, err)
+	}
+	type existingEntry struct {
+		name    string
+		modTime int64
+	}
+	existing := make([]existingEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		existing = append(existing, existingEntry{name: dirEntry.Name(), modTime: info.ModTime().UnixNano()})
+	}
+	// the newest entries are adopted first, so that the oldest ones end up at the back of the list
+	slices.SortFunc(existing, func(a, b existingEntry) int { return cmp.Compare(b.modTime, a.modTime) })
+	for _, existingDir := range existing {
+		size, err := directorySize(filepath.Join(c.directory, existingDir.name))
+		if err != nil {
+			return err
+		}
+		adopted := newEntry(existingDir.name, size)
+		adopted.element = c.lru.PushBack(adopted)
+		c.entries[existingDir.name] = adopted
+		c.size += size
+	}
+	c.metrics.RecordSize(c.size)
+	return nil
+}
+
+// Entry is an acquired directory of the cache. It must be released after use.
+type Entry struct {
+	cache  *Cache
+	entry  *entry
+	shared bool
+}
+
+func (e *Entry) Directory() string {
+	return filepath.Join(e.cache.directory, e.entry.key)
+}
+
+// Lookup returns the path of a file previously stored in the entry. A file that no longer matches its digest is
+// treated as not found, and all files of the entry are removed, as they may be derived from the corrupted file.
+// A shared entry is not changed, and only its hits are recorded, as a miss is looked up again exclusively.
+func (e *Entry) Lookup(filename string) (string, bool, error) {
+	filePath := filepath.Join(e.Directory(), filename)
+	expectedDigest, found := e.entry.digests[filename]
+	if !found {
+		if !e.shared {
+			e.cache.metrics.RecordMiss()
+		}
+		return filePath, false, nil
+	}
+
+	digest, err := fileDigest(filePath)
+	if err == nil && digest == expectedDigest {
+		e.cache.metrics.RecordHit()
+		return filePath, true, nil
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", false, err
+	}
+	if e.shared {
+		return filePath, false, nil
+	}
+
+	e.cache.metrics.RecordMiss()
+	if err := e.clear(); err != nil {
+		return "", false, err
+	}
+	return filePath, false, nil
+}
+
+// Store writes the content to a file of the entry and records its digest. The file is written to a temporary file
+// first, so that a failed write never leaves a partial file behind.
+func (e *Entry) Store(filename string, content io.Reader) (string, error) {
+	filePath := filepath.Join(e.Directory(), filename)
+	tmpFile, err := os.CreateTemp(e.Directory(), temporaryFilePattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create file in layer cache: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	hash := sha256.New()
+	_, copyErr := io.Copy(io.MultiWriter(tmpFile, hash), content)
+	closeErr := tmpFile.Close()
+	if err := errors.Join(copyErr, closeErr); err != nil {
+		return "", fmt.Errorf("failed to write %s to layer cache: %w", filename, err)
+	}
+	if err := os.Rename(tmpFile.Name(), filePath); err != nil {
+		return "", fmt.Errorf("failed to move %s into layer cache: %w", filename, err)
+	}
+	e.entry.digests[filename] = hex.EncodeToString(hash.Sum(nil))
+	return filePath, nil
+}
+
+// Release unlocks the entry and evicts least recently used entries if the cache exceeds its size budget.
+func (e *Entry) Release() {
+	e.cache.release(e.entry, e.shared)
+}
+
+// fetch stores the content returned by fetch in the file unless it is cached already, and returns its path.
+func (e *Entry) fetch(filename string, fetch func() (io.ReadCloser, error)) (string, error) {
+	filePath, found, err := e.Lookup(filename)
+	if err != nil || found {
+		return filePath, err
+	}
+	content, err := fetch()
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+	return e.Store(filename, content)
+}
+
+// share downgrades the exclusive lock of the entry to a shared one.
+func (e *Entry) share() {
+	e.entry.lock.downgrade()
+	e.shared = true
+}
+
+func (e *Entry) clear() error {
+	clear(e.entry.digests)
+	if err := os.RemoveAll(e.Directory()); err != nil {
+		return fmt.Errorf("failed to clear layer cache entry %s: %w", e.entry.key, err)
+	}
+	if err := os.MkdirAll(e.Directory(), 0o750); err != nil {
+		return fmt.Errorf("failed to create layer cache entry %s: %w", e.entry.key, err)
+	}
+	return nil
+}
+
+func newEntry(key string, size int64) *entry {
+	return &entry{key: key, lock: newEntryLock(), size: size, digests: map[string]string{}}
+}
+
+// entryLock is a readers-writer lock whose exclusive hold can be downgraded to a shared one without letting another
+// writer in. Waiting writers take precedence over new readers, so that an entry that is read all the time can still
+// be written.
+type entryLock struct {
+	mu             sync.Mutex
+	changed        *sync.Cond
+	writer         bool
+	readers        int
+	waitingWriters int
+}
+
+func newEntryLock() *entryLock {
+	lock := &entryLock{}
+	lock.changed = sync.NewCond(&lock.mu)
+	return lock
+}
+
+func (l *entryLock) lock() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waitingWriters++
+	for l.writer || l.readers > 0 {
+		l.changed.Wait()
+	}
+	l.waitingWriters--
+	l.writer = true
+}
+
+func (l *entryLock) unlock() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.writer = false
+	l.changed.Broadcast()
+}
+
+func (l *entryLock) rlock() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.writer || l.waitingWriters > 0 {
+		l.changed.Wait()
+	}
+	l.readers++
+}
+
+func (l *entryLock) runlock() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.readers--
+	l.changed.Broadcast()
+}
+
+func (l *entryLock) downgrade() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.writer = false
+	l.readers++
+	l.changed.Broadcast()
+}
+
+// entryDirName maps a key, which can contain path separators, for example from component names, to the name of a
+// single directory.
+func entryDirName(key string) (string, error) {
+	dirName := strings.ReplaceAll(key, string(filepath.Separator), "_")
+	if dirName == "" || dirName == "." || dirName == ".." {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return dirName, nil
+}
+
+func directorySize(directory string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(directory, func(_ string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEntry.IsDir() {
+			return nil
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to determine size of %s: %w", directory, err)
+	}
+	return size, nil
+}
+
+func fileDigest(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open cached file: %w", err)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to read cached file: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
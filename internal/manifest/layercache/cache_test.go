@@ -0,0 +1,229 @@
+package layercache_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
+)
+
+const layerFile = "raw-manifest.yaml"
+
+func TestCache_StoreAndLookup(t *testing.T) {
+	metrics := &metricsStub{}
+	cache, err := layercache.NewCache(t.TempDir(), 0, metrics)
+	require.NoError(t, err)
+
+	storedPath := storeLayer(t, cache, "template-operator-sha256:1", "kind: ConfigMap")
+
+	entry, err := cache.Acquire("template-operator-sha256:1")
+	require.NoError(t, err)
+	defer entry.Release()
+	path, found, err := entry.Lookup(layerFile)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, storedPath, path)
+	assert.Equal(t, 1, metrics.hits)
+	assert.Equal(t, 1, metrics.misses)
+}
+
+func TestCache_Lookup_OnCorruptedFile_ClearsEntry(t *testing.T) {
+	metrics := &metricsStub{}
+	cache, err := layercache.NewCache(t.TempDir(), 0, metrics)
+	require.NoError(t, err)
+	storedPath := storeLayer(t, cache, "template-operator-sha256:1", "kind: ConfigMap")
+	extractedPath := filepath.Join(filepath.Dir(storedPath), "extracted.yaml")
+	require.NoError(t, os.WriteFile(extractedPath, []byte("kind: ConfigMap"), 0o600))
+	require.NoError(t, os.WriteFile(storedPath, []byte("kind: Secret"), 0o600))
+
+	entry, err := cache.Acquire("template-operator-sha256:1")
+	require.NoError(t, err)
+	defer entry.Release()
+	_, found, err := entry.Lookup(layerFile)
+
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.NoFileExists(t, storedPath)
+	assert.NoFileExists(t, extractedPath)
+	assert.Equal(t, 2, metrics.misses)
+}
+
+func TestCache_Release_EvictsLeastRecentlyUsedEntries(t *testing.T) {
+	metrics := &metricsStub{}
+	content := strings.Repeat("x", 100)
+	cache, err := layercache.NewCache(t.TempDir(), 250, metrics)
+	require.NoError(t, err)
+
+	first := storeLayer(t, cache, "module-sha256:1", content)
+	second := storeLayer(t, cache, "module-sha256:2", content)
+	// reusing the first entry makes the second one the least recently used entry
+	entry, err := cache.Acquire("module-sha256:1")
+	require.NoError(t, err)
+	entry.Release()
+	third := storeLayer(t, cache, "module-sha256:3", content)
+
+	assert.FileExists(t, first)
+	assert.NoFileExists(t, second)
+	assert.FileExists(t, third)
+	assert.Equal(t, 1, metrics.evictions)
+	assert.Equal(t, int64(200), metrics.size)
+}
+
+func TestCache_Release_DoesNotEvictAcquiredEntries(t *testing.T) {
+	metrics := &metricsStub{}
+	content := strings.Repeat("x", 100)
+	cache, err := layercache.NewCache(t.TempDir(), 150, metrics)
+	require.NoError(t, err)
+
+	first := storeLayer(t, cache, "module-sha256:1", content)
+	inUse, err := cache.Acquire("module-sha256:1")
+	require.NoError(t, err)
+	second := storeLayer(t, cache, "module-sha256:2", content)
+
+	assert.FileExists(t, first)
+	assert.FileExists(t, second)
+	assert.Equal(t, 0, metrics.evictions)
+
+	inUse.Release()
+	assert.NoFileExists(t, first)
+	assert.FileExists(t, second)
+	assert.Equal(t, 1, metrics.evictions)
+}
+
+func TestCache_Fetch_HoldsEntryUntilReleased(t *testing.T) {
+	metrics := &metricsStub{}
+	content := strings.Repeat("x", 100)
+	cache, err := layercache.NewCache(t.TempDir(), 150, metrics)
+	require.NoError(t, err)
+	fetches := 0
+	fetch := func() (io.ReadCloser, error) {
+		fetches++
+		return io.NopCloser(strings.NewReader(content)), nil
+	}
+
+	first, releaseFirst, err := cache.Fetch("module-sha256:1", layerFile, fetch)
+	require.NoError(t, err)
+	reused, releaseReused, err := cache.Fetch("module-sha256:1", layerFile, fetch)
+	require.NoError(t, err)
+	releaseReused()
+	second, releaseSecond, err := cache.Fetch("module-sha256:2", layerFile, fetch)
+	require.NoError(t, err)
+	releaseSecond()
+
+	assert.Equal(t, first, reused)
+	assert.Equal(t, 2, fetches)
+	assert.FileExists(t, first)
+	assert.FileExists(t, second)
+	assert.Equal(t, 0, metrics.evictions)
+
+	releaseFirst()
+	assert.NoFileExists(t, first)
+	assert.Equal(t, 1, metrics.evictions)
+}
+
+func TestCache_Fetch_BlocksExclusiveAcquireUntilReleased(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 0, &metricsStub{})
+	require.NoError(t, err)
+	_, release, err := cache.Fetch("module-sha256:1", layerFile, func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("kind: ConfigMap")), nil
+	})
+	require.NoError(t, err)
+
+	acquired := make(chan struct{})
+	go func() {
+		entry, err := cache.Acquire("module-sha256:1")
+		assert.NoError(t, err)
+		entry.Release()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("entry was acquired exclusively while it was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	<-acquired
+}
+
+func TestNewCache_AdoptsExistingEntries(t *testing.T) {
+	directory := t.TempDir()
+	leftOver := filepath.Join(directory, "module-sha256:0", layerFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(leftOver), 0o750))
+	require.NoError(t, os.WriteFile(leftOver, []byte(strings.Repeat("x", 100)), 0o600))
+	metrics := &metricsStub{}
+
+	cache, err := layercache.NewCache(directory, 150, metrics)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), metrics.size)
+
+	storeLayer(t, cache, "module-sha256:1", strings.Repeat("x", 100))
+
+	assert.NoFileExists(t, leftOver)
+	assert.Equal(t, 1, metrics.evictions)
+}
+
+func TestCache_Acquire_MapsKeyToSingleDirectory(t *testing.T) {
+	directory := t.TempDir()
+	cache, err := layercache.NewCache(directory, 0, &metricsStub{})
+	require.NoError(t, err)
+
+	entry, err := cache.Acquire("kyma-project.io/module/template-operator-sha256:1")
+	require.NoError(t, err)
+	defer entry.Release()
+
+	assert.Equal(t, directory, filepath.Dir(entry.Directory()))
+	assert.DirExists(t, entry.Directory())
+}
+
+func TestCache_Acquire_OnInvalidKey_ReturnsErr(t *testing.T) {
+	cache, err := layercache.NewCache(t.TempDir(), 0, &metricsStub{})
+	require.NoError(t, err)
+
+	_, err = cache.Acquire("..")
+
+	require.ErrorIs(t, err, layercache.ErrInvalidKey)
+}
+
+func storeLayer(t *testing.T, cache *layercache.Cache, key, content string) string {
+	t.Helper()
+	entry, err := cache.Acquire(key)
+	require.NoError(t, err)
+	defer entry.Release()
+	_, found, err := entry.Lookup(layerFile)
+	require.NoError(t, err)
+	require.False(t, found)
+	path, err := entry.Store(layerFile, strings.NewReader(content))
+	require.NoError(t, err)
+	return path
+}
+
+type metricsStub struct {
+	hits      int
+	misses    int
+	evictions int
+	size      int64
+}
+
+func (m *metricsStub) RecordHit() {
+	m.hits++
+}
+
+func (m *metricsStub) RecordMiss() {
+	m.misses++
+}
+
+func (m *metricsStub) RecordEviction() {
+	m.evictions++
+}
+
+func (m *metricsStub) RecordSize(bytes int64) {
+	m.size = bytes
+}
@@ -3,6 +3,7 @@ package manifestclient
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -59,7 +60,8 @@ func (m *ManifestClient) SsaSpec(ctx context.Context, obj client.Object) error {
 }
 
 func HasStatusDiff(first, second shared.Status) bool {
-	return first.State != second.State || first.LastOperation.Operation != second.LastOperation.Operation
+	return first.State != second.State || first.LastOperation.Operation != second.LastOperation.Operation ||
+		!slices.Equal(first.Health, second.Health)
 }
 
 func resetNonPatchableField(obj client.Object) {
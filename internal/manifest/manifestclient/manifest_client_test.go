@@ -61,6 +61,24 @@ func Test_hasStatusDiff(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "Different Resource Health",
+			args: args{
+				first: shared.Status{
+					State: shared.StateProcessing,
+					Health: []shared.ResourceHealth{
+						{Resource: shared.Resource{Name: "agent"}, State: shared.StateProcessing},
+					},
+				},
+				second: shared.Status{
+					State: shared.StateProcessing,
+					Health: []shared.ResourceHealth{
+						{Resource: shared.Resource{Name: "agent"}, State: shared.StateReady},
+					},
+				},
+			},
+			want: true,
+		},
 		{
 			name: "Empty Status",
 			args: args{
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -21,8 +23,14 @@ import (
 var (
 	ErrNoResourceDefined           = errors.New("no resource defined in the manifest")
 	ErrWaitingForModuleCRsDeletion = errors.New("waiting for module CRs deletion")
+	// ErrWaitingForAssociatedResourcesDeletion is wrapped into an error listing the blocking resources.
+	ErrWaitingForAssociatedResourcesDeletion = errors.New("waiting for associated resources deletion")
 )
 
+// maxReportedBlockingResources limits the number of blocking resources listed in the deletion error,
+// so that the error stays readable when used as status message.
+const maxReportedBlockingResources = 10
+
 type Client struct {
 	client.Client
 }
@@ -95,6 +103,68 @@ func (c *Client) CheckModuleCRsDeletion(ctx context.Context, manifestCR *v1beta2
 	return ErrWaitingForModuleCRsDeletion
 }
 
+// CheckAssociatedResourcesDeletion checks if instances of the AssociatedResources declared in the Manifest still
+// exist in any namespace. Resources synced from the module's manifest layer and the default module CR are not
+// considered, as they are removed by Lifecycle Manager itself. If blocking resources exist, an error wrapping
+// ErrWaitingForAssociatedResourcesDeletion and listing the blocking resources is returned.
+func (c *Client) CheckAssociatedResourcesDeletion(ctx context.Context, manifestCR *v1beta2.Manifest) error {
+	if len(manifestCR.Spec.AssociatedResources) == 0 {
+		return nil
+	}
+
+	synced := make(map[string]struct{}, len(manifestCR.Status.Synced))
+	for _, resource := range manifestCR.Status.Synced {
+		synced[groupKindObjectKey(resource.Group, resource.Kind, resource.Namespace, resource.Name)] = struct{}{}
+	}
+
+	var blocking []string
+	for _, gvk := range manifestCR.Spec.AssociatedResources {
+		groupKind := schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}
+		resources, err := c.listResourcesByGroupKindInAllNamespaces(ctx, groupKind)
+		if util.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to list associated resources of kind %s: %w", groupKind, err)
+		}
+
+		for _, resource := range resources {
+			if manifestCR.Spec.Resource != nil && c.isResourceTheDefaultCR(&resource, manifestCR.Spec.Resource) {
+				continue
+			}
+			key := groupKindObjectKey(gvk.Group, gvk.Kind, resource.GetNamespace(), resource.GetName())
+			if _, isSynced := synced[key]; isSynced {
+				continue
+			}
+			blocking = append(blocking, formatBlockingResource(gvk.Kind, &resource))
+		}
+	}
+
+	if len(blocking) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrWaitingForAssociatedResourcesDeletion, summarizeBlockingResources(blocking))
+}
+
+func groupKindObjectKey(group, kind, namespace, name string) string {
+	return strings.Join([]string{group, kind, namespace, name}, "/")
+}
+
+func formatBlockingResource(kind string, resource *unstructured.Unstructured) string {
+	if resource.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", kind, resource.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", kind, resource.GetNamespace(), resource.GetName())
+}
+
+func summarizeBlockingResources(blocking []string) string {
+	slices.Sort(blocking)
+	if len(blocking) <= maxReportedBlockingResources {
+		return strings.Join(blocking, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(blocking[:maxReportedBlockingResources], ", "),
+		len(blocking)-maxReportedBlockingResources)
+}
+
 // RemoveDefaultModuleCR deletes the default module CR if available in the cluster.
 // It uses DeletePropagationBackground to delete module CR.
 // Only if module CR is not found (indicated by NotFound error), it continues to remove Manifest finalizer,
@@ -428,3 +428,77 @@ func TestClient_GetAllModuleCRsExcludingDefaultCR_WithClusterScopedCR(t *testing
 		assert.False(t, isDefaultCR, "Default cluster-scoped CR should be excluded even with namespace mismatch")
 	}
 }
+
+func TestClient_CheckAssociatedResourcesDeletion(t *testing.T) {
+	// Given a manifest CR declaring the sample kind as associated resource
+	testScheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(testScheme)
+	require.NoError(t, err)
+
+	kcpClient := fake.NewClientBuilder().WithScheme(testScheme).WithRESTMapper(getRestMapper()).Build()
+	skrClient := modulecr.NewClient(kcpClient)
+
+	sampleGVK := schema.GroupVersionKind{
+		Group:   shared.OperatorGroup,
+		Version: string(templatev1alpha1.Version),
+		Kind:    string(templatev1alpha1.SampleKind),
+	}
+	manifest := testutils.NewTestManifest("test-manifest")
+	manifest.Spec.AssociatedResources = []apimetav1.GroupVersionKind{apimetav1.GroupVersionKind(sampleGVK)}
+
+	// And the default module CR and a resource synced by the manifest deployed in the cluster
+	defaultCR := unstructured.Unstructured{}
+	defaultCR.SetGroupVersionKind(sampleGVK)
+	defaultCR.SetName("default-resource")
+	defaultCR.SetNamespace(shared.DefaultRemoteNamespace)
+	manifest.Spec.Resource = &defaultCR
+	require.NoError(t, skrClient.Create(t.Context(), defaultCR.DeepCopy()))
+
+	syncedResource := unstructured.Unstructured{}
+	syncedResource.SetGroupVersionKind(sampleGVK)
+	syncedResource.SetName("synced-resource")
+	syncedResource.SetNamespace(shared.DefaultRemoteNamespace)
+	require.NoError(t, skrClient.Create(t.Context(), syncedResource.DeepCopy()))
+	manifest.Status.Synced = []shared.Resource{{
+		GroupVersionKind: apimetav1.GroupVersionKind(sampleGVK),
+		Name:             syncedResource.GetName(),
+		Namespace:        syncedResource.GetNamespace(),
+	}}
+
+	// When no user-created associated resource exists
+	// Then the deletion is not blocked
+	require.NoError(t, skrClient.CheckAssociatedResourcesDeletion(t.Context(), manifest))
+
+	// When a user-created associated resource exists
+	userResource := unstructured.Unstructured{}
+	userResource.SetGroupVersionKind(sampleGVK)
+	userResource.SetName("user-resource")
+	userResource.SetNamespace("user-namespace")
+	require.NoError(t, skrClient.Create(t.Context(), userResource.DeepCopy()))
+
+	// Then the deletion is blocked and the blocking resource is reported
+	err = skrClient.CheckAssociatedResourcesDeletion(t.Context(), manifest)
+	require.ErrorIs(t, err, modulecr.ErrWaitingForAssociatedResourcesDeletion)
+	assert.Contains(t, err.Error(), "Sample user-namespace/user-resource")
+	assert.NotContains(t, err.Error(), "default-resource")
+	assert.NotContains(t, err.Error(), "synced-resource")
+}
+
+func TestClient_CheckAssociatedResourcesDeletion_IgnoresUnknownKinds(t *testing.T) {
+	// Given a manifest CR declaring an associated resource whose CRD is not installed
+	testScheme := machineryruntime.NewScheme()
+	err := v1beta2.AddToScheme(testScheme)
+	require.NoError(t, err)
+
+	kcpClient := fake.NewClientBuilder().WithScheme(testScheme).WithRESTMapper(getRestMapper()).Build()
+	skrClient := modulecr.NewClient(kcpClient)
+
+	manifest := testutils.NewTestManifest("test-manifest")
+	manifest.Spec.AssociatedResources = []apimetav1.GroupVersionKind{
+		{Group: "unknown.kyma-project.io", Version: "v1", Kind: "Unknown"},
+	}
+
+	// When checking the deletion of associated resources
+	// Then the deletion is not blocked
+	require.NoError(t, skrClient.CheckAssociatedResourcesDeletion(t.Context(), manifest))
+}
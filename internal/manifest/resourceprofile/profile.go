@@ -0,0 +1,113 @@
+// Package resourceprofile sizes the workloads of modules by the plan of the Kyma runtime they are installed in, so
+// that, for example, trial runtimes run smaller and production runtimes larger workloads than the module manifests
+// declare.
+package resourceprofile
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var ErrInvalidProfile = errors.New("invalid resource profile")
+
+// Profiles maps the plan names of Kyma runtimes, as in the broker-plan-name label of the Kyma, to the profile applied
+// to the module workloads in the runtimes of that plan.
+type Profiles map[string]Profile
+
+// Profile sizes the workloads of all modules, unless a module has its own sizing in Modules.
+type Profile struct {
+	WorkloadProfile `json:",inline"`
+
+	// Modules override the sizing for the workloads of single modules, by module name.
+	Modules map[string]WorkloadProfile `json:"modules,omitempty"`
+}
+
+// WorkloadProfile is the sizing applied to a Deployment or StatefulSet. The requests and limits are applied to all
+// containers of the workload, each replacing the request or limit of the same resource.
+type WorkloadProfile struct {
+	Replicas *int32                 `json:"replicas,omitempty"`
+	Requests apicorev1.ResourceList `json:"requests,omitempty"`
+	Limits   apicorev1.ResourceList `json:"limits,omitempty"`
+}
+
+// Load reads the profiles from a YAML file.
+func Load(path string) (Profiles, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource profiles file %s: %w", path, err)
+	}
+	profiles := Profiles{}
+	if err := yaml.UnmarshalStrict(content, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to parse resource profiles file %s: %w", path, err)
+	}
+	if err := profiles.Validate(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// Validate checks that no profile has negative replicas or requests exceeding its limits.
+func (p Profiles) Validate() error {
+	for plan, profile := range p {
+		if err := profile.validate(); err != nil {
+			return fmt.Errorf("%w: plan %s: %w", ErrInvalidProfile, plan, err)
+		}
+		for module, moduleProfile := range profile.Modules {
+			merged := profile.merge(moduleProfile)
+			if err := merged.validate(); err != nil {
+				return fmt.Errorf("%w: plan %s, module %s: %w", ErrInvalidProfile, plan, module, err)
+			}
+		}
+	}
+	return nil
+}
+
+// For returns the sizing for the workloads of the module in runtimes of the plan, and whether there is one.
+func (p Profiles) For(plan, module string) (WorkloadProfile, bool) {
+	profile, ok := p[plan]
+	if !ok {
+		return WorkloadProfile{}, false
+	}
+	if moduleProfile, ok := profile.Modules[module]; ok {
+		return profile.merge(moduleProfile), true
+	}
+	return profile.WorkloadProfile, true
+}
+
+// merge returns the sizing of the profile with the replicas, requests and limits of the module profile on top.
+func (p *Profile) merge(moduleProfile WorkloadProfile) WorkloadProfile {
+	merged := WorkloadProfile{
+		Replicas: p.Replicas,
+		Requests: maps.Clone(p.Requests),
+		Limits:   maps.Clone(p.Limits),
+	}
+	if moduleProfile.Replicas != nil {
+		merged.Replicas = moduleProfile.Replicas
+	}
+	if len(moduleProfile.Requests) > 0 && merged.Requests == nil {
+		merged.Requests = apicorev1.ResourceList{}
+	}
+	maps.Copy(merged.Requests, moduleProfile.Requests)
+	if len(moduleProfile.Limits) > 0 && merged.Limits == nil {
+		merged.Limits = apicorev1.ResourceList{}
+	}
+	maps.Copy(merged.Limits, moduleProfile.Limits)
+	return merged
+}
+
+func (w *WorkloadProfile) validate() error {
+	if w.Replicas != nil && *w.Replicas < 0 {
+		return fmt.Errorf("replicas must not be negative, got %d", *w.Replicas)
+	}
+	for name, request := range w.Requests {
+		if limit, ok := w.Limits[name]; ok && request.Cmp(limit) > 0 {
+			return fmt.Errorf("request %s of %s exceeds limit %s", request.String(), name, limit.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package resourceprofile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
+)
+
+const profilesYAML = `
+trial:
+  replicas: 1
+  requests:
+    cpu: 10m
+    memory: 32Mi
+  limits:
+    memory: 128Mi
+  modules:
+    istio:
+      replicas: 2
+      requests:
+        memory: 64Mi
+production:
+  replicas: 3
+`
+
+func TestLoad_ReturnsProfilesForPlanAndModule(t *testing.T) {
+	profiles, err := resourceprofile.Load(writeProfiles(t, profilesYAML))
+	require.NoError(t, err)
+
+	trial, found := profiles.For("trial", "serverless")
+	require.True(t, found)
+	assert.Equal(t, int32(1), *trial.Replicas)
+	assert.Equal(t, resource.MustParse("32Mi"), trial.Requests[apicorev1.ResourceMemory])
+
+	istio, found := profiles.For("trial", "istio")
+	require.True(t, found)
+	assert.Equal(t, int32(2), *istio.Replicas)
+	assert.Equal(t, resource.MustParse("10m"), istio.Requests[apicorev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("64Mi"), istio.Requests[apicorev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("128Mi"), istio.Limits[apicorev1.ResourceMemory])
+	// the module sizing must not leak into the sizing of the plan
+	assert.Equal(t, resource.MustParse("32Mi"), trial.Requests[apicorev1.ResourceMemory])
+
+	_, found = profiles.For("azure", "istio")
+	assert.False(t, found)
+}
+
+func TestLoad_OnRequestAboveLimit_ReturnsErr(t *testing.T) {
+	_, err := resourceprofile.Load(writeProfiles(t, `
+trial:
+  limits:
+    memory: 128Mi
+  modules:
+    istio:
+      requests:
+        memory: 256Mi
+`))
+
+	require.ErrorIs(t, err, resourceprofile.ErrInvalidProfile)
+	assert.ErrorContains(t, err, "module istio")
+}
+
+func TestLoad_OnUnknownField_ReturnsErr(t *testing.T) {
+	_, err := resourceprofile.Load(writeProfiles(t, `
+trial:
+  replica: 1
+`))
+
+	require.Error(t, err)
+}
+
+func writeProfiles(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
@@ -0,0 +1,247 @@
+package skrresources
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/status"
+)
+
+// maxDriftFields bounds the field paths listed per drift entry, so that a manager owning a whole resource does not
+// bloat the Manifest status.
+const maxDriftFields = 10
+
+type DriftMetrics interface {
+	RecordDrift(manifestName, moduleName string, driftedResources, revertedResources int)
+}
+
+// DriftDetection creates the collectors that report the fields of synced resources managed by field managers other
+// than the known ones, which are Lifecycle Manager itself and the controllers of the remote cluster.
+type DriftDetection struct {
+	knownManagers []string
+	maxEntries    int
+	metrics       DriftMetrics
+}
+
+func NewDriftDetection(knownManagers []string, maxEntries int, metrics DriftMetrics) *DriftDetection {
+	return &DriftDetection{
+		knownManagers: knownManagers,
+		maxEntries:    maxEntries,
+		metrics:       metrics,
+	}
+}
+
+// NewCollector returns a collector that reports the drift of the resources synced for the manifest in its status.
+func (d *DriftDetection) NewCollector(manifest *v1beta2.Manifest) ManagedFieldsCollector {
+	return &DriftCollector{detection: d, manifest: manifest}
+}
+
+// DisabledDriftDetection creates collectors that neither collect nor report anything.
+type DisabledDriftDetection struct{}
+
+func (DisabledDriftDetection) NewCollector(_ *v1beta2.Manifest) ManagedFieldsCollector {
+	return NoopCollector{}
+}
+
+// NoopCollector implements the ManagedFieldsCollector interface without collecting anything.
+type NoopCollector struct{}
+
+func (NoopCollector) Collect(_ context.Context, _ client.Object) {}
+
+func (NoopCollector) Emit(_ context.Context) error { return nil }
+
+// DriftCollector implements the ManagedFieldsCollector and ConflictCollector interfaces. It collects the fields of the
+// applied resources that unknown field managers changed, both the ones reverted by the apply and the ones the
+// managers still manage, and emits them to the Drift status and condition of the manifest and to the drift metrics
+// of the module. The collector is thread-safe.
+type DriftCollector struct {
+	detection *DriftDetection
+	manifest  *v1beta2.Manifest
+
+	mu      sync.Mutex
+	entries []shared.ResourceDrift
+}
+
+// Collect collects the fields of the object managed by unknown field managers, except for the fields the drift
+// policy of the manifest ignores.
+func (c *DriftCollector) Collect(ctx context.Context, obj client.Object) {
+	policy := driftpolicy.Policy(c.manifest.Spec.DriftPolicy)
+	var entries []shared.ResourceDrift
+	for _, managedFields := range obj.GetManagedFields() {
+		// subresources, such as status, are written by controllers and never applied by Lifecycle Manager
+		if managedFields.Subresource != "" || slices.Contains(c.detection.knownManagers, managedFields.Manager) ||
+			managedFields.Manager == string(fieldowners.KeptFieldsApplier) {
+			continue
+		}
+		fields, err := fieldPaths(managedFields.FieldsV1, func(path fieldpath.Path) bool {
+			return policy.FieldAction(obj, path) != v1beta2.DriftActionIgnore
+		})
+		if err != nil {
+			logf.FromContext(ctx).V(internal.DebugLogLevel).Error(err, "failed to parse managed fields",
+				"resource", obj.GetName(), "manager", managedFields.Manager)
+		} else if len(fields) == 0 {
+			continue
+		}
+		entries = append(entries, shared.ResourceDrift{
+			Resource:  objectToResource(obj),
+			Manager:   managedFields.Manager,
+			Operation: string(managedFields.Operation),
+			Fields:    fields,
+		})
+	}
+	c.add(entries)
+}
+
+// CollectConflicts collects the fields of the apply conflicts with unknown field managers, which the following
+// apply with forced ownership reverts.
+func (c *DriftCollector) CollectConflicts(_ context.Context, obj client.Object, conflictErr error) {
+	var apiStatus apierrors.APIStatus
+	if !errors.As(conflictErr, &apiStatus) || apiStatus.Status().Details == nil {
+		return
+	}
+	var entries []shared.ResourceDrift
+	for _, cause := range apiStatus.Status().Details.Causes {
+		if cause.Type != apimetav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager, operation, ok := parseConflictManager(cause.Message)
+		if !ok || slices.Contains(c.detection.knownManagers, manager) {
+			continue
+		}
+		index := slices.IndexFunc(entries, func(entry shared.ResourceDrift) bool {
+			return entry.Manager == manager
+		})
+		if index < 0 {
+			entries = append(entries, shared.ResourceDrift{
+				Resource:  objectToResource(obj),
+				Manager:   manager,
+				Operation: operation,
+				Reverted:  true,
+			})
+			index = len(entries) - 1
+		}
+		entries[index].Fields = append(entries[index].Fields, cause.Field)
+	}
+	for i := range entries {
+		slices.Sort(entries[i].Fields)
+		entries[i].Fields = boundFields(entries[i].Fields)
+	}
+	c.add(entries)
+}
+
+func (c *DriftCollector) add(entries []shared.ResourceDrift) {
+	if len(entries) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, entries...)
+}
+
+// Emit replaces the Drift status of the manifest with the collected entries, the reverted ones first and bounded by
+// the configured maximum, and sets the Drift condition, which names all unknown managers and counts all drifted
+// resources.
+func (c *DriftCollector) Emit(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	slices.SortFunc(c.entries, func(a, b shared.ResourceDrift) int {
+		if a.Reverted != b.Reverted {
+			if a.Reverted {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(cmp.Compare(a.ID(), b.ID()), cmp.Compare(a.Manager, b.Manager))
+	})
+	var drifted, reverted, managers []string
+	for _, entry := range c.entries {
+		if !slices.Contains(drifted, entry.ID()) {
+			drifted = append(drifted, entry.ID())
+		}
+		if entry.Reverted && !slices.Contains(reverted, entry.ID()) {
+			reverted = append(reverted, entry.ID())
+		}
+		if !slices.Contains(managers, entry.Manager) {
+			managers = append(managers, entry.Manager)
+		}
+	}
+	slices.Sort(managers)
+
+	drift := c.entries
+	if len(drift) > c.detection.maxEntries {
+		drift = drift[:c.detection.maxEntries]
+	}
+	c.manifest.SetStatus(c.manifest.GetStatus().WithDrift(slices.Clone(drift)))
+	status.SetDriftCondition(c.manifest, len(drifted), len(reverted), managers)
+	c.detection.metrics.RecordDrift(c.manifest.GetName(), c.manifest.GetLabels()[shared.ModuleName],
+		len(drifted), len(reverted))
+	return nil
+}
+
+// fieldPaths returns the sorted paths of the leaf fields in the field set accepted by the filter, for example
+// ".spec.replicas".
+func fieldPaths(fieldsV1 *apimetav1.FieldsV1, filter func(fieldpath.Path) bool) ([]string, error) {
+	if fieldsV1 == nil {
+		return nil, nil
+	}
+	set := &fieldpath.Set{}
+	if err := set.FromJSON(bytes.NewReader(fieldsV1.Raw)); err != nil {
+		return nil, fmt.Errorf("failed to parse field set: %w", err)
+	}
+	var paths []string
+	set.Leaves().Iterate(func(path fieldpath.Path) {
+		if filter(path) {
+			paths = append(paths, path.String())
+		}
+	})
+	slices.Sort(paths)
+	return boundFields(paths), nil
+}
+
+func boundFields(fields []string) []string {
+	if len(fields) > maxDriftFields {
+		return fields[:maxDriftFields]
+	}
+	return fields
+}
+
+// parseConflictManager parses the field manager and its operation from the message of an apply conflict cause, for
+// example `conflict with "kubectl-edit" using apps/v1` for a manager that updated the fields.
+func parseConflictManager(message string) (string, string, bool) {
+	quoted, found := strings.CutPrefix(message, "conflict with ")
+	if !found {
+		return "", "", false
+	}
+	quoted, err := strconv.QuotedPrefix(quoted)
+	if err != nil {
+		return "", "", false
+	}
+	manager, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", "", false
+	}
+	operation := apimetav1.ManagedFieldsOperationApply
+	if strings.Contains(strings.TrimPrefix(message, "conflict with "+quoted), " using ") {
+		operation = apimetav1.ManagedFieldsOperationUpdate
+	}
+	return manager, string(operation), true
+}
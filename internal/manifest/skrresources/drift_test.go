@@ -0,0 +1,236 @@
+package skrresources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/status"
+)
+
+var knownManagers = []string{"declarative.kyma-project.io/applier", "kube-controller-manager"}
+
+func TestDriftCollector_ReportsUnknownManagers(t *testing.T) {
+	manifest := driftManifest()
+	metrics := &driftMetricsStub{}
+	collector := skrresources.NewDriftDetection(knownManagers, 10, metrics).NewCollector(manifest)
+
+	collector.Collect(t.Context(), deploymentManagedBy("manager",
+		managedFieldsEntry("declarative.kyma-project.io/applier", "", `{"f:spec":{"f:replicas":{}}}`),
+		managedFieldsEntry("kubectl-edit", "", `{"f:spec":{"f:template":{"f:spec":{"f:containers":{`+
+			`"k:{\"name\":\"manager\"}":{"f:image":{}}}}}}}`),
+		managedFieldsEntry("kubectl-patch", "", `{"f:metadata":{"f:labels":{"f:team":{}}}}`),
+		managedFieldsEntry("hpa-controller", "scale", `{"f:spec":{"f:replicas":{}}}`),
+	))
+	collector.Collect(t.Context(), deploymentManagedBy("webhook",
+		managedFieldsEntry("declarative.kyma-project.io/applier", "", `{"f:spec":{"f:replicas":{}}}`),
+	))
+	require.NoError(t, collector.Emit(t.Context()))
+
+	drift := manifest.GetStatus().Drift
+	require.Len(t, drift, 2)
+	assert.Equal(t, "manager", drift[0].Name)
+	assert.Equal(t, "kubectl-edit", drift[0].Manager)
+	assert.Equal(t, string(apimetav1.ManagedFieldsOperationUpdate), drift[0].Operation)
+	assert.Equal(t, []string{`.spec.template.spec.containers[name="manager"].image`}, drift[0].Fields)
+	assert.Equal(t, "kubectl-patch", drift[1].Manager)
+	assert.Equal(t, []string{".metadata.labels.team"}, drift[1].Fields)
+
+	condition := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeDrift))
+	require.NotNil(t, condition)
+	assert.Equal(t, apimetav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "1 resources changed by unknown field managers, 0 reverted: kubectl-edit, kubectl-patch",
+		condition.Message)
+
+	assert.Equal(t, "test-manifest", metrics.manifestName)
+	assert.Equal(t, "test-module", metrics.moduleName)
+	assert.Equal(t, 1, metrics.driftedResources)
+}
+
+func TestDriftCollector_ReportsRevertedConflicts(t *testing.T) {
+	manifest := driftManifest()
+	metrics := &driftMetricsStub{}
+	collector := skrresources.NewDriftDetection(knownManagers, 10, metrics).NewCollector(manifest)
+	conflictCollector, ok := collector.(skrresources.ConflictCollector)
+	require.True(t, ok)
+
+	container := `.spec.template.spec.containers[name="manager"]`
+	conflictErr := apierrors.NewApplyConflict([]apimetav1.StatusCause{
+		conflictCause(`conflict with "kubectl-edit" using apps/v1`, container+".image"),
+		conflictCause(`conflict with "kubectl-edit" using apps/v1`, container+".args"),
+		conflictCause(`conflict with "argocd"`, ".metadata.labels.app"),
+		conflictCause(`conflict with "kube-controller-manager" using apps/v1`, ".spec.replicas"),
+	}, "Apply failed with 4 conflicts")
+	conflictCollector.CollectConflicts(t.Context(), deploymentManagedBy("manager"), conflictErr)
+	collector.Collect(t.Context(), deploymentManagedBy("webhook",
+		managedFieldsEntry("kubectl-patch", "", `{"f:metadata":{"f:labels":{"f:team":{}}}}`),
+	))
+	require.NoError(t, collector.Emit(t.Context()))
+
+	drift := manifest.GetStatus().Drift
+	require.Len(t, drift, 3)
+	assert.Equal(t, shared.ResourceDrift{
+		Resource:  drift[0].Resource,
+		Manager:   "argocd",
+		Operation: string(apimetav1.ManagedFieldsOperationApply),
+		Fields:    []string{".metadata.labels.app"},
+		Reverted:  true,
+	}, drift[0])
+	assert.Equal(t, "manager", drift[0].Name)
+	assert.Equal(t, shared.ResourceDrift{
+		Resource:  drift[1].Resource,
+		Manager:   "kubectl-edit",
+		Operation: string(apimetav1.ManagedFieldsOperationUpdate),
+		Fields:    []string{container + ".args", container + ".image"},
+		Reverted:  true,
+	}, drift[1])
+	assert.Equal(t, "webhook", drift[2].Name)
+	assert.False(t, drift[2].Reverted)
+
+	condition := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeDrift))
+	require.NotNil(t, condition)
+	assert.Equal(t, "2 resources changed by unknown field managers, 1 reverted: argocd, kubectl-edit, kubectl-patch",
+		condition.Message)
+	assert.Equal(t, 2, metrics.driftedResources)
+	assert.Equal(t, 1, metrics.revertedResources)
+}
+
+func TestDriftCollector_SkipsFieldsIgnoredByDriftPolicy(t *testing.T) {
+	manifest := driftManifest()
+	manifest.Spec.DriftPolicy = []v1beta2.DriftRule{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.replicas"}, Action: v1beta2.DriftActionIgnore},
+		{Group: "apps", Kind: "Deployment", Paths: []string{"metadata.labels"}, Action: v1beta2.DriftActionReport},
+	}
+	metrics := &driftMetricsStub{}
+	collector := skrresources.NewDriftDetection(knownManagers, 10, metrics).NewCollector(manifest)
+
+	collector.Collect(t.Context(), deploymentManagedBy("manager",
+		managedFieldsEntry("hpa-controller", "", `{"f:spec":{"f:replicas":{}}}`),
+		managedFieldsEntry("kubectl-patch", "", `{"f:metadata":{"f:labels":{"f:team":{}}},"f:spec":{"f:replicas":{}}}`),
+	))
+	require.NoError(t, collector.Emit(t.Context()))
+
+	drift := manifest.GetStatus().Drift
+	require.Len(t, drift, 1)
+	assert.Equal(t, "kubectl-patch", drift[0].Manager)
+	assert.Equal(t, []string{".metadata.labels.team"}, drift[0].Fields)
+	assert.Equal(t, 1, metrics.driftedResources)
+}
+
+func TestDriftCollector_BoundsEntriesAndFields(t *testing.T) {
+	manifest := driftManifest()
+	metrics := &driftMetricsStub{}
+	collector := skrresources.NewDriftDetection(knownManagers, 2, metrics).NewCollector(manifest)
+
+	labels := ""
+	for i := range 15 {
+		if i > 0 {
+			labels += ","
+		}
+		labels += fmt.Sprintf(`"f:label-%02d":{}`, i)
+	}
+	for _, name := range []string{"c", "b", "a"} {
+		collector.Collect(t.Context(), deploymentManagedBy(name,
+			managedFieldsEntry("kubectl-edit", "", `{"f:metadata":{"f:labels":{`+labels+`}}}`),
+		))
+	}
+	require.NoError(t, collector.Emit(t.Context()))
+
+	drift := manifest.GetStatus().Drift
+	require.Len(t, drift, 2)
+	assert.Equal(t, "a", drift[0].Name)
+	assert.Equal(t, "b", drift[1].Name)
+	assert.Len(t, drift[0].Fields, 10)
+	assert.Equal(t, ".metadata.labels.label-00", drift[0].Fields[0])
+	assert.Equal(t, 3, metrics.driftedResources)
+}
+
+func TestDriftCollector_ClearsDrift(t *testing.T) {
+	manifest := driftManifest()
+	manifest.SetStatus(manifest.GetStatus().WithDrift([]shared.ResourceDrift{{Manager: "kubectl-edit"}}))
+	status.SetDriftCondition(manifest, 1, 1, []string{"kubectl-edit"})
+	metrics := &driftMetricsStub{driftedResources: 1}
+	collector := skrresources.NewDriftDetection(knownManagers, 10, metrics).NewCollector(manifest)
+
+	collector.Collect(t.Context(), deploymentManagedBy("manager",
+		managedFieldsEntry("declarative.kyma-project.io/applier", "", `{"f:spec":{"f:replicas":{}}}`),
+	))
+	require.NoError(t, collector.Emit(t.Context()))
+
+	assert.Empty(t, manifest.GetStatus().Drift)
+	condition := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeDrift))
+	require.NotNil(t, condition)
+	assert.Equal(t, apimetav1.ConditionFalse, condition.Status)
+	assert.Equal(t, 0, metrics.driftedResources)
+}
+
+func TestDisabledDriftDetection_ReportsNothing(t *testing.T) {
+	manifest := driftManifest()
+	collector := skrresources.DisabledDriftDetection{}.NewCollector(manifest)
+
+	collector.Collect(t.Context(), deploymentManagedBy("manager",
+		managedFieldsEntry("kubectl-edit", "", `{"f:spec":{"f:replicas":{}}}`),
+	))
+	require.NoError(t, collector.Emit(t.Context()))
+
+	assert.Empty(t, manifest.GetStatus().Drift)
+	assert.Empty(t, manifest.GetStatus().Conditions)
+}
+
+type driftMetricsStub struct {
+	manifestName      string
+	moduleName        string
+	driftedResources  int
+	revertedResources int
+}
+
+func (m *driftMetricsStub) RecordDrift(manifestName, moduleName string, driftedResources, revertedResources int) {
+	m.manifestName = manifestName
+	m.moduleName = moduleName
+	m.driftedResources = driftedResources
+	m.revertedResources = revertedResources
+}
+
+func driftManifest() *v1beta2.Manifest {
+	manifest := &v1beta2.Manifest{}
+	manifest.SetName("test-manifest")
+	manifest.SetLabels(map[string]string{shared.ModuleName: "test-module"})
+	return manifest
+}
+
+func deploymentManagedBy(name string, managedFields ...apimetav1.ManagedFieldsEntry) *unstructured.Unstructured {
+	deployment := &unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	deployment.SetKind("Deployment")
+	deployment.SetName(name)
+	deployment.SetNamespace("kyma-system")
+	deployment.SetManagedFields(managedFields)
+	return deployment
+}
+
+func conflictCause(message, field string) apimetav1.StatusCause {
+	return apimetav1.StatusCause{Type: apimetav1.CauseTypeFieldManagerConflict, Message: message, Field: field}
+}
+
+func managedFieldsEntry(manager, subresource, fields string) apimetav1.ManagedFieldsEntry {
+	operation := apimetav1.ManagedFieldsOperationUpdate
+	if manager == "declarative.kyma-project.io/applier" {
+		operation = apimetav1.ManagedFieldsOperationApply
+	}
+	return apimetav1.ManagedFieldsEntry{
+		Manager:     manager,
+		Operation:   operation,
+		Subresource: subresource,
+		FieldsType:  "FieldsV1",
+		FieldsV1:    &apimetav1.FieldsV1{Raw: []byte(fields)},
+	}
+}
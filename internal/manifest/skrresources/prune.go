@@ -0,0 +1,37 @@
+package skrresources
+
+import (
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
+)
+
+// PrunedResources returns the synced resources that are not part of the target resources and are therefore deleted
+// from the remote cluster. The kyma-system Namespace is never pruned, and neither are the resources the drift policy
+// keeps as a whole. Pass an empty policy when the module is deleted, so that the kept resources are pruned as well.
+func PrunedResources(synced []shared.Resource, target []client.Object,
+	policy driftpolicy.Policy,
+) []shared.Resource {
+	targetIDs := make(map[string]struct{}, len(target))
+	for _, obj := range target {
+		targetIDs[objectToResource(obj).ID()] = struct{}{}
+	}
+
+	var pruned []shared.Resource
+	for _, resource := range synced {
+		if _, found := targetIDs[resource.ID()]; !found && !isKymaSystemNamespace(resource) {
+			pruned = append(pruned, resource)
+		}
+	}
+	return slices.DeleteFunc(pruned, func(resource shared.Resource) bool {
+		return policy.ResourceAction(resource.ToUnstructured()) != v1beta2.DriftActionRevert
+	})
+}
+
+func isKymaSystemNamespace(resource shared.Resource) bool {
+	return resource.Kind == "Namespace" && resource.Name == shared.DefaultRemoteNamespace
+}
@@ -0,0 +1,73 @@
+package skrresources_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+)
+
+func makeResource(name, namespace, kind string) shared.Resource {
+	return shared.Resource{
+		Name:      name,
+		Namespace: namespace,
+		GroupVersionKind: apimetav1.GroupVersionKind{
+			Kind: kind,
+		},
+	}
+}
+
+func makeObj(name, namespace, kind string) client.Object {
+	res := makeResource(name, namespace, kind)
+	return res.ToUnstructured()
+}
+
+func TestPrunedResources(t *testing.T) {
+	t.Parallel()
+	dummyPod := makeResource("foo", "default", "Pod")
+	dummyService := makeResource("bar", "default", "Service")
+	dummyDeploy := makeResource("baz", "default", "Deployment")
+
+	synced := []shared.Resource{dummyPod, dummyService, dummyDeploy}
+	target := []client.Object{makeObj("bar", "default", "Service")}
+
+	pruned := skrresources.PrunedResources(synced, target, nil)
+
+	assert.Len(t, pruned, 2)
+	assert.Contains(t, pruned, dummyPod)
+	assert.Contains(t, pruned, dummyDeploy)
+	assert.NotContains(t, pruned, dummyService)
+}
+
+func TestPrunedResources_KeepsKymaSystemNamespace(t *testing.T) {
+	t.Parallel()
+	kubeNs := makeResource("kube-system", "", "Namespace")
+	kymaNs := makeResource(shared.DefaultRemoteNamespace, "", "Namespace")
+	crd := makeResource(shared.DefaultRemoteNamespace, "", "CustomResourceDefinition")
+
+	pruned := skrresources.PrunedResources([]shared.Resource{kubeNs, kymaNs, crd}, nil, nil)
+
+	require.Equal(t, []shared.Resource{kubeNs, crd}, pruned)
+}
+
+func TestPrunedResources_KeepsResourcesKeptByDriftPolicy(t *testing.T) {
+	t.Parallel()
+	configMap := makeResource("tuned-config", "kyma-system", "ConfigMap")
+	otherConfigMap := makeResource("other-config", "kyma-system", "ConfigMap")
+	deployment := makeResource("some-deploy", "kyma-system", "Deployment")
+	policy := driftpolicy.Policy{
+		{Kind: "ConfigMap", Name: "tuned-config", Action: v1beta2.DriftActionReport},
+		{Kind: "Deployment", Paths: []string{"spec.replicas"}, Action: v1beta2.DriftActionIgnore},
+	}
+
+	pruned := skrresources.PrunedResources([]shared.Resource{configMap, otherConfigMap, deployment}, nil, policy)
+
+	require.Equal(t, []shared.Resource{otherConfigMap, deployment}, pruned)
+}
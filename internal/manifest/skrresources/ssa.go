@@ -1,18 +1,24 @@
 package skrresources
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
 
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal"
+	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
 
@@ -32,25 +38,48 @@ type ManagedFieldsCollector interface {
 	Emit(ctx context.Context) error
 }
 
+// ConflictCollector is implemented by ManagedFieldsCollectors that also collect the fields taken over from other
+// field managers. For them, resources are first applied without forcing the ownership, so that the API server
+// reports the conflicting fields, and only applied with forced ownership on conflicts.
+type ConflictCollector interface {
+	// CollectConflicts collects the conflicts of the apply conflict error of the single object
+	CollectConflicts(ctx context.Context, obj client.Object, conflictErr error)
+}
+
 type ConcurrentDefaultSSA struct {
 	clnt      client.Client
 	owner     client.FieldOwner
 	versioner machineryruntime.GroupVersioner
 	converter machineryruntime.ObjectConvertor
 	collector ManagedFieldsCollector
+	policy    driftpolicy.Policy
 }
 
 func ConcurrentSSA(clnt client.Client,
 	owner client.FieldOwner,
 	managedFieldsCollector ManagedFieldsCollector,
+	opts ...func(*ConcurrentDefaultSSA) *ConcurrentDefaultSSA,
 ) *ConcurrentDefaultSSA {
-	return &ConcurrentDefaultSSA{
+	ssa := &ConcurrentDefaultSSA{
 		clnt:      clnt,
 		owner:     owner,
 		versioner: schema.GroupVersions(clnt.Scheme().PrioritizedVersionsAllGroups()),
 		converter: clnt.Scheme(),
 		collector: managedFieldsCollector,
 	}
+	for _, opt := range opts {
+		ssa = opt(ssa)
+	}
+	return ssa
+}
+
+// WithDriftPolicy keeps the changes to the resources and fields in the remote cluster that the drift policy does
+// not revert.
+func WithDriftPolicy(policy driftpolicy.Policy) func(*ConcurrentDefaultSSA) *ConcurrentDefaultSSA {
+	return func(ssa *ConcurrentDefaultSSA) *ConcurrentDefaultSSA {
+		ssa.policy = policy
+		return ssa
+	}
 }
 
 func (c *ConcurrentDefaultSSA) Run(ctx context.Context, resources []client.Object) error {
@@ -139,7 +168,27 @@ func (c *ConcurrentDefaultSSA) serverSideApplyObject(
 		)
 	}
 
-	err := c.clnt.Apply(ctx, client.ApplyConfigurationFromUnstructured(unstructuredObj), client.ForceOwnership, c.owner)
+	apply, err := c.applyDriftPolicy(ctx, unstructuredObj)
+	if err != nil {
+		name := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
+		return fmt.Errorf("drift policy for %s failed: %w", name, supressLongClientErrors(err))
+	}
+	if !apply {
+		c.collector.Collect(ctx, obj)
+		return nil
+	}
+
+	conflictCollector, collectsConflicts := c.collector.(ConflictCollector)
+	if collectsConflicts {
+		err = c.clnt.Apply(ctx, client.ApplyConfigurationFromUnstructured(unstructuredObj), c.owner)
+		if apierrors.IsConflict(err) {
+			conflictCollector.CollectConflicts(ctx, obj, err)
+		}
+	}
+	if !collectsConflicts || apierrors.IsConflict(err) {
+		err = c.clnt.Apply(ctx, client.ApplyConfigurationFromUnstructured(unstructuredObj), client.ForceOwnership,
+			c.owner)
+	}
 	if err != nil {
 		name := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
 		return fmt.Errorf(
@@ -151,6 +200,79 @@ func (c *ConcurrentDefaultSSA) serverSideApplyObject(
 	return nil
 }
 
+// applyDriftPolicy prepares the object for the apply according to the drift policy and returns whether to apply it.
+// Resources kept as a whole are not applied once they exist, and only get the managed fields of the live resource
+// for the collector. For all other existing resources, the fields whose changes are kept are removed from the
+// object, so that the apply neither reverts nor takes over these fields, no matter who changes them meanwhile.
+func (c *ConcurrentDefaultSSA) applyDriftPolicy(ctx context.Context, obj *unstructured.Unstructured) (bool, error) {
+	keepsResource := c.policy.ResourceAction(obj) != v1beta2.DriftActionRevert
+	if !keepsResource && len(c.policy.KeptPaths(obj)) == 0 {
+		return true, nil
+	}
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := c.clnt.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		if util.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get live resource: %w", err)
+	}
+	if keepsResource {
+		obj.SetManagedFields(live.GetManagedFields())
+		return false, nil
+	}
+	if err := c.handOverKeptFields(ctx, live); err != nil {
+		return false, err
+	}
+	if err := c.policy.RemoveKeptFields(obj); err != nil {
+		return false, fmt.Errorf("failed to remove kept fields: %w", err)
+	}
+	return true, nil
+}
+
+// handOverKeptFields passes the fields whose changes are kept to a dedicated field manager while the owner still
+// manages them, for example from the apply that created the resource, as the apply would remove the fields the owner
+// manages but no longer sets. The fields are applied without forcing the ownership, so that a change made since the
+// live resource was read is never reverted. On a conflict, the changing manager has taken the fields over from the
+// owner anyway.
+func (c *ConcurrentDefaultSSA) handOverKeptFields(ctx context.Context, live *unstructured.Unstructured) error {
+	owns, err := c.managesKeptFields(live)
+	if err != nil || !owns {
+		return err
+	}
+	kept, err := c.policy.KeptFields(live)
+	if err != nil {
+		return fmt.Errorf("failed to collect kept fields: %w", err)
+	}
+	err = c.clnt.Apply(ctx, client.ApplyConfigurationFromUnstructured(kept), fieldowners.KeptFieldsApplier)
+	if err != nil && !apierrors.IsConflict(err) {
+		return fmt.Errorf("failed to hand over kept fields: %w", err)
+	}
+	return nil
+}
+
+// managesKeptFields returns whether the owner manages fields of the live resource whose changes are kept.
+func (c *ConcurrentDefaultSSA) managesKeptFields(live *unstructured.Unstructured) (bool, error) {
+	for _, managedFields := range live.GetManagedFields() {
+		if managedFields.Manager != string(c.owner) || managedFields.Subresource != "" ||
+			managedFields.FieldsV1 == nil {
+			continue
+		}
+		set := &fieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(managedFields.FieldsV1.Raw)); err != nil {
+			return false, fmt.Errorf("failed to parse field set: %w", err)
+		}
+		manages := false
+		set.Leaves().Iterate(func(path fieldpath.Path) {
+			manages = manages || c.policy.FieldAction(live, path) != v1beta2.DriftActionRevert
+		})
+		if manages {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func supressLongClientErrors(clientErr error) error {
 	if err, suppressed := suppressUnauthorized(clientErr); suppressed {
 		return err
@@ -3,12 +3,15 @@ package skrresources_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/common/fieldowners"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 )
 
@@ -28,7 +31,7 @@ func TestConcurrentSSA(t *testing.T) {
 	fakeClientBuilder := fake.NewClientBuilder().WithRuntimeObjects(pod).Build()
 	_ = fakeClientBuilder.Create(t.Context(), pod)
 
-	inactiveCollector := skrresources.NewManifestLogCollector(nil, fieldowners.DeclarativeApplier)
+	inactiveCollector := skrresources.NoopCollector{}
 
 	type args struct {
 		clnt  client.Client
@@ -63,3 +66,89 @@ func TestConcurrentSSA(t *testing.T) {
 		)
 	}
 }
+
+func TestConcurrentSSA_WithDriftPolicy_KeepsModifications(t *testing.T) {
+	t.Parallel()
+
+	liveDeployment := deployment(5, 0)
+	liveConfigMap := configMap(map[string]any{"config.yaml": "tuned: true"})
+	clnt := fake.NewClientBuilder().WithObjects(liveDeployment, liveConfigMap).Build()
+	policy := driftpolicy.Policy{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.replicas"}, Action: v1beta2.DriftActionIgnore},
+		{Kind: "ConfigMap", Name: "tuned-config", Action: v1beta2.DriftActionReport},
+	}
+
+	err := skrresources.ConcurrentSSA(clnt, fieldowners.DeclarativeApplier, skrresources.NoopCollector{},
+		skrresources.WithDriftPolicy(policy),
+	).Run(t.Context(), []client.Object{
+		deployment(1, 10),
+		configMap(map[string]any{"config.yaml": "tuned: false"}),
+	})
+	require.NoError(t, err)
+
+	applied := &unstructured.Unstructured{}
+	applied.SetGroupVersionKind(liveDeployment.GroupVersionKind())
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKeyFromObject(liveDeployment), applied))
+	replicas, _, _ := unstructured.NestedInt64(applied.Object, "spec", "replicas")
+	assert.Equal(t, int64(5), replicas)
+	minReadySeconds, _, _ := unstructured.NestedInt64(applied.Object, "spec", "minReadySeconds")
+	assert.Equal(t, int64(10), minReadySeconds)
+
+	kept := &unstructured.Unstructured{}
+	kept.SetGroupVersionKind(liveConfigMap.GroupVersionKind())
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKeyFromObject(liveConfigMap), kept))
+	data, _, _ := unstructured.NestedStringMap(kept.Object, "data")
+	assert.Equal(t, map[string]string{"config.yaml": "tuned: true"}, data)
+}
+
+func TestConcurrentSSA_WithDriftPolicy_HandsOverKeptFieldsOfOwner(t *testing.T) {
+	t.Parallel()
+
+	clnt := fake.NewClientBuilder().WithReturnManagedFields().Build()
+	require.NoError(t, skrresources.ConcurrentSSA(clnt, fieldowners.DeclarativeApplier,
+		skrresources.NoopCollector{}).Run(t.Context(), []client.Object{deployment(1, 10)}))
+	policy := driftpolicy.Policy{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.replicas"}, Action: v1beta2.DriftActionIgnore},
+	}
+
+	err := skrresources.ConcurrentSSA(clnt, fieldowners.DeclarativeApplier, skrresources.NoopCollector{},
+		skrresources.WithDriftPolicy(policy),
+	).Run(t.Context(), []client.Object{deployment(3, 20)})
+	require.NoError(t, err)
+
+	applied := &unstructured.Unstructured{}
+	applied.SetGroupVersionKind(deployment(0, 0).GroupVersionKind())
+	require.NoError(t, clnt.Get(t.Context(), client.ObjectKeyFromObject(deployment(0, 0)), applied))
+	replicas, found, _ := unstructured.NestedInt64(applied.Object, "spec", "replicas")
+	assert.True(t, found, "the kept field must not be removed with the ownership of the applier")
+	assert.Equal(t, int64(1), replicas)
+	minReadySeconds, _, _ := unstructured.NestedInt64(applied.Object, "spec", "minReadySeconds")
+	assert.Equal(t, int64(20), minReadySeconds)
+	managers := make([]string, 0, len(applied.GetManagedFields()))
+	for _, managedFields := range applied.GetManagedFields() {
+		managers = append(managers, managedFields.Manager)
+	}
+	assert.Contains(t, managers, string(fieldowners.KeptFieldsApplier))
+}
+
+func deployment(replicas, minReadySeconds int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "manager", "namespace": "kyma-system"},
+			"spec":       map[string]any{"replicas": replicas, "minReadySeconds": minReadySeconds},
+		},
+	}
+}
+
+func configMap(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "tuned-config", "namespace": "kyma-system"},
+			"data":       data,
+		},
+	}
+}
@@ -15,21 +15,24 @@ import (
 
 var ErrWarningResourceSyncStateDiff = errors.New("resource syncTarget state diff detected")
 
+// SyncResources applies the target resources to the remote cluster and records them as synced in the manifest
+// status. The managed fields of the applied resources are passed to the collector. Changes in the remote cluster are
+// reverted unless the drift policy of the manifest keeps them.
 func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1beta2.Manifest,
-	target []client.Object,
+	target []client.Object, managedFieldsCollector ManagedFieldsCollector,
 ) error {
-	manifestStatus := manifest.GetStatus()
-
-	managedFieldsCollector := NewManifestLogCollector(manifest, fieldowners.DeclarativeApplier)
-
 	if err := ConcurrentSSA(skrClient,
 		fieldowners.DeclarativeApplier,
 		managedFieldsCollector,
+		WithDriftPolicy(manifest.Spec.DriftPolicy),
 	).Run(ctx, target); err != nil {
-		manifest.SetStatus(manifestStatus.WithState(shared.StateError).WithErr(err))
+		manifest.SetStatus(manifest.GetStatus().WithState(shared.StateError).WithErr(err))
 		return err
 	}
 
+	// read after the apply, as the collector may have reported drift to the status
+	manifestStatus := manifest.GetStatus()
+
 	oldSynced := manifestStatus.Synced
 	newSynced := objectsToResources(target)
 	manifestStatus.Synced = newSynced
@@ -51,22 +54,26 @@ func SyncResources(ctx context.Context, skrClient client.Client, manifest *v1bet
 func objectsToResources(objs []client.Object) []shared.Resource {
 	result := make([]shared.Resource, 0, len(objs))
 	for _, obj := range objs {
-		gvk := obj.GetObjectKind().GroupVersionKind()
-		result = append(result, shared.Resource{
-			Name:      obj.GetName(),
-			Namespace: obj.GetNamespace(),
-			GroupVersionKind: apimetav1.GroupVersionKind(
-				schema.GroupVersionKind{
-					Group:   gvk.Group,
-					Version: gvk.Version,
-					Kind:    gvk.Kind,
-				},
-			),
-		})
+		result = append(result, objectToResource(obj))
 	}
 	return result
 }
 
+func objectToResource(obj client.Object) shared.Resource {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return shared.Resource{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		GroupVersionKind: apimetav1.GroupVersionKind(
+			schema.GroupVersionKind{
+				Group:   gvk.Group,
+				Version: gvk.Version,
+				Kind:    gvk.Kind,
+			},
+		),
+	}
+}
+
 func HasDiff(oldResources []shared.Resource, newResources []shared.Resource) bool {
 	if len(oldResources) != len(newResources) {
 		return true
@@ -0,0 +1,132 @@
+package statecheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+var ErrInvalidCustomStateCheck = errors.New("invalid custom state check")
+
+// CustomStateCheck evaluates the CustomStateCheck rules of a Manifest against the default Module CR. If the
+// Manifest has no default Module CR, the rules are evaluated against the manager resource instead.
+type CustomStateCheck struct{}
+
+func NewCustomStateCheck() *CustomStateCheck {
+	return &CustomStateCheck{}
+}
+
+// GetState returns the state mapped by the matching rules. A state is reached only if all rules mapping to it
+// match. Error takes precedence over Warning, which takes precedence over Ready. If no state is reached, or the
+// target resource does not exist yet, StateProcessing is returned. A Manifest without rules is always StateReady.
+func (c *CustomStateCheck) GetState(ctx context.Context,
+	clnt client.Client,
+	manifest *v1beta2.Manifest,
+) (shared.State, error) {
+	if len(manifest.Spec.CustomStateCheck) == 0 {
+		return shared.StateReady, nil
+	}
+
+	target := getCustomStateCheckTarget(manifest)
+	if target == nil {
+		return shared.StateReady, nil
+	}
+
+	if err := clnt.Get(ctx, client.ObjectKeyFromObject(target), target); err != nil {
+		if util.IsNotFound(err) {
+			return shared.StateProcessing, nil
+		}
+		return shared.StateError, fmt.Errorf("failed to fetch %s for custom state check: %w",
+			target.GetKind(), err)
+	}
+
+	return MapCustomState(manifest.Spec.CustomStateCheck, target)
+}
+
+// MapCustomState maps the given object to a state by evaluating the given rules.
+func MapCustomState(checks []*v1beta2.CustomStateCheck, obj *unstructured.Unstructured) (shared.State, error) {
+	matched := map[shared.State]bool{}
+	for _, check := range checks {
+		if check == nil {
+			continue
+		}
+		found, err := matchesValue(obj, check.JSONPath, check.Value)
+		if err != nil {
+			return shared.StateError, err
+		}
+		// all rules mapping to the same state have to match for the state to be reached
+		if previous, exists := matched[check.MappedState]; exists {
+			matched[check.MappedState] = previous && found
+		} else {
+			matched[check.MappedState] = found
+		}
+	}
+
+	for _, state := range []shared.State{shared.StateError, shared.StateWarning, shared.StateReady} {
+		if matched[state] {
+			return state, nil
+		}
+	}
+	return shared.StateProcessing, nil
+}
+
+func matchesValue(obj *unstructured.Unstructured, path, value string) (bool, error) {
+	parser := jsonpath.New("customStateCheck").AllowMissingKeys(true)
+	if err := parser.Parse(toJSONPathTemplate(path)); err != nil {
+		return false, fmt.Errorf("%w: failed to parse JSONPath %q: %w", ErrInvalidCustomStateCheck, path, err)
+	}
+
+	results, err := parser.FindResults(obj.Object)
+	if err != nil {
+		return false, fmt.Errorf("%w: failed to evaluate JSONPath %q: %w", ErrInvalidCustomStateCheck, path, err)
+	}
+
+	for _, result := range results {
+		for _, field := range result {
+			if field.IsValid() && field.CanInterface() && fmt.Sprint(field.Interface()) == value {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// toJSONPathTemplate accepts both the plain notation (status.state) and the kubectl template
+// notation ({.status.state}) for JSONPaths.
+func toJSONPathTemplate(path string) string {
+	path = strings.TrimSpace(path)
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+	return "{." + strings.TrimPrefix(path, ".") + "}"
+}
+
+func getCustomStateCheckTarget(manifest *v1beta2.Manifest) *unstructured.Unstructured {
+	if manifest.Spec.Resource != nil {
+		target := &unstructured.Unstructured{}
+		target.SetGroupVersionKind(manifest.Spec.Resource.GroupVersionKind())
+		target.SetName(manifest.Spec.Resource.GetName())
+		target.SetNamespace(manifest.Spec.Resource.GetNamespace())
+		return target
+	}
+
+	if mgr := manifest.Spec.Manager; mgr != nil {
+		target := &unstructured.Unstructured{}
+		target.SetGroupVersionKind(schema.GroupVersionKind{Group: mgr.Group, Version: mgr.Version, Kind: mgr.Kind})
+		target.SetName(mgr.Name)
+		target.SetNamespace(mgr.Namespace)
+		return target
+	}
+
+	return nil
+}
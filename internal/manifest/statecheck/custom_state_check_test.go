@@ -0,0 +1,183 @@
+package statecheck_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
+)
+
+const readyConditionPath = `status.conditions[?(@.type=="Ready")].status`
+
+func newModuleCR(conditionStatus string) *unstructured.Unstructured {
+	moduleCR := &unstructured.Unstructured{}
+	moduleCR.SetAPIVersion("operator.kyma-project.io/v1alpha1")
+	moduleCR.SetKind("Sample")
+	moduleCR.SetName("default")
+	moduleCR.SetNamespace("kyma-system")
+	if conditionStatus != "" {
+		_ = unstructured.SetNestedSlice(moduleCR.Object, []any{
+			map[string]any{"type": "Installed", "status": "True"},
+			map[string]any{"type": "Ready", "status": conditionStatus},
+		}, "status", "conditions")
+	}
+	return moduleCR
+}
+
+func readyConditionChecks() []*v1beta2.CustomStateCheck {
+	return []*v1beta2.CustomStateCheck{
+		{JSONPath: readyConditionPath, Value: "True", MappedState: shared.StateReady},
+		{JSONPath: readyConditionPath, Value: "False", MappedState: shared.StateError},
+		{JSONPath: "{.status.phase}", Value: "Degraded", MappedState: shared.StateWarning},
+	}
+}
+
+func TestMapCustomState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		moduleCR *unstructured.Unstructured
+		checks   []*v1beta2.CustomStateCheck
+		expected shared.State
+	}{
+		{
+			name:     "maps matching condition to Ready",
+			moduleCR: newModuleCR("True"),
+			expected: shared.StateReady,
+		},
+		{
+			name:     "maps matching condition to Error",
+			moduleCR: newModuleCR("False"),
+			expected: shared.StateError,
+		},
+		{
+			name:     "returns Processing when no rule matches",
+			moduleCR: newModuleCR("Unknown"),
+			expected: shared.StateProcessing,
+		},
+		{
+			name:     "returns Processing when status is missing",
+			moduleCR: newModuleCR(""),
+			expected: shared.StateProcessing,
+		},
+		{
+			name: "Warning takes precedence over Ready",
+			moduleCR: func() *unstructured.Unstructured {
+				moduleCR := newModuleCR("True")
+				_ = unstructured.SetNestedField(moduleCR.Object, "Degraded", "status", "phase")
+				return moduleCR
+			}(),
+			expected: shared.StateWarning,
+		},
+		{
+			name: "Ready requires all Ready rules to match",
+			moduleCR: func() *unstructured.Unstructured {
+				moduleCR := newModuleCR("True")
+				_ = unstructured.SetNestedField(moduleCR.Object, "Installing", "status", "phase")
+				return moduleCR
+			}(),
+			checks: append(readyConditionChecks(), &v1beta2.CustomStateCheck{
+				JSONPath: "status.phase", Value: "Installed", MappedState: shared.StateReady,
+			}),
+			expected: shared.StateProcessing,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			checks := testCase.checks
+			if checks == nil {
+				checks = readyConditionChecks()
+			}
+			state, err := statecheck.MapCustomState(checks, testCase.moduleCR)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, state)
+		})
+	}
+}
+
+func TestMapCustomState_ReturnsErrorForInvalidJSONPath(t *testing.T) {
+	t.Parallel()
+
+	state, err := statecheck.MapCustomState([]*v1beta2.CustomStateCheck{
+		{JSONPath: "status.conditions[?(@.type==", Value: "True", MappedState: shared.StateReady},
+	}, newModuleCR("True"))
+
+	require.ErrorIs(t, err, statecheck.ErrInvalidCustomStateCheck)
+	assert.Equal(t, shared.StateError, state)
+}
+
+func TestCustomStateCheck_GetState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		manifest *v1beta2.Manifest
+		objects  []*unstructured.Unstructured
+		expected shared.State
+	}{
+		{
+			name:     "returns Ready when no custom state check is defined",
+			manifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{Resource: newModuleCR("")}},
+			expected: shared.StateReady,
+		},
+		{
+			name: "evaluates rules against the default module CR",
+			manifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{
+				Resource:         newModuleCR(""),
+				CustomStateCheck: readyConditionChecks(),
+			}},
+			objects:  []*unstructured.Unstructured{newModuleCR("False")},
+			expected: shared.StateError,
+		},
+		{
+			name: "returns Processing when the default module CR does not exist yet",
+			manifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{
+				Resource:         newModuleCR(""),
+				CustomStateCheck: readyConditionChecks(),
+			}},
+			expected: shared.StateProcessing,
+		},
+		{
+			name: "evaluates rules against the manager when no default module CR is defined",
+			manifest: &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{
+				Manager: &v1beta2.Manager{
+					Group:     "operator.kyma-project.io",
+					Version:   "v1alpha1",
+					Kind:      "Sample",
+					Name:      "default",
+					Namespace: "kyma-system",
+				},
+				CustomStateCheck: readyConditionChecks(),
+			}},
+			objects:  []*unstructured.Unstructured{newModuleCR("True")},
+			expected: shared.StateReady,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := fake.NewClientBuilder()
+			for _, obj := range testCase.objects {
+				builder = builder.WithObjects(obj)
+			}
+
+			state, err := statecheck.NewCustomStateCheck().GetState(t.Context(), builder.Build(), testCase.manifest)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, state)
+		})
+	}
+}
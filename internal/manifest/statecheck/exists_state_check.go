@@ -22,11 +22,11 @@ func (c *ExistsStateCheck) GetState(
 	ctx context.Context,
 	clnt client.Client,
 	resources []client.Object,
-) (shared.State, error) {
+) (shared.State, []shared.ResourceHealth, error) {
 	for _, obj := range resources {
 		if err := clnt.Get(ctx, client.ObjectKeyFromObject(obj), obj); client.IgnoreNotFound(err) != nil {
-			return shared.StateError, fmt.Errorf("failed to fetch object by key: %w", err)
+			return shared.StateError, nil, fmt.Errorf("failed to fetch object by key: %w", err)
 		}
 	}
-	return shared.StateReady, nil
+	return shared.StateReady, nil, nil
 }
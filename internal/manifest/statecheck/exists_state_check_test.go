@@ -30,7 +30,7 @@ func TestExistsStateCheck_ReturnsReadyWhenAllResourcesExist(t *testing.T) {
 	}
 	clnt := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
 
-	state, err := statecheck.NewExistsStateCheck().GetState(t.Context(), clnt,
+	state, _, err := statecheck.NewExistsStateCheck().GetState(t.Context(), clnt,
 		[]client.Object{&apicorev1.ConfigMap{ObjectMeta: apimetav1.ObjectMeta{Name: "cm", Namespace: "default"}}})
 
 	require.NoError(t, err)
@@ -44,7 +44,7 @@ func TestExistsStateCheck_TolerantToNotFoundResources(t *testing.T) {
 	require.NoError(t, apicorev1.AddToScheme(scheme))
 	clnt := fake.NewClientBuilder().WithScheme(scheme).Build()
 
-	state, err := statecheck.NewExistsStateCheck().GetState(t.Context(), clnt,
+	state, _, err := statecheck.NewExistsStateCheck().GetState(t.Context(), clnt,
 		[]client.Object{&apicorev1.ConfigMap{ObjectMeta: apimetav1.ObjectMeta{Name: "missing", Namespace: "default"}}})
 
 	require.NoError(t, err, "missing resources are tolerated via IgnoreNotFound")
@@ -66,7 +66,7 @@ func TestExistsStateCheck_PropagatesNonNotFoundError(t *testing.T) {
 		},
 	}).Build()
 
-	state, err := statecheck.NewExistsStateCheck().GetState(t.Context(), clnt,
+	state, _, err := statecheck.NewExistsStateCheck().GetState(t.Context(), clnt,
 		[]client.Object{&apicorev1.ConfigMap{ObjectMeta: apimetav1.ObjectMeta{Name: "cm", Namespace: "default"}}})
 
 	require.Error(t, err)
@@ -0,0 +1,159 @@
+package statecheck
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+// Health is the result of evaluating a single resource.
+type Health struct {
+	State   shared.State
+	Message string
+}
+
+// HealthEvaluator evaluates the health of a single resource. It returns nil if it does not handle the resource,
+// so that the next evaluator is consulted.
+type HealthEvaluator interface {
+	Evaluate(ctx context.Context, clnt client.Client, obj *unstructured.Unstructured) (*Health, error)
+}
+
+// HealthCheck determines the state of a module by evaluating the health of all of its resources.
+type HealthCheck struct {
+	evaluators []HealthEvaluator
+	maxEntries int
+}
+
+// NewHealthCheck creates a HealthCheck consulting the evaluators in the order provided. The first evaluator
+// handling a resource determines its health. At most maxEntries resources are reported with their health.
+func NewHealthCheck(maxEntries int, evaluators ...HealthEvaluator) *HealthCheck {
+	return &HealthCheck{evaluators: evaluators, maxEntries: maxEntries}
+}
+
+// NewDefaultHealthCheck creates a HealthCheck evaluating Deployments, StatefulSets, DaemonSets, Jobs,
+// CustomResourceDefinitions, LoadBalancer Services, PersistentVolumeClaims and, as a fallback, the Ready
+// condition of any other resource.
+func NewDefaultHealthCheck(statefulSetChecker StatefulSetStateChecker,
+	deploymentChecker DeploymentStateChecker,
+	maxEntries int,
+) *HealthCheck {
+	return NewHealthCheck(maxEntries,
+		&DeploymentHealthEvaluator{checker: deploymentChecker},
+		&StatefulSetHealthEvaluator{checker: statefulSetChecker},
+		&DaemonSetHealthEvaluator{},
+		&JobHealthEvaluator{},
+		&CRDHealthEvaluator{},
+		&ServiceHealthEvaluator{},
+		&PVCHealthEvaluator{},
+		&ReadyConditionHealthEvaluator{},
+	)
+}
+
+// GetState returns the least healthy state of all evaluated resources together with their health, the least
+// healthy resources first and bounded by the configured maximum. Error takes precedence over Warning, which takes
+// precedence over Processing. Resources not handled by any evaluator are considered Ready and are not part of the
+// returned health.
+func (h *HealthCheck) GetState(ctx context.Context,
+	clnt client.Client,
+	resources []client.Object,
+) (shared.State, []shared.ResourceHealth, error) {
+	state := shared.StateReady
+	var health []shared.ResourceHealth
+	for _, resource := range resources {
+		obj, err := toUnstructured(clnt.Scheme(), resource)
+		if err != nil {
+			return shared.StateError, nil, err
+		}
+		resourceHealth, err := h.evaluate(ctx, clnt, obj)
+		if err != nil {
+			return shared.StateError, nil, err
+		}
+		if resourceHealth == nil {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		health = append(health, shared.ResourceHealth{
+			Resource: shared.Resource{
+				GroupVersionKind: apimetav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+				Name:             obj.GetName(),
+				Namespace:        obj.GetNamespace(),
+			},
+			State:   resourceHealth.State,
+			Message: resourceHealth.Message,
+		})
+		state = leastHealthy(state, resourceHealth.State)
+	}
+
+	slices.SortStableFunc(health, func(a, b shared.ResourceHealth) int {
+		return cmp.Compare(healthRank(a.State), healthRank(b.State))
+	})
+	if len(health) > h.maxEntries {
+		health = health[:h.maxEntries]
+	}
+	return state, health, nil
+}
+
+func (h *HealthCheck) evaluate(ctx context.Context, clnt client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	for _, evaluator := range h.evaluators {
+		health, err := evaluator.Evaluate(ctx, clnt, obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate health of %s %s: %w",
+				obj.GetKind(), client.ObjectKeyFromObject(obj), err)
+		}
+		if health != nil {
+			return health, nil
+		}
+	}
+	return nil, nil //nolint:nilnil // no evaluator handles the resource
+}
+
+// unhealthyStates lists the states that take precedence over Ready, the least healthy first.
+var unhealthyStates = []shared.State{shared.StateError, shared.StateWarning, shared.StateProcessing}
+
+func leastHealthy(current, candidate shared.State) shared.State {
+	for _, state := range unhealthyStates {
+		if current == state || candidate == state {
+			return state
+		}
+	}
+	return shared.StateReady
+}
+
+// healthRank orders the states from the least healthy to Ready.
+func healthRank(state shared.State) int {
+	if rank := slices.Index(unhealthyStates, state); rank >= 0 {
+		return rank
+	}
+	return len(unhealthyStates)
+}
+
+func toUnstructured(scheme *machineryruntime.Scheme, obj client.Object) (*unstructured.Unstructured, error) {
+	if unstructuredObj, ok := obj.(*unstructured.Unstructured); ok {
+		return unstructuredObj, nil
+	}
+	content, err := machineryruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to unstructured: %w", obj.GetName(), err)
+	}
+	unstructuredObj := &unstructured.Unstructured{Object: content}
+	// typed objects do not necessarily carry their type meta
+	if unstructuredObj.GetKind() == "" {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+		}
+		unstructuredObj.SetGroupVersionKind(gvk)
+	}
+	return unstructuredObj, nil
+}
@@ -0,0 +1,350 @@
+package statecheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiappsv1 "k8s.io/api/apps/v1"
+	apibatchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
+)
+
+const maxHealthEntries = 20
+
+func newDaemonSet(desired, available int32) *apiappsv1.DaemonSet {
+	return &apiappsv1.DaemonSet{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "node-agent", Namespace: "kyma-system", Generation: 1},
+		Spec: apiappsv1.DaemonSetSpec{
+			Selector: &apimetav1.LabelSelector{MatchLabels: map[string]string{"app": "node-agent"}},
+		},
+		Status: apiappsv1.DaemonSetStatus{
+			ObservedGeneration:     1,
+			DesiredNumberScheduled: desired,
+			UpdatedNumberScheduled: desired,
+			NumberAvailable:        available,
+		},
+	}
+}
+
+func newAgentPod(waitingReason string) *apicorev1.Pod {
+	return &apicorev1.Pod{
+		ObjectMeta: apimetav1.ObjectMeta{
+			Name: "node-agent-x2k4v", Namespace: "kyma-system", Labels: map[string]string{"app": "node-agent"},
+		},
+		Status: apicorev1.PodStatus{
+			ContainerStatuses: []apicorev1.ContainerStatus{{
+				Name:  "agent",
+				State: apicorev1.ContainerState{Waiting: &apicorev1.ContainerStateWaiting{Reason: waitingReason}},
+			}},
+		},
+	}
+}
+
+func newJob(conditionType apibatchv1.JobConditionType) *apibatchv1.Job {
+	job := &apibatchv1.Job{ObjectMeta: apimetav1.ObjectMeta{Name: "migration", Namespace: "kyma-system"}}
+	if conditionType != "" {
+		job.Status.Conditions = []apibatchv1.JobCondition{
+			{Type: conditionType, Status: apicorev1.ConditionTrue, Message: "BackoffLimitExceeded"},
+		}
+	}
+	return job
+}
+
+func newCRD(established apiextensionsv1.ConditionStatus) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "samples.operator.kyma-project.io"},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{
+				{Type: apiextensionsv1.Established, Status: established},
+			},
+		},
+	}
+}
+
+func newLoadBalancer(ingress ...apicorev1.LoadBalancerIngress) *apicorev1.Service {
+	return &apicorev1.Service{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "gateway", Namespace: "kyma-system"},
+		Spec:       apicorev1.ServiceSpec{Type: apicorev1.ServiceTypeLoadBalancer},
+		Status: apicorev1.ServiceStatus{
+			LoadBalancer: apicorev1.LoadBalancerStatus{Ingress: ingress},
+		},
+	}
+}
+
+func newPVC(phase apicorev1.PersistentVolumeClaimPhase) *apicorev1.PersistentVolumeClaim {
+	return &apicorev1.PersistentVolumeClaim{
+		ObjectMeta: apimetav1.ObjectMeta{Name: "data", Namespace: "kyma-system"},
+		Status:     apicorev1.PersistentVolumeClaimStatus{Phase: phase},
+	}
+}
+
+func newCustomResource(conditions ...map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("operator.kyma-project.io/v1alpha1")
+	obj.SetKind("Sample")
+	obj.SetName("default")
+	obj.SetNamespace("kyma-system")
+	items := make([]any, 0, len(conditions))
+	for _, condition := range conditions {
+		items = append(items, condition)
+	}
+	_ = unstructured.SetNestedSlice(obj.Object, items, "status", "conditions")
+	return obj
+}
+
+func newCondition(conditionType, status, reason string) map[string]any {
+	return map[string]any{"type": conditionType, "status": status, "reason": reason, "message": "reconciling"}
+}
+
+func TestHealthCheck_GetState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resources []client.Object
+		pods      []client.Object
+		expected  shared.State
+	}{
+		{
+			name:      "DaemonSet with all pods available is Ready",
+			resources: []client.Object{newDaemonSet(3, 3)},
+			expected:  shared.StateReady,
+		},
+		{
+			name:      "DaemonSet rolling out is Processing",
+			resources: []client.Object{newDaemonSet(3, 1)},
+			pods:      []client.Object{newAgentPod("ContainerCreating")},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name:      "DaemonSet with crash-looping pods is in Error",
+			resources: []client.Object{newDaemonSet(3, 0)},
+			pods:      []client.Object{newAgentPod("CrashLoopBackOff")},
+			expected:  shared.StateError,
+		},
+		{
+			name:      "completed Job is Ready",
+			resources: []client.Object{newJob(apibatchv1.JobComplete)},
+			expected:  shared.StateReady,
+		},
+		{
+			name:      "running Job is Processing",
+			resources: []client.Object{newJob("")},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name:      "failed Job is in Error",
+			resources: []client.Object{newJob(apibatchv1.JobFailed)},
+			expected:  shared.StateError,
+		},
+		{
+			name:      "established CRD is Ready",
+			resources: []client.Object{newCRD(apiextensionsv1.ConditionTrue)},
+			expected:  shared.StateReady,
+		},
+		{
+			name:      "CRD not yet established is Processing",
+			resources: []client.Object{newCRD(apiextensionsv1.ConditionFalse)},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name:      "LoadBalancer without ingress is Processing",
+			resources: []client.Object{newLoadBalancer()},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name:      "LoadBalancer with ingress is Ready",
+			resources: []client.Object{newLoadBalancer(apicorev1.LoadBalancerIngress{IP: "10.0.0.1"})},
+			expected:  shared.StateReady,
+		},
+		{
+			name:      "pending PVC is Processing",
+			resources: []client.Object{newPVC(apicorev1.ClaimPending)},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name:      "lost PVC is in Error",
+			resources: []client.Object{newPVC(apicorev1.ClaimLost)},
+			expected:  shared.StateError,
+		},
+		{
+			name:      "custom resource with Ready condition True is Ready",
+			resources: []client.Object{newCustomResource(newCondition("Ready", "True", "Ready"))},
+			expected:  shared.StateReady,
+		},
+		{
+			name:      "custom resource with Ready condition False is Processing",
+			resources: []client.Object{newCustomResource(newCondition("Ready", "False", "Reconciling"))},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name:      "custom resource with Ready condition False for a failure is Processing",
+			resources: []client.Object{newCustomResource(newCondition("Ready", "False", "InstallFailed"))},
+			expected:  shared.StateProcessing,
+		},
+		{
+			name: "custom resource with Error condition True is in Error",
+			resources: []client.Object{newCustomResource(newCondition("Ready", "False", "Reconciling"),
+				newCondition("Error", "True", "InstallFailed"))},
+			expected: shared.StateError,
+		},
+		{
+			name: "custom resource with Failed condition True is in Error",
+			resources: []client.Object{newCustomResource(newCondition("Ready", "Unknown", "Reconciling"),
+				newCondition("Failed", "True", "BackoffLimitExceeded"))},
+			expected: shared.StateError,
+		},
+		{
+			name:      "Error takes precedence over Processing",
+			resources: []client.Object{newJob(""), newPVC(apicorev1.ClaimBound), newJob(apibatchv1.JobFailed)},
+			expected:  shared.StateError,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, health, err := statecheck.NewDefaultHealthCheck(&StatefulSetStateCheckerStub{},
+				&DeploymentStateCheckerStub{}, maxHealthEntries).GetState(t.Context(), newHealthCheckClient(t, testCase.pods...),
+				testCase.resources)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, state)
+			require.Len(t, health, len(testCase.resources))
+		})
+	}
+}
+
+func TestHealthCheck_GetState_ReportsResourceHealth(t *testing.T) {
+	t.Parallel()
+
+	resources := []client.Object{
+		newDaemonSet(2, 0),
+		&apicorev1.ConfigMap{ObjectMeta: apimetav1.ObjectMeta{Name: "config", Namespace: "kyma-system"}},
+		&apicorev1.Service{ObjectMeta: apimetav1.ObjectMeta{Name: "webhook", Namespace: "kyma-system"}},
+		newCRD(apiextensionsv1.ConditionTrue),
+	}
+	clnt := newHealthCheckClient(t, newAgentPod("ImagePullBackOff"))
+
+	state, health, err := statecheck.NewDefaultHealthCheck(&StatefulSetStateCheckerStub{},
+		&DeploymentStateCheckerStub{}, maxHealthEntries).GetState(t.Context(), clnt, resources)
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateError, state)
+	assert.Equal(t, []shared.ResourceHealth{
+		{
+			Resource: shared.Resource{
+				GroupVersionKind: apimetav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"},
+				Name:             "node-agent",
+				Namespace:        "kyma-system",
+			},
+			State:   shared.StateError,
+			Message: "container agent of pod node-agent-x2k4v is in ImagePullBackOff",
+		},
+		{
+			Resource: shared.Resource{
+				GroupVersionKind: apimetav1.GroupVersionKind{
+					Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition",
+				},
+				Name: "samples.operator.kyma-project.io",
+			},
+			State: shared.StateReady,
+		},
+	}, health)
+}
+
+func TestHealthCheck_GetState_BoundsHealthToLeastHealthyResources(t *testing.T) {
+	t.Parallel()
+
+	resources := []client.Object{
+		newCRD(apiextensionsv1.ConditionTrue),
+		newPVC(apicorev1.ClaimPending),
+		newJob(apibatchv1.JobFailed),
+	}
+
+	state, health, err := statecheck.NewDefaultHealthCheck(&StatefulSetStateCheckerStub{},
+		&DeploymentStateCheckerStub{}, 2).GetState(t.Context(), newHealthCheckClient(t), resources)
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateError, state)
+	require.Len(t, health, 2)
+	assert.Equal(t, "Job", health[0].Kind)
+	assert.Equal(t, shared.StateError, health[0].State)
+	assert.Equal(t, "PersistentVolumeClaim", health[1].Kind)
+	assert.Equal(t, shared.StateProcessing, health[1].State)
+}
+
+func TestHealthCheck_GetState_UsesDeploymentChecker(t *testing.T) {
+	t.Parallel()
+
+	deploymentChecker := &DeploymentStateCheckerStub{}
+	healthCheck := statecheck.NewDefaultHealthCheck(&StatefulSetStateCheckerStub{}, deploymentChecker,
+		maxHealthEntries)
+	state, health, err := healthCheck.GetState(t.Context(), newHealthCheckClient(t), []client.Object{
+		&apiappsv1.Deployment{ObjectMeta: apimetav1.ObjectMeta{Name: "manager", Namespace: "kyma-system"}},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, deploymentChecker.called)
+	assert.Equal(t, shared.StateProcessing, state)
+	require.Len(t, health, 1)
+	assert.Equal(t, "deployment rollout is in progress", health[0].Message)
+}
+
+func TestHealthCheck_GetState_UsesStatefulSetChecker(t *testing.T) {
+	t.Parallel()
+
+	statefulSetChecker := &StatefulSetStateCheckerStub{}
+	healthCheck := statecheck.NewDefaultHealthCheck(statefulSetChecker, &DeploymentStateCheckerStub{},
+		maxHealthEntries)
+	state, health, err := healthCheck.GetState(t.Context(), newHealthCheckClient(t), []client.Object{
+		&apiappsv1.StatefulSet{ObjectMeta: apimetav1.ObjectMeta{Name: "manager", Namespace: "kyma-system"}},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, statefulSetChecker.called)
+	assert.Equal(t, shared.StateReady, state)
+	require.Len(t, health, 1)
+}
+
+func newHealthCheckClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, apicorev1.AddToScheme(scheme))
+	require.NoError(t, apiappsv1.AddToScheme(scheme))
+	require.NoError(t, apibatchv1.AddToScheme(scheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+type DeploymentStateCheckerStub struct {
+	called bool
+}
+
+func (d *DeploymentStateCheckerStub) GetState(_ *apiappsv1.Deployment) (shared.State, error) {
+	d.called = true
+	return shared.StateProcessing, nil
+}
+
+type StatefulSetStateCheckerStub struct {
+	called bool
+}
+
+func (s *StatefulSetStateCheckerStub) GetState(_ context.Context, _ client.Client,
+	_ *apiappsv1.StatefulSet,
+) (shared.State, error) {
+	s.called = true
+	return shared.StateReady, nil
+}
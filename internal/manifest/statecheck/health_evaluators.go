@@ -0,0 +1,295 @@
+package statecheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apiappsv1 "k8s.io/api/apps/v1"
+	apibatchv1 "k8s.io/api/batch/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+)
+
+var (
+	deploymentKind  = apiappsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind()
+	statefulSetKind = apiappsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind()
+	daemonSetKind   = apiappsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind()
+	jobKind         = apibatchv1.SchemeGroupVersion.WithKind("Job").GroupKind()
+	crdKind         = apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition").GroupKind()
+	serviceKind     = apicorev1.SchemeGroupVersion.WithKind("Service").GroupKind()
+	pvcKind         = apicorev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim").GroupKind()
+)
+
+// failingContainerReasons are the waiting reasons of containers that do not recover without intervention.
+var failingContainerReasons = []string{
+	"CrashLoopBackOff", "ImagePullBackOff", "ErrImagePull", "CreateContainerConfigError",
+}
+
+// DeploymentHealthEvaluator evaluates Deployments by their Progressing and Available conditions.
+type DeploymentHealthEvaluator struct {
+	checker DeploymentStateChecker
+}
+
+func (e *DeploymentHealthEvaluator) Evaluate(_ context.Context, _ client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, deploymentKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	deploy := &apiappsv1.Deployment{}
+	if err := fromUnstructured(obj, deploy); err != nil {
+		return nil, err
+	}
+	state, err := e.checker.GetState(deploy)
+	if err != nil {
+		return nil, err
+	}
+	return healthWithMessage(state, "deployment rollout is in progress", "deployment is not available"), nil
+}
+
+// StatefulSetHealthEvaluator evaluates StatefulSets by their ready replicas and the state of their pods.
+type StatefulSetHealthEvaluator struct {
+	checker StatefulSetStateChecker
+}
+
+func (e *StatefulSetHealthEvaluator) Evaluate(ctx context.Context, clnt client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, statefulSetKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	statefulSet := &apiappsv1.StatefulSet{}
+	if err := fromUnstructured(obj, statefulSet); err != nil {
+		return nil, err
+	}
+	state, err := e.checker.GetState(ctx, clnt, statefulSet)
+	if err != nil {
+		return nil, err
+	}
+	return healthWithMessage(state, "statefulset pods are not ready yet", "statefulset pods failed to start"), nil
+}
+
+// DaemonSetHealthEvaluator evaluates DaemonSets by their rollout progress. A DaemonSet with pods failing to
+// start, for example because of a crash loop, is reported with StateError.
+type DaemonSetHealthEvaluator struct{}
+
+func (e *DaemonSetHealthEvaluator) Evaluate(ctx context.Context, clnt client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, daemonSetKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	daemonSet := &apiappsv1.DaemonSet{}
+	if err := fromUnstructured(obj, daemonSet); err != nil {
+		return nil, err
+	}
+
+	dsStatus := daemonSet.Status
+	if dsStatus.ObservedGeneration >= daemonSet.Generation &&
+		dsStatus.UpdatedNumberScheduled >= dsStatus.DesiredNumberScheduled &&
+		dsStatus.NumberAvailable >= dsStatus.DesiredNumberScheduled {
+		return &Health{State: shared.StateReady}, nil
+	}
+
+	if daemonSet.Spec.Selector != nil {
+		podList, err := getPodsList(ctx, clnt, daemonSet.Namespace, daemonSet.Spec.Selector.MatchLabels)
+		if err != nil {
+			return nil, err
+		}
+		if message, failing := findFailingPod(podList); failing {
+			return &Health{State: shared.StateError, Message: message}, nil
+		}
+	}
+	return &Health{
+		State: shared.StateProcessing,
+		Message: fmt.Sprintf("%d of %d pods updated, %d available", dsStatus.UpdatedNumberScheduled,
+			dsStatus.DesiredNumberScheduled, dsStatus.NumberAvailable),
+	}, nil
+}
+
+// JobHealthEvaluator evaluates Jobs by their Complete and Failed conditions.
+type JobHealthEvaluator struct{}
+
+func (e *JobHealthEvaluator) Evaluate(_ context.Context, _ client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, jobKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	job := &apibatchv1.Job{}
+	if err := fromUnstructured(obj, job); err != nil {
+		return nil, err
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != apicorev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case apibatchv1.JobComplete:
+			return &Health{State: shared.StateReady}, nil
+		case apibatchv1.JobFailed:
+			return &Health{State: shared.StateError, Message: "job failed: " + condition.Message}, nil
+		default:
+		}
+	}
+	return &Health{State: shared.StateProcessing, Message: "job has not completed yet"}, nil
+}
+
+// CRDHealthEvaluator evaluates CustomResourceDefinitions by their Established and NamesAccepted conditions.
+type CRDHealthEvaluator struct{}
+
+func (e *CRDHealthEvaluator) Evaluate(_ context.Context, _ client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, crdKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := fromUnstructured(obj, crd); err != nil {
+		return nil, err
+	}
+	for _, condition := range crd.Status.Conditions {
+		switch {
+		case condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue:
+			return &Health{State: shared.StateReady}, nil
+		case condition.Type == apiextensionsv1.NamesAccepted && condition.Status == apiextensionsv1.ConditionFalse:
+			return &Health{State: shared.StateError, Message: "names not accepted: " + condition.Message}, nil
+		}
+	}
+	return &Health{State: shared.StateProcessing, Message: "custom resource definition is not established yet"}, nil
+}
+
+// ServiceHealthEvaluator evaluates Services of type LoadBalancer by their assigned ingress points.
+// Services of other types are not handled.
+type ServiceHealthEvaluator struct{}
+
+func (e *ServiceHealthEvaluator) Evaluate(_ context.Context, _ client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, serviceKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	service := &apicorev1.Service{}
+	if err := fromUnstructured(obj, service); err != nil {
+		return nil, err
+	}
+	if service.Spec.Type != apicorev1.ServiceTypeLoadBalancer {
+		return nil, nil //nolint:nilnil // only load balancers have to become ready
+	}
+	if len(service.Status.LoadBalancer.Ingress) == 0 {
+		return &Health{State: shared.StateProcessing, Message: "waiting for load balancer ingress"}, nil
+	}
+	return &Health{State: shared.StateReady}, nil
+}
+
+// PVCHealthEvaluator evaluates PersistentVolumeClaims by their phase.
+type PVCHealthEvaluator struct{}
+
+func (e *PVCHealthEvaluator) Evaluate(_ context.Context, _ client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	if !isKind(obj, pvcKind) {
+		return nil, nil //nolint:nilnil // not handled
+	}
+	pvc := &apicorev1.PersistentVolumeClaim{}
+	if err := fromUnstructured(obj, pvc); err != nil {
+		return nil, err
+	}
+	switch pvc.Status.Phase {
+	case apicorev1.ClaimBound:
+		return &Health{State: shared.StateReady}, nil
+	case apicorev1.ClaimLost:
+		return &Health{State: shared.StateError, Message: "persistent volume claim lost its volume"}, nil
+	case apicorev1.ClaimPending:
+	}
+	return &Health{State: shared.StateProcessing, Message: "persistent volume claim is not bound yet"}, nil
+}
+
+// ReadyConditionHealthEvaluator evaluates any resource reporting a Ready condition in status.conditions.
+// A resource is in Error only if it reports a Failed or Error condition that is True, as a Ready condition that is
+// not True may just mean that the resource is still reconciled. Resources without a Ready condition are not handled.
+type ReadyConditionHealthEvaluator struct{}
+
+func (e *ReadyConditionHealthEvaluator) Evaluate(_ context.Context, _ client.Client,
+	obj *unstructured.Unstructured,
+) (*Health, error) {
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, nil //nolint:nilerr,nilnil // resources without conditions are not handled
+	}
+	var ready map[string]any
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch condition["type"] {
+		case "Failed", "Error":
+			if condition["status"] == string(apicorev1.ConditionTrue) {
+				return &Health{State: shared.StateError, Message: conditionMessage(condition)}, nil
+			}
+		case "Ready":
+			ready = condition
+		}
+	}
+	if ready == nil {
+		return nil, nil //nolint:nilnil // resources without Ready condition are not handled
+	}
+	if ready["status"] == string(apicorev1.ConditionTrue) {
+		return &Health{State: shared.StateReady}, nil
+	}
+	message, _ := ready["message"].(string)
+	return &Health{State: shared.StateProcessing, Message: message}, nil
+}
+
+// conditionMessage returns the message of the condition, or its reason if it has no message.
+func conditionMessage(condition map[string]any) string {
+	if message, _ := condition["message"].(string); message != "" {
+		return message
+	}
+	reason, _ := condition["reason"].(string)
+	return reason
+}
+
+func findFailingPod(podList *apicorev1.PodList) (string, bool) {
+	for _, pod := range podList.Items {
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, containerStatus := range statuses {
+			waiting := containerStatus.State.Waiting
+			if waiting != nil && slices.Contains(failingContainerReasons, waiting.Reason) {
+				return fmt.Sprintf("container %s of pod %s is in %s", containerStatus.Name, pod.Name,
+					waiting.Reason), true
+			}
+		}
+	}
+	return "", false
+}
+
+func healthWithMessage(state shared.State, processingMessage, errorMessage string) *Health {
+	switch state {
+	case shared.StateProcessing:
+		return &Health{State: state, Message: processingMessage}
+	case shared.StateError:
+		return &Health{State: state, Message: errorMessage}
+	default:
+		return &Health{State: state}
+	}
+}
+
+func isKind(obj *unstructured.Unstructured, groupKind schema.GroupKind) bool {
+	return obj.GroupVersionKind().GroupKind() == groupKind
+}
+
+func fromUnstructured(obj *unstructured.Unstructured, target any) error {
+	if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, target); err != nil {
+		return fmt.Errorf("failed to convert %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package status
 
 import (
+	"fmt"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/api/meta"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -14,6 +17,7 @@ const (
 	ConditionTypeResources    ConditionType = "Resources"
 	ConditionTypeModuleCR     ConditionType = "ModuleCR"
 	ConditionTypeInstallation ConditionType = "Installation"
+	ConditionTypeDrift        ConditionType = "Drift"
 )
 
 type ConditionReason string
@@ -22,6 +26,8 @@ const (
 	ConditionReasonResourcesAreAvailable ConditionReason = "ResourcesAvailable"
 	ConditionReasonModuleCRCreated       ConditionReason = "ModuleCRCreated"
 	ConditionReasonReady                 ConditionReason = "Ready"
+	ConditionReasonUnknownFieldManagers  ConditionReason = "UnknownFieldManagers"
+	ConditionReasonNoUnknownManagers     ConditionReason = "NoUnknownFieldManagers"
 )
 
 func InitializeStatusConditions(manifest *v1beta2.Manifest) {
@@ -107,3 +113,24 @@ func setConditionToTrue(manifest *v1beta2.Manifest, conditionType ConditionType,
 		manifest.SetStatus(status.WithOperation(condition.Message))
 	}
 }
+
+// SetDriftCondition sets the Drift condition to True if resources of the manifest have fields managed by the given
+// unknown field managers, including the resources reverted in the last apply, and to False otherwise.
+func SetDriftCondition(manifest *v1beta2.Manifest, driftedResources, revertedResources int, managers []string) {
+	status := manifest.GetStatus()
+	condition := apimetav1.Condition{
+		Type:               string(ConditionTypeDrift),
+		Reason:             string(ConditionReasonNoUnknownManagers),
+		Status:             apimetav1.ConditionFalse,
+		Message:            "no resources have fields managed by unknown field managers",
+		ObservedGeneration: manifest.GetGeneration(),
+	}
+	if driftedResources > 0 {
+		condition.Reason = string(ConditionReasonUnknownFieldManagers)
+		condition.Status = apimetav1.ConditionTrue
+		condition.Message = fmt.Sprintf("%d resources changed by unknown field managers, %d reverted: %s",
+			driftedResources, revertedResources, strings.Join(managers, ", "))
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	manifest.SetStatus(status)
+}
@@ -275,3 +275,29 @@ func testSetConditionTrue(
 		require.Equal(t, expectedOperation, manifest.GetStatus().LastOperation.Operation)
 	})
 }
+
+func TestSetDriftCondition_WithDriftedResources_SetsConditionTrue(t *testing.T) {
+	manifest := &v1beta2.Manifest{}
+	manifest.SetGeneration(2)
+
+	status.SetDriftCondition(manifest, 3, 1, []string{"helm", "kubectl-edit"})
+
+	drift := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeDrift))
+	require.NotNil(t, drift)
+	require.Equal(t, apimetav1.ConditionTrue, drift.Status)
+	require.Equal(t, string(status.ConditionReasonUnknownFieldManagers), drift.Reason)
+	require.Equal(t, "3 resources changed by unknown field managers, 1 reverted: helm, kubectl-edit", drift.Message)
+	require.Equal(t, manifest.GetGeneration(), drift.ObservedGeneration)
+}
+
+func TestSetDriftCondition_WithoutDriftedResources_SetsConditionFalse(t *testing.T) {
+	manifest := &v1beta2.Manifest{}
+	status.SetDriftCondition(manifest, 1, 0, []string{"kubectl-edit"})
+
+	status.SetDriftCondition(manifest, 0, 0, nil)
+
+	drift := meta.FindStatusCondition(manifest.GetStatus().Conditions, string(status.ConditionTypeDrift))
+	require.NotNil(t, drift)
+	require.Equal(t, apimetav1.ConditionFalse, drift.Status)
+	require.Equal(t, string(status.ConditionReasonNoUnknownManagers), drift.Reason)
+}
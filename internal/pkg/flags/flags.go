@@ -4,12 +4,15 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	gcertv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/internal/common"
@@ -31,6 +34,7 @@ const (
 	DefaultMandatoryModuleDeletionRequeueSuccessInterval                = 30 * time.Second
 	DefaultWatcherRequeueSuccessInterval                                = 1 * time.Minute
 	DefaultModuleUpgradeRolloutMaxDelay                                 = 5 * time.Minute
+	DefaultModuleRolloutCheckInterval                                   = 1 * time.Minute
 	DefaultClientQPS                                                    = 1000
 	DefaultClientBurst                                                  = 2000
 	DefaultSkrClientQPS                                                 = 50
@@ -72,9 +76,20 @@ const (
 	DefaultDropCrdStoredVersionMap                                      = "Manifest:v1beta1,Watcher:v1beta1,ModuleTemplate:v1beta1,Kyma:v1beta1" //nolint:revive // keep it readible
 	DefaultMetricsCleanupIntervalInMinutes                              = 15
 	DefaultMinMaintenanceWindowSize                                     = 20 * time.Minute
+	DefaultMaintenancePolicyReloadInterval                              = 1 * time.Minute
 	DefaultLeaderElectionLeaseDuration                                  = 180 * time.Second
 	DefaultLeaderElectionRenewDeadline                                  = 120 * time.Second
 	DefaultLeaderElectionRetryPeriod                                    = 3 * time.Second
+	DefaultLayerCacheDirectoryName                                      = "klm-layer-cache"
+	DefaultLayerCacheMaxSize                                            = "2Gi"
+	DefaultDescriptorCacheMaxEntries                                    = 1000
+	DefaultDescriptorCacheTTL                                           = 24 * time.Hour
+	DefaultOciRegistryMirrorTimeout                                     = 30 * time.Second
+	DefaultOciRegistryMirrorCooldown                                    = 1 * time.Minute
+	DefaultDriftReportMaxEntries                                        = 20
+	DefaultHealthReportMaxEntries                                       = 20
+	DefaultDriftKnownFieldManagers                                      = "declarative.kyma-project.io/applier," +
+		"lifecycle-manager,k3s,kube-controller-manager"
 )
 
 // variation of the regex defined in api/v1beta2/moduletemplate_types.go.
@@ -100,6 +115,20 @@ var (
 	ErrInvalidManifestRequeueJitterProbability = errors.New(
 		"invalid manifest requeue jitter probability: must be between 0 and 1",
 	)
+	ErrInvalidLayerCacheMaxSize         = errors.New("invalid layer-cache-max-size: must be a non-negative quantity")
+	ErrInvalidDescriptorCacheMaxEntries = errors.New("invalid descriptor-cache-max-entries: must not be negative")
+	ErrInvalidDescriptorCacheTTL        = errors.New("invalid descriptor-cache-ttl: must not be negative")
+	ErrInvalidOciRegistryMirrors        = errors.New(
+		"invalid oci-registry-mirrors: must be a comma-separated list of 'registry' or 'registry=secret-name' entries",
+	)
+	ErrOciLayoutDirWithMirrors           = errors.New("oci-layout-dir and oci-registry-mirrors cannot be used together")
+	ErrInvalidDriftReportMaxEntries      = errors.New("invalid drift-report-max-entries: must not be negative")
+	ErrInvalidHealthReportMaxEntries     = errors.New("invalid health-report-max-entries: must not be negative")
+	ErrInvalidModuleRolloutCheckInterval = errors.New("invalid module-rollout-check-interval: must be positive")
+
+	ErrInvalidMaintenancePolicyReloadInterval = errors.New(
+		"invalid maintenance-policy-reload-interval: must be positive",
+	)
 )
 
 //nolint:funlen // defines all program flags
@@ -165,6 +194,10 @@ func DefineFlagVar() *FlagVar {
 		"Maximum random delay added when requeueing Kyma CRs after a new module version is "+
 			"assigned to a channel in a ModuleReleaseMeta. Spreads reconciliations over time to avoid "+
 			"rate-limiting bursts. Set to `0` to disable spreading.")
+	flag.DurationVar(&flagVar.ModuleRolloutCheckInterval, "module-rollout-check-interval",
+		DefaultModuleRolloutCheckInterval,
+		"Interval in which the health of the modules upgraded by a wave-based rollout is checked to promote or "+
+			"pause the rollout.")
 	flag.DurationVar(&flagVar.MandatoryModuleRequeueSuccessInterval, "mandatory-module-requeue-success-interval",
 		DefaultMandatoryModuleRequeueSuccessInterval,
 		"Duration after which a Kyma in Ready state is enqueued for mandatory module installation reconciliation.")
@@ -293,6 +326,9 @@ func DefineFlagVar() *FlagVar {
 	flag.DurationVar(&flagVar.MinMaintenanceWindowSize, "min-maintenance-window-size",
 		DefaultMinMaintenanceWindowSize,
 		"Minimum duration of maintenance window required for reconciling modules with downtime.")
+	flag.DurationVar(&flagVar.MaintenancePolicyReloadInterval, "maintenance-policy-reload-interval",
+		DefaultMaintenancePolicyReloadInterval,
+		"Interval at which the maintenance window policy file is checked for changes and read again.")
 	flag.StringVar(&flagVar.OciRegistryCredSecretName, "oci-registry-cred-secret", "",
 		"Allows to configure the name of the Secret containing the credentials for "+
 			"the OCI registry storing the OCM component versions of modules. "+
@@ -305,6 +341,59 @@ func DefineFlagVar() *FlagVar {
 			"Must not be set together with --oci-registry-cred-secret. "+
 			"If the OCI registry requires authentication, the --oci-registry-cred-secret flag must be used instead.",
 	)
+	flag.StringVar(&flagVar.DescriptorSignatureSecret, "descriptor-signature-secret", "",
+		"Allows to configure the name of the Secret containing the public keys or certificates, PEM encoded "+
+			"and keyed by signature name, that the OCM component descriptors of modules must be signed with. "+
+			"If set, modules whose descriptor has no valid signature are not installed. "+
+			"If empty, signatures are not verified.",
+	)
+	flag.StringVar(&flagVar.LayerCacheDirectory, "layer-cache-dir",
+		filepath.Join(os.TempDir(), DefaultLayerCacheDirectoryName),
+		"Directory in which the manifest layers pulled from the OCI registry are cached.")
+	flag.StringVar(&flagVar.LayerCacheMaxSize, "layer-cache-max-size", DefaultLayerCacheMaxSize,
+		"Maximum size of the manifest layer cache, as a Kubernetes quantity such as '2Gi'. "+
+			"When the cache exceeds it, the least recently used layers are removed. '0' disables the limit.")
+	flag.IntVar(&flagVar.DescriptorCacheMaxEntries, "descriptor-cache-max-entries",
+		DefaultDescriptorCacheMaxEntries,
+		"Maximum number of component descriptors kept in memory. When the cache is full, "+
+			"the least recently used descriptor is removed. '0' disables the limit.")
+	flag.DurationVar(&flagVar.DescriptorCacheTTL, "descriptor-cache-ttl", DefaultDescriptorCacheTTL,
+		"Duration after which a cached component descriptor is fetched again from the OCI registry. "+
+			"'0' disables the expiry.")
+	flag.StringVar(&flagVar.DescriptorCacheDirectory, "descriptor-cache-dir", "",
+		"Directory in which the cached component descriptors are persisted, so that they survive restarts. "+
+			"If empty, component descriptors are only cached in memory.")
+	flag.StringVar(&flagVar.OciLayoutDirectory, "oci-layout-dir", "",
+		"Directory of an OCI image layout from which component descriptors and module layers are read instead "+
+			"of the OCI registry. Artifacts are looked up by their reference without the registry host.")
+	flag.StringVar(&flagVar.OciRegistryMirrors, "oci-registry-mirrors", "",
+		"Comma-separated, ordered list of mirror registries that are used when the OCI registry fails. "+
+			"Each entry is a registry host with an optional path, optionally followed by '=' and the name of "+
+			"a Secret in the kcp-system namespace holding the credentials for the mirror.")
+	flag.DurationVar(&flagVar.OciRegistryMirrorTimeout, "oci-registry-mirror-timeout",
+		DefaultOciRegistryMirrorTimeout,
+		"Duration the OCI registry and each mirror are given to respond before the next mirror is tried.")
+	flag.DurationVar(&flagVar.OciRegistryMirrorCooldown, "oci-registry-mirror-cooldown",
+		DefaultOciRegistryMirrorCooldown,
+		"Duration for which the OCI registry or a mirror is skipped after it was not available repeatedly.")
+	flag.BoolVar(&flagVar.ResolveImageDigests, "resolve-image-digests", false,
+		"Resolve the digests of module images that the component descriptor references by tag only in the "+
+			"registry, so that all module images are pinned to digests.")
+	flag.StringVar(&flagVar.ResourceProfilesFile, "resource-profiles-file", "",
+		"Path to a YAML file with the resource profiles, which size the Deployments and StatefulSets of modules "+
+			"by the plan of the Kyma runtime. If empty, module workloads are applied as rendered.")
+	flag.BoolVar(&flagVar.DriftDetection, "drift-detection", true,
+		"Report the fields of module resources in SKR clusters that are managed by unknown field managers, for "+
+			"example after a kubectl edit, in the drift status and condition of the Manifest CR and in metrics.")
+	flag.StringVar(&flagVar.DriftKnownFieldManagers, "drift-known-field-managers", DefaultDriftKnownFieldManagers,
+		"Comma-separated list of field managers that are not reported as drift, such as Lifecycle Manager "+
+			"itself and the controllers of the SKR clusters.")
+	flag.IntVar(&flagVar.DriftReportMaxEntries, "drift-report-max-entries", DefaultDriftReportMaxEntries,
+		"Maximum number of resource and field manager entries listed in the drift status of a Manifest CR. "+
+			"The drift condition and metrics count all drifted resources.")
+	flag.IntVar(&flagVar.HealthReportMaxEntries, "health-report-max-entries", DefaultHealthReportMaxEntries,
+		"Maximum number of resources listed in the health status of a Manifest CR, the least healthy ones first. "+
+			"The state of the Manifest CR considers all evaluated resources.")
 	flag.StringVar(&flagVar.ModulesRepositorySubPath, "modules-repository-subpath", "",
 		"Allows to configure an additional repository subpath that is appended to the OCI registry host. "+
 			"This is required when the configured OCI registry is a general-purpose registry and the OCM component "+
@@ -343,6 +432,7 @@ type FlagVar struct {
 	KymaRequeueBusyInterval                        time.Duration
 	KymaRequeueWarningInterval                     time.Duration
 	ModuleUpgradeRolloutMaxDelay                   time.Duration
+	ModuleRolloutCheckInterval                     time.Duration
 	ManifestRequeueSuccessInterval                 time.Duration
 	ManifestRequeueErrInterval                     time.Duration
 	ManifestRequeueBusyInterval                    time.Duration
@@ -392,8 +482,25 @@ type FlagVar struct {
 	IstioGatewaySecretRequeueSuccessInterval   time.Duration
 	IstioGatewaySecretRequeueErrInterval       time.Duration
 	MinMaintenanceWindowSize                   time.Duration
+	MaintenancePolicyReloadInterval            time.Duration
 	OciRegistryCredSecretName                  string
 	OciRegistryHost                            string
+	DescriptorSignatureSecret                  string
+	LayerCacheDirectory                        string
+	LayerCacheMaxSize                          string
+	DescriptorCacheMaxEntries                  int
+	DescriptorCacheTTL                         time.Duration
+	DescriptorCacheDirectory                   string
+	OciLayoutDirectory                         string
+	OciRegistryMirrors                         string
+	OciRegistryMirrorTimeout                   time.Duration
+	OciRegistryMirrorCooldown                  time.Duration
+	ResolveImageDigests                        bool
+	ResourceProfilesFile                       string
+	DriftDetection                             bool
+	DriftKnownFieldManagers                    string
+	DriftReportMaxEntries                      int
+	HealthReportMaxEntries                     int
 	ModulesRepositorySubPath                   string
 	SkrImagePullSecret                         string
 	RestrictedDefaultModules                   string
@@ -442,6 +549,40 @@ func (f *FlagVar) Validate() error {
 		return err
 	}
 
+	if _, err := f.GetLayerCacheMaxSize(); err != nil {
+		return err
+	}
+
+	if f.DescriptorCacheMaxEntries < 0 {
+		return ErrInvalidDescriptorCacheMaxEntries
+	}
+	if f.DescriptorCacheTTL < 0 {
+		return ErrInvalidDescriptorCacheTTL
+	}
+
+	if _, err := f.GetOciRegistryMirrors(); err != nil {
+		return err
+	}
+	if f.OciLayoutDirectory != "" && f.OciRegistryMirrors != "" {
+		return ErrOciLayoutDirWithMirrors
+	}
+
+	if f.DriftReportMaxEntries < 0 {
+		return ErrInvalidDriftReportMaxEntries
+	}
+
+	if f.HealthReportMaxEntries < 0 {
+		return ErrInvalidHealthReportMaxEntries
+	}
+
+	if f.ModuleRolloutCheckInterval <= 0 {
+		return ErrInvalidModuleRolloutCheckInterval
+	}
+
+	if f.MaintenancePolicyReloadInterval <= 0 {
+		return ErrInvalidMaintenancePolicyReloadInterval
+	}
+
 	return nil
 }
 
@@ -466,6 +607,42 @@ func (f *FlagVar) GetWatcherImage() string {
 	return fmt.Sprintf("%s/%s:%s", f.WatcherImageRegistry, f.WatcherImageName, f.WatcherImageTag)
 }
 
+// OciRegistryMirror is a mirror of the OCI registry with the name of the Secret holding its credentials, if any.
+type OciRegistryMirror struct {
+	Registry       string
+	CredSecretName string
+}
+
+// GetOciRegistryMirrors returns the mirror registries in the order they are tried.
+func (f *FlagVar) GetOciRegistryMirrors() ([]OciRegistryMirror, error) {
+	if f.OciRegistryMirrors == "" {
+		return nil, nil
+	}
+	entries := strings.Split(f.OciRegistryMirrors, ",")
+	mirrors := make([]OciRegistryMirror, 0, len(entries))
+	for _, entry := range entries {
+		registry, credSecretName, hasSecret := strings.Cut(strings.TrimSpace(entry), "=")
+		if registry == "" || (hasSecret && credSecretName == "") {
+			return nil, fmt.Errorf("%w: '%s'", ErrInvalidOciRegistryMirrors, f.OciRegistryMirrors)
+		}
+		mirrors = append(mirrors, OciRegistryMirror{Registry: registry, CredSecretName: credSecretName})
+	}
+	return mirrors, nil
+}
+
+// GetLayerCacheMaxSize returns the maximum size of the manifest layer cache in bytes.
+func (f *FlagVar) GetLayerCacheMaxSize() (int64, error) {
+	maxSize, err := resource.ParseQuantity(f.LayerCacheMaxSize)
+	if err != nil || maxSize.Sign() < 0 {
+		return 0, fmt.Errorf("%w: '%s'", ErrInvalidLayerCacheMaxSize, f.LayerCacheMaxSize)
+	}
+	return maxSize.Value(), nil
+}
+
+func (f *FlagVar) GetDriftKnownFieldManagers() []string {
+	return splitCommaSeparatedList(f.DriftKnownFieldManagers)
+}
+
 func (f *FlagVar) GetRestrictedDefaultModules() []string {
 	if f.restrictedDefaultModules != nil {
 		return f.restrictedDefaultModules
@@ -88,6 +88,11 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultModuleUpgradeRolloutMaxDelay.String(),
 			expectedValue: (5 * time.Minute).String(),
 		},
+		{
+			constName:     "DefaultModuleRolloutCheckInterval",
+			constValue:    DefaultModuleRolloutCheckInterval.String(),
+			expectedValue: (1 * time.Minute).String(),
+		},
 		{
 			constName:     "DefaultClientQPS",
 			constValue:    strconv.Itoa(DefaultClientQPS),
@@ -283,6 +288,11 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultMinMaintenanceWindowSize.String(),
 			expectedValue: (20 * time.Minute).String(),
 		},
+		{
+			constName:     "DefaultMaintenancePolicyReloadInterval",
+			constValue:    DefaultMaintenancePolicyReloadInterval.String(),
+			expectedValue: (1 * time.Minute).String(),
+		},
 		{
 			constName:     "DefaultLeaderElectionLeaseDuration",
 			constValue:    DefaultLeaderElectionLeaseDuration.String(),
@@ -298,6 +308,46 @@ func Test_ConstantFlags(t *testing.T) {
 			constValue:    DefaultLeaderElectionRetryPeriod.String(),
 			expectedValue: (3 * time.Second).String(),
 		},
+		{
+			constName:     "DefaultLayerCacheMaxSize",
+			constValue:    DefaultLayerCacheMaxSize,
+			expectedValue: "2Gi",
+		},
+		{
+			constName:     "DefaultDescriptorCacheMaxEntries",
+			constValue:    strconv.Itoa(DefaultDescriptorCacheMaxEntries),
+			expectedValue: "1000",
+		},
+		{
+			constName:     "DefaultDescriptorCacheTTL",
+			constValue:    DefaultDescriptorCacheTTL.String(),
+			expectedValue: (24 * time.Hour).String(),
+		},
+		{
+			constName:     "DefaultOciRegistryMirrorTimeout",
+			constValue:    DefaultOciRegistryMirrorTimeout.String(),
+			expectedValue: (30 * time.Second).String(),
+		},
+		{
+			constName:     "DefaultOciRegistryMirrorCooldown",
+			constValue:    DefaultOciRegistryMirrorCooldown.String(),
+			expectedValue: (1 * time.Minute).String(),
+		},
+		{
+			constName:     "DefaultDriftKnownFieldManagers",
+			constValue:    DefaultDriftKnownFieldManagers,
+			expectedValue: "declarative.kyma-project.io/applier,lifecycle-manager,k3s,kube-controller-manager",
+		},
+		{
+			constName:     "DefaultDriftReportMaxEntries",
+			constValue:    strconv.Itoa(DefaultDriftReportMaxEntries),
+			expectedValue: "20",
+		},
+		{
+			constName:     "DefaultHealthReportMaxEntries",
+			constValue:    strconv.Itoa(DefaultHealthReportMaxEntries),
+			expectedValue: "20",
+		},
 	}
 	for _, testcase := range tests {
 		testName := fmt.Sprintf("const %s has correct value", testcase.constName)
@@ -525,6 +575,82 @@ func Test_Flags_Validate(t *testing.T) {
 			flags: newFlagVarBuilder().withRestrictedDefaultModules(",").build(),
 			err:   ErrInvalidRestrictedDefaultModules,
 		},
+		{
+			name:  "LayerCacheMaxSize 0 disables the limit",
+			flags: newFlagVarBuilder().withLayerCacheMaxSize("0").build(),
+			err:   nil,
+		},
+		{
+			name:  "LayerCacheMaxSize negative",
+			flags: newFlagVarBuilder().withLayerCacheMaxSize("-1Gi").build(),
+			err:   ErrInvalidLayerCacheMaxSize,
+		},
+		{
+			name:  "LayerCacheMaxSize not a quantity",
+			flags: newFlagVarBuilder().withLayerCacheMaxSize("two gigs").build(),
+			err:   ErrInvalidLayerCacheMaxSize,
+		},
+		{
+			name:  "DescriptorCacheMaxEntries 0 disables the limit",
+			flags: newFlagVarBuilder().withDescriptorCacheMaxEntries(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "DescriptorCacheMaxEntries negative",
+			flags: newFlagVarBuilder().withDescriptorCacheMaxEntries(-1).build(),
+			err:   ErrInvalidDescriptorCacheMaxEntries,
+		},
+		{
+			name:  "DescriptorCacheTTL negative",
+			flags: newFlagVarBuilder().withDescriptorCacheTTL(-time.Minute).build(),
+			err:   ErrInvalidDescriptorCacheTTL,
+		},
+		{
+			name:  "OciRegistryMirrors with empty entry",
+			flags: newFlagVarBuilder().withOciRegistryMirrors("mirror.example.com,").build(),
+			err:   ErrInvalidOciRegistryMirrors,
+		},
+		{
+			name:  "OciRegistryMirrors with empty secret name",
+			flags: newFlagVarBuilder().withOciRegistryMirrors("mirror.example.com=").build(),
+			err:   ErrInvalidOciRegistryMirrors,
+		},
+		{
+			name: "OciRegistryMirrors with OciLayoutDirectory",
+			flags: newFlagVarBuilder().withOciRegistryMirrors("mirror.example.com").
+				withOciLayoutDirectory("/oci-layout").build(),
+			err: ErrOciLayoutDirWithMirrors,
+		},
+		{
+			name:  "DriftReportMaxEntries 0 lists no entries",
+			flags: newFlagVarBuilder().withDriftReportMaxEntries(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "DriftReportMaxEntries negative",
+			flags: newFlagVarBuilder().withDriftReportMaxEntries(-1).build(),
+			err:   ErrInvalidDriftReportMaxEntries,
+		},
+		{
+			name:  "HealthReportMaxEntries 0 lists no entries",
+			flags: newFlagVarBuilder().withHealthReportMaxEntries(0).build(),
+			err:   nil,
+		},
+		{
+			name:  "HealthReportMaxEntries negative",
+			flags: newFlagVarBuilder().withHealthReportMaxEntries(-1).build(),
+			err:   ErrInvalidHealthReportMaxEntries,
+		},
+		{
+			name:  "ModuleRolloutCheckInterval 0",
+			flags: newFlagVarBuilder().withModuleRolloutCheckInterval(0).build(),
+			err:   ErrInvalidModuleRolloutCheckInterval,
+		},
+		{
+			name:  "MaintenancePolicyReloadInterval 0",
+			flags: newFlagVarBuilder().withMaintenancePolicyReloadInterval(0).build(),
+			err:   ErrInvalidMaintenancePolicyReloadInterval,
+		},
 	}
 
 	for _, tt := range tests {
@@ -560,7 +686,10 @@ func newFlagVarBuilder() *flagVarBuilder {
 		withSelfSignedCertKeySize(4096).
 		withManifestRequeueJitterProbability(0.01).
 		withManifestRequeueJitterPercentage(0.1).
-		withOciRegistryHost("europe-docker.pkg.dev")
+		withOciRegistryHost("europe-docker.pkg.dev").
+		withLayerCacheMaxSize(DefaultLayerCacheMaxSize).
+		withModuleRolloutCheckInterval(DefaultModuleRolloutCheckInterval).
+		withMaintenancePolicyReloadInterval(DefaultMaintenancePolicyReloadInterval)
 }
 
 func (b *flagVarBuilder) build() FlagVar {
@@ -631,3 +760,79 @@ func (b *flagVarBuilder) withRestrictedDefaultModules(modules string) *flagVarBu
 	b.flags.RestrictedDefaultModules = modules
 	return b
 }
+
+func (b *flagVarBuilder) withLayerCacheMaxSize(maxSize string) *flagVarBuilder {
+	b.flags.LayerCacheMaxSize = maxSize
+	return b
+}
+
+func (b *flagVarBuilder) withDescriptorCacheMaxEntries(maxEntries int) *flagVarBuilder {
+	b.flags.DescriptorCacheMaxEntries = maxEntries
+	return b
+}
+
+func (b *flagVarBuilder) withDescriptorCacheTTL(ttl time.Duration) *flagVarBuilder {
+	b.flags.DescriptorCacheTTL = ttl
+	return b
+}
+
+func (b *flagVarBuilder) withOciRegistryMirrors(mirrors string) *flagVarBuilder {
+	b.flags.OciRegistryMirrors = mirrors
+	return b
+}
+
+func (b *flagVarBuilder) withOciLayoutDirectory(directory string) *flagVarBuilder {
+	b.flags.OciLayoutDirectory = directory
+	return b
+}
+
+func (b *flagVarBuilder) withDriftReportMaxEntries(maxEntries int) *flagVarBuilder {
+	b.flags.DriftReportMaxEntries = maxEntries
+	return b
+}
+
+func (b *flagVarBuilder) withHealthReportMaxEntries(maxEntries int) *flagVarBuilder {
+	b.flags.HealthReportMaxEntries = maxEntries
+	return b
+}
+
+func TestGetOciRegistryMirrors(t *testing.T) {
+	flags := newFlagVarBuilder().
+		withOciRegistryMirrors("mirror.example.com/modules=mirror-cred, http://mirror.local:5000").
+		build()
+
+	mirrors, err := flags.GetOciRegistryMirrors()
+
+	require.NoError(t, err)
+	require.Equal(t, []OciRegistryMirror{
+		{Registry: "mirror.example.com/modules", CredSecretName: "mirror-cred"},
+		{Registry: "http://mirror.local:5000"},
+	}, mirrors)
+}
+
+func TestGetLayerCacheMaxSize(t *testing.T) {
+	flags := newFlagVarBuilder().withLayerCacheMaxSize("512Mi").build()
+
+	maxSize, err := flags.GetLayerCacheMaxSize()
+
+	require.NoError(t, err)
+	require.Equal(t, int64(512*1024*1024), maxSize)
+}
+
+func TestGetDriftKnownFieldManagers(t *testing.T) {
+	flags := FlagVar{DriftKnownFieldManagers: DefaultDriftKnownFieldManagers}
+
+	require.Equal(t, []string{
+		"declarative.kyma-project.io/applier", "lifecycle-manager", "k3s", "kube-controller-manager",
+	}, flags.GetDriftKnownFieldManagers())
+}
+
+func (b *flagVarBuilder) withModuleRolloutCheckInterval(interval time.Duration) *flagVarBuilder {
+	b.flags.ModuleRolloutCheckInterval = interval
+	return b
+}
+
+func (b *flagVarBuilder) withMaintenancePolicyReloadInterval(interval time.Duration) *flagVarBuilder {
+	b.flags.MaintenancePolicyReloadInterval = interval
+	return b
+}
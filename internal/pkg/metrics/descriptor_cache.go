@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricDescriptorCacheHits    = "lifecycle_mgr_descriptor_cache_hits_total"
+	MetricDescriptorCacheMisses  = "lifecycle_mgr_descriptor_cache_misses_total"
+	MetricDescriptorCacheEntries = "lifecycle_mgr_descriptor_cache_entries"
+)
+
+type DescriptorCacheMetrics struct {
+	hitCounter   prometheus.Counter
+	missCounter  prometheus.Counter
+	entriesGauge prometheus.Gauge
+}
+
+func NewDescriptorCacheMetrics() *DescriptorCacheMetrics {
+	metrics := &DescriptorCacheMetrics{
+		hitCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricDescriptorCacheHits,
+			Help: "Indicates the number of component descriptors served from the in-memory descriptor cache",
+		}),
+		missCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricDescriptorCacheMisses,
+			Help: "Indicates the number of component descriptor lookups that were missing in the in-memory " +
+				"descriptor cache or had expired",
+		}),
+		entriesGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricDescriptorCacheEntries,
+			Help: "Indicates the number of component descriptors held in the in-memory descriptor cache",
+		}),
+	}
+	ctrlmetrics.Registry.MustRegister(metrics.hitCounter, metrics.missCounter, metrics.entriesGauge)
+	return metrics
+}
+
+func (m *DescriptorCacheMetrics) RecordHit() {
+	m.hitCounter.Inc()
+}
+
+func (m *DescriptorCacheMetrics) RecordMiss() {
+	m.missCounter.Inc()
+}
+
+func (m *DescriptorCacheMetrics) RecordSize(entries int) {
+	m.entriesGauge.Set(float64(entries))
+}
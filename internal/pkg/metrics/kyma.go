@@ -16,16 +16,20 @@ import (
 )
 
 const (
-	MetricKymaState     = "lifecycle_mgr_kyma_state"
-	MetricModuleState   = "lifecycle_mgr_module_state"
-	MetricRequeueReason = "lifecycle_mgr_requeue_reason_total"
+	MetricKymaState        = "lifecycle_mgr_kyma_state"
+	MetricModuleState      = "lifecycle_mgr_module_state"
+	MetricRequeueReason    = "lifecycle_mgr_requeue_reason_total"
+	MetricDeprecatedModule = "lifecycle_mgr_deprecated_module"
+	channelLabel           = "channel"
+	sunsetDateLabel        = "sunset_date"
 )
 
 type KymaMetrics struct {
 	*SharedMetrics
 
-	KymaStateGauge   *prometheus.GaugeVec
-	moduleStateGauge *prometheus.GaugeVec
+	KymaStateGauge        *prometheus.GaugeVec
+	moduleStateGauge      *prometheus.GaugeVec
+	deprecatedModuleGauge *prometheus.GaugeVec
 }
 
 type KymaRequeueReason string
@@ -66,9 +70,15 @@ func NewKymaMetrics(sharedMetrics *SharedMetrics) *KymaMetrics {
 			Name: MetricModuleState,
 			Help: "Indicates the Status.state for modules of Kyma",
 		}, []string{moduleNameLabel, KymaNameLabel, stateLabel, shootIDLabel, instanceIDLabel}),
+
+		deprecatedModuleGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricDeprecatedModule,
+			Help: "Indicates that a Kyma uses a deprecated module or module channel",
+		}, []string{moduleNameLabel, KymaNameLabel, channelLabel, sunsetDateLabel}),
 	}
 	ctrlmetrics.Registry.MustRegister(kymaMetrics.KymaStateGauge)
 	ctrlmetrics.Registry.MustRegister(kymaMetrics.moduleStateGauge)
+	ctrlmetrics.Registry.MustRegister(kymaMetrics.deprecatedModuleGauge)
 	return kymaMetrics
 }
 
@@ -91,8 +101,8 @@ func (k *KymaMetrics) UpdateAll(kyma *v1beta2.Kyma) error {
 	return nil
 }
 
-// CleanupMetrics deletes all 'lifecycle_mgr_kyma_state',
-// 'lifecycle_mgr_module_state' metrics for the matching Kyma.
+// CleanupMetrics deletes all 'lifecycle_mgr_kyma_state', 'lifecycle_mgr_module_state' and
+// 'lifecycle_mgr_deprecated_module' metrics for the matching Kyma.
 func (k *KymaMetrics) CleanupMetrics(kymaName string) {
 	k.KymaStateGauge.DeletePartialMatch(prometheus.Labels{
 		KymaNameLabel: kymaName,
@@ -100,6 +110,32 @@ func (k *KymaMetrics) CleanupMetrics(kymaName string) {
 	k.moduleStateGauge.DeletePartialMatch(prometheus.Labels{
 		KymaNameLabel: kymaName,
 	})
+	k.deprecatedModuleGauge.DeletePartialMatch(prometheus.Labels{
+		KymaNameLabel: kymaName,
+	})
+}
+
+// DeprecatedModule is a deprecated module, or a deprecated channel of a module if Channel is set, in use by a Kyma.
+type DeprecatedModule struct {
+	ModuleName string
+	Channel    string
+	SunsetDate string
+}
+
+// SetDeprecatedModules replaces the 'lifecycle_mgr_deprecated_module' metrics of the Kyma with the deprecated modules
+// and channels it uses.
+func (k *KymaMetrics) SetDeprecatedModules(kymaName string, deprecatedModules []DeprecatedModule) {
+	k.deprecatedModuleGauge.DeletePartialMatch(prometheus.Labels{
+		KymaNameLabel: kymaName,
+	})
+	for _, deprecatedModule := range deprecatedModules {
+		k.deprecatedModuleGauge.With(prometheus.Labels{
+			moduleNameLabel: deprecatedModule.ModuleName,
+			KymaNameLabel:   kymaName,
+			channelLabel:    deprecatedModule.Channel,
+			sunsetDateLabel: deprecatedModule.SunsetDate,
+		}).Set(1)
+	}
 }
 
 func (k *KymaMetrics) HasMetrics(kymaName string) (bool, error) {
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricLayerCacheHits      = "lifecycle_mgr_layer_cache_hits_total"
+	MetricLayerCacheMisses    = "lifecycle_mgr_layer_cache_misses_total"
+	MetricLayerCacheEvictions = "lifecycle_mgr_layer_cache_evictions_total"
+	MetricLayerCacheSize      = "lifecycle_mgr_layer_cache_size_bytes"
+)
+
+type LayerCacheMetrics struct {
+	hitCounter      prometheus.Counter
+	missCounter     prometheus.Counter
+	evictionCounter prometheus.Counter
+	sizeGauge       prometheus.Gauge
+}
+
+func NewLayerCacheMetrics() *LayerCacheMetrics {
+	metrics := &LayerCacheMetrics{
+		hitCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricLayerCacheHits,
+			Help: "Indicates the number of manifest layers reused from the on-disk layer cache",
+		}),
+		missCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricLayerCacheMisses,
+			Help: "Indicates the number of manifest layers pulled because they were missing in the on-disk " +
+				"layer cache or did not match their digest",
+		}),
+		evictionCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: MetricLayerCacheEvictions,
+			Help: "Indicates the number of OCI refs evicted from the on-disk layer cache",
+		}),
+		sizeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: MetricLayerCacheSize,
+			Help: "Indicates the size of the on-disk layer cache in bytes",
+		}),
+	}
+	ctrlmetrics.Registry.MustRegister(metrics.hitCounter, metrics.missCounter, metrics.evictionCounter,
+		metrics.sizeGauge)
+	return metrics
+}
+
+func (m *LayerCacheMetrics) RecordHit() {
+	m.hitCounter.Inc()
+}
+
+func (m *LayerCacheMetrics) RecordMiss() {
+	m.missCounter.Inc()
+}
+
+func (m *LayerCacheMetrics) RecordEviction() {
+	m.evictionCounter.Inc()
+}
+
+func (m *LayerCacheMetrics) RecordSize(bytes int64) {
+	m.sizeGauge.Set(float64(bytes))
+}
@@ -13,6 +13,8 @@ type ManifestRequeueReason string
 
 const (
 	MetricManifestDuration                                     = "reconcile_duration_seconds"
+	MetricModuleDriftedResources                               = "lifecycle_mgr_module_drifted_resources"
+	MetricModuleDriftReverts                                   = "lifecycle_mgr_module_drift_reverts_total"
 	ManifestNameLabel                                          = "manifest_name"
 	ManifestRetrieval                    ManifestRequeueReason = "manifest_retrieval"
 	ManifestInit                         ManifestRequeueReason = "manifest_initialize"
@@ -40,6 +42,8 @@ type ManifestMetrics struct {
 	*SharedMetrics
 
 	ManifestDurationGauge *prometheus.GaugeVec
+	DriftedResourcesGauge *prometheus.GaugeVec
+	DriftRevertCounter    *prometheus.CounterVec
 }
 
 func NewManifestMetrics(sharedMetrics *SharedMetrics) *ManifestMetrics {
@@ -49,9 +53,18 @@ func NewManifestMetrics(sharedMetrics *SharedMetrics) *ManifestMetrics {
 			Name: MetricManifestDuration,
 			Help: "Indicates the duration for manifest reconciliation in seconds",
 		}, []string{ManifestNameLabel}),
+		DriftedResourcesGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricModuleDriftedResources,
+			Help: "Indicates the number of module resources with fields managed by unknown field managers",
+		}, []string{ManifestNameLabel, moduleNameLabel}),
+		DriftRevertCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricModuleDriftReverts,
+			Help: "Indicates the number of module resources reverted after unknown field managers changed them",
+		}, []string{moduleNameLabel}),
 	}
 
-	ctrlmetrics.Registry.MustRegister(metrics.ManifestDurationGauge)
+	ctrlmetrics.Registry.MustRegister(metrics.ManifestDurationGauge, metrics.DriftedResourcesGauge,
+		metrics.DriftRevertCounter)
 	return metrics
 }
 
@@ -69,8 +82,21 @@ func (k *ManifestMetrics) RemoveManifestDuration(manifestName string) {
 	})
 }
 
+// RecordDrift records the number of resources of the module drifted by unknown field managers and counts the
+// resources reverted in the last apply.
+func (k *ManifestMetrics) RecordDrift(manifestName, moduleName string, driftedResources, revertedResources int) {
+	k.DriftedResourcesGauge.With(prometheus.Labels{
+		ManifestNameLabel: manifestName,
+		moduleNameLabel:   moduleName,
+	}).Set(float64(driftedResources))
+	k.DriftRevertCounter.With(prometheus.Labels{moduleNameLabel: moduleName}).Add(float64(revertedResources))
+}
+
 func (k *ManifestMetrics) CleanupMetrics(manifestName string) {
 	k.ManifestDurationGauge.DeletePartialMatch(prometheus.Labels{
 		ManifestNameLabel: manifestName,
 	})
+	k.DriftedResourcesGauge.DeletePartialMatch(prometheus.Labels{
+		ManifestNameLabel: manifestName,
+	})
 }
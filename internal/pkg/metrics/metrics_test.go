@@ -34,6 +34,16 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    MetricManifestDuration,
 			expectedValue: "reconcile_duration_seconds",
 		},
+		{
+			constName:     "MetricModuleDriftedResources",
+			constValue:    MetricModuleDriftedResources,
+			expectedValue: "lifecycle_mgr_module_drifted_resources",
+		},
+		{
+			constName:     "MetricModuleDriftReverts",
+			constValue:    MetricModuleDriftReverts,
+			expectedValue: "lifecycle_mgr_module_drift_reverts_total",
+		},
 		{
 			constName:     "MetricMandatoryModulesCount",
 			constValue:    MetricMandatoryModulesCount,
@@ -44,6 +54,51 @@ func Test_ConstantMetricNames(t *testing.T) {
 			constValue:    MetricMandatoryModuleState,
 			expectedValue: "lifecycle_mgr_mandatory_module_state",
 		},
+		{
+			constName:     "MetricLayerCacheHits",
+			constValue:    MetricLayerCacheHits,
+			expectedValue: "lifecycle_mgr_layer_cache_hits_total",
+		},
+		{
+			constName:     "MetricLayerCacheMisses",
+			constValue:    MetricLayerCacheMisses,
+			expectedValue: "lifecycle_mgr_layer_cache_misses_total",
+		},
+		{
+			constName:     "MetricLayerCacheEvictions",
+			constValue:    MetricLayerCacheEvictions,
+			expectedValue: "lifecycle_mgr_layer_cache_evictions_total",
+		},
+		{
+			constName:     "MetricLayerCacheSize",
+			constValue:    MetricLayerCacheSize,
+			expectedValue: "lifecycle_mgr_layer_cache_size_bytes",
+		},
+		{
+			constName:     "MetricDescriptorCacheHits",
+			constValue:    MetricDescriptorCacheHits,
+			expectedValue: "lifecycle_mgr_descriptor_cache_hits_total",
+		},
+		{
+			constName:     "MetricDescriptorCacheMisses",
+			constValue:    MetricDescriptorCacheMisses,
+			expectedValue: "lifecycle_mgr_descriptor_cache_misses_total",
+		},
+		{
+			constName:     "MetricDescriptorCacheEntries",
+			constValue:    MetricDescriptorCacheEntries,
+			expectedValue: "lifecycle_mgr_descriptor_cache_entries",
+		},
+		{
+			constName:     "MetricOciRegistryRequests",
+			constValue:    MetricOciRegistryRequests,
+			expectedValue: "lifecycle_mgr_oci_registry_requests_total",
+		},
+		{
+			constName:     "MetricOciRegistryCircuitOpen",
+			constValue:    MetricOciRegistryCircuitOpen,
+			expectedValue: "lifecycle_mgr_oci_registry_circuit_open",
+		},
 	}
 	for _, testcase := range tests {
 		testName := fmt.Sprintf("const %s has correct value", testcase.constName)
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	MetricOciRegistryRequests    = "lifecycle_mgr_oci_registry_requests_total"
+	MetricOciRegistryCircuitOpen = "lifecycle_mgr_oci_registry_circuit_open"
+	registryLabel                = "registry"
+	resultLabel                  = "result"
+	resultSuccess                = "success"
+	resultFailure                = "failure"
+)
+
+type RegistryMirrorMetrics struct {
+	requestCounter   *prometheus.CounterVec
+	circuitOpenGauge *prometheus.GaugeVec
+}
+
+func NewRegistryMirrorMetrics() *RegistryMirrorMetrics {
+	metrics := &RegistryMirrorMetrics{
+		requestCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: MetricOciRegistryRequests,
+			Help: "Indicates the number of requests to the OCI registry and its mirrors by result",
+		}, []string{registryLabel, resultLabel}),
+		circuitOpenGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: MetricOciRegistryCircuitOpen,
+			Help: "Indicates whether the OCI registry or mirror is skipped after repeated failures (1) or not (0)",
+		}, []string{registryLabel}),
+	}
+	ctrlmetrics.Registry.MustRegister(metrics.requestCounter, metrics.circuitOpenGauge)
+	return metrics
+}
+
+func (m *RegistryMirrorMetrics) RecordRequest(registry string, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultFailure
+	}
+	m.requestCounter.With(prometheus.Labels{registryLabel: registry, resultLabel: result}).Inc()
+}
+
+func (m *RegistryMirrorMetrics) RecordCircuitOpen(registry string, open bool) {
+	value := 0.0
+	if open {
+		value = 1.0
+	}
+	m.circuitOpenGauge.With(prometheus.Labels{registryLabel: registry}).Set(value)
+}
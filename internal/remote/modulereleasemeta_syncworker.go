@@ -106,6 +106,8 @@ func prepareModuleReleaseMetaForSSA(moduleReleaseMeta *v1beta2.ModuleReleaseMeta
 	moduleReleaseMeta.SetResourceVersion("")
 	moduleReleaseMeta.SetUID("")
 	moduleReleaseMeta.SetManagedFields([]apimetav1.ManagedFieldsEntry{})
+	// the rollout progress is only relevant in KCP
+	moduleReleaseMeta.Status = v1beta2.ModuleReleaseMetaStatus{}
 	moduleReleaseMeta.SetLabels(collections.MergeMapsSilent(moduleReleaseMeta.GetLabels(), map[string]string{
 		shared.ManagedBy: shared.ManagedByLabelValue,
 	}))
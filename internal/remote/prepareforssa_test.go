@@ -61,6 +61,34 @@ func TestPrepareForSSA(t *testing.T) {
 	})
 }
 
+func TestPrepareModuleReleaseMetaForSSA_KeepsDeprecations(t *testing.T) {
+	moduleReleaseMeta := v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: "test-module",
+			Deprecation: &v1beta2.Deprecation{
+				Deprecated: true, SunsetDate: "2027-01-31", Replacement: "other-module",
+			},
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{
+					Channel: "fast", Version: "1.0.0",
+					Deprecation: &v1beta2.Deprecation{Deprecated: true, Replacement: "regular"},
+				},
+			},
+		},
+		Status: v1beta2.ModuleReleaseMetaStatus{
+			Rollouts: []v1beta2.ChannelRollout{{Channel: "fast", Version: "1.0.0"}},
+		},
+	}
+
+	prepareModuleReleaseMetaForSSA(&moduleReleaseMeta, "someNamespace")
+
+	assert.Equal(t, &v1beta2.Deprecation{Deprecated: true, SunsetDate: "2027-01-31", Replacement: "other-module"},
+		moduleReleaseMeta.Spec.Deprecation)
+	assert.Equal(t, &v1beta2.Deprecation{Deprecated: true, Replacement: "regular"},
+		moduleReleaseMeta.Spec.Channels[0].Deprecation)
+	assert.Empty(t, moduleReleaseMeta.Status.Rollouts)
+}
+
 func stubManagedFieldsEntry() apimetav1.ManagedFieldsEntry {
 	return apimetav1.ManagedFieldsEntry{Manager: "1", Operation: apimetav1.ManagedFieldsOperationApply}
 }
@@ -49,6 +49,13 @@ func (r *Repository) RemoveFinalizer(ctx context.Context, mrmName string, finali
 	return nil
 }
 
+func (r *Repository) UpdateStatus(ctx context.Context, mrm *v1beta2.ModuleReleaseMeta) error {
+	if err := r.clnt.Status().Update(ctx, mrm); err != nil {
+		return fmt.Errorf("failed to update status of ModuleReleaseMeta %s: %w", mrm.GetName(), err)
+	}
+	return nil
+}
+
 func (r *Repository) Get(ctx context.Context, mrmName string) (*v1beta2.ModuleReleaseMeta, error) {
 	mrm := &v1beta2.ModuleReleaseMeta{}
 	err := r.clnt.Get(ctx, client.ObjectKey{Name: mrmName, Namespace: r.namespace}, mrm)
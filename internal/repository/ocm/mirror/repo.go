@@ -0,0 +1,257 @@
+// Package mirror reads OCI artifacts from an ordered list of registries, falling back to the next registry when one
+// fails, so that the outage of a single registry does not block the installation of modules.
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+const (
+	DefaultTimeout          = 30 * time.Second
+	DefaultCooldown         = time.Minute
+	DefaultFailureThreshold = 3
+)
+
+var (
+	ErrNoRegistries        = errors.New("at least one registry is required")
+	ErrAllRegistriesFailed = errors.New("all registries failed")
+	ErrCircuitOpen         = errors.New("registry is skipped after repeated failures")
+	ErrTimeout             = errors.New("registry did not respond in time")
+)
+
+type OciRepositoryReader interface {
+	Config(ctx context.Context, ref string) ([]byte, error)
+	PullLayer(ctx context.Context, ref string) (containerregistryv1.Layer, error)
+}
+
+// KeyChainLayerReader is implemented by readers that can authenticate a layer pull with a given keychain.
+type KeyChainLayerReader interface {
+	PullLayerWithKeyChain(ctx context.Context, ref string, keyChain authn.Keychain) (containerregistryv1.Layer, error)
+}
+
+type Metrics interface {
+	RecordRequest(registry string, err error)
+	RecordCircuitOpen(registry string, open bool)
+}
+
+// Registry is a registry the artifacts are read from. The reference is the host and optional path of the registry,
+// which replaces the reference of the primary registry in the requested artifact references.
+type Registry struct {
+	Reference string
+	Reader    OciRepositoryReader
+}
+
+// RepositoryReader reads artifacts from the first registry, the primary one, and falls back to the next registry
+// in order on errors and timeouts. A registry that was not available a number of times in a row, because it timed
+// out, could not be connected to or responded with a server error, is skipped for a cooldown period, after which it
+// is tried again.
+type RepositoryReader struct {
+	registries       []*registry
+	timeout          time.Duration
+	cooldown         time.Duration
+	failureThreshold int
+	metrics          Metrics
+}
+
+type registry struct {
+	Registry
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func NewRepositoryReader(registries []Registry, metrics Metrics,
+	opts ...func(*RepositoryReader) *RepositoryReader,
+) (*RepositoryReader, error) {
+	if len(registries) == 0 {
+		return nil, ErrNoRegistries
+	}
+	reader := &RepositoryReader{
+		registries:       make([]*registry, 0, len(registries)),
+		timeout:          DefaultTimeout,
+		cooldown:         DefaultCooldown,
+		failureThreshold: DefaultFailureThreshold,
+		metrics:          metrics,
+	}
+	for _, reg := range registries {
+		reader.registries = append(reader.registries, &registry{
+			Registry: Registry{Reference: strings.TrimSuffix(reg.Reference, "/"), Reader: reg.Reader},
+		})
+	}
+	for _, opt := range opts {
+		reader = opt(reader)
+	}
+	return reader, nil
+}
+
+// WithTimeout bounds the time a single registry is given to respond before the next one is tried.
+func WithTimeout(timeout time.Duration) func(*RepositoryReader) *RepositoryReader {
+	return func(reader *RepositoryReader) *RepositoryReader {
+		reader.timeout = timeout
+		return reader
+	}
+}
+
+// WithCooldown sets the time a registry is skipped after it was not available the configured number of times
+// in a row.
+func WithCooldown(cooldown time.Duration) func(*RepositoryReader) *RepositoryReader {
+	return func(reader *RepositoryReader) *RepositoryReader {
+		reader.cooldown = cooldown
+		return reader
+	}
+}
+
+func WithFailureThreshold(failureThreshold int) func(*RepositoryReader) *RepositoryReader {
+	return func(reader *RepositoryReader) *RepositoryReader {
+		reader.failureThreshold = failureThreshold
+		return reader
+	}
+}
+
+func (r *RepositoryReader) Config(ctx context.Context, ref string) ([]byte, error) {
+	return read(r, ref, func(reg *registry, mirrorRef string) ([]byte, error) {
+		attemptCtx, cancel := context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+		config, err := reg.Reader.Config(attemptCtx, mirrorRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get config for ref=%q: %w", mirrorRef, err)
+		}
+		return config, nil
+	})
+}
+
+// PullLayer returns the layer from the first registry that has it. As layers are read lazily with the context they
+// were pulled with, only the pull is bounded by the timeout: the registry has to report the size of the layer within
+// the timeout, which ensures that the registry is reachable and has the layer, otherwise the pull is cancelled.
+func (r *RepositoryReader) PullLayer(ctx context.Context, ref string) (containerregistryv1.Layer, error) {
+	return r.PullLayerWithKeyChain(ctx, ref, nil)
+}
+
+// PullLayerWithKeyChain is like PullLayer, but authenticates against the primary registry with the given keychain,
+// for example the one of a Manifest, if its reader supports it. The mirrors are authenticated with their own
+// credentials, as the given keychain is meant for the primary registry.
+func (r *RepositoryReader) PullLayerWithKeyChain(ctx context.Context,
+	ref string,
+	keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
+	return read(r, ref, func(reg *registry, mirrorRef string) (containerregistryv1.Layer, error) {
+		// the pull is cancelled once it times out, but the context of a pulled layer stays valid for reading it
+		attemptCtx, cancel := context.WithCancelCause(ctx)
+		timer := time.AfterFunc(r.timeout, func() { cancel(ErrTimeout) })
+		layer, err := r.pullLayer(attemptCtx, reg, mirrorRef, keyChain)
+		if err == nil {
+			_, err = layer.Size()
+		}
+		if !timer.Stop() {
+			return nil, fmt.Errorf("%w: failed to pull layer for ref=%q", ErrTimeout, mirrorRef)
+		}
+		if err != nil {
+			cancel(err)
+			return nil, fmt.Errorf("failed to pull layer for ref=%q: %w", mirrorRef, err)
+		}
+		return layer, nil
+	})
+}
+
+func (r *RepositoryReader) pullLayer(ctx context.Context,
+	reg *registry,
+	ref string,
+	keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
+	if keyChainReader, ok := reg.Reader.(KeyChainLayerReader); ok && keyChain != nil && reg == r.registries[0] {
+		return keyChainReader.PullLayerWithKeyChain(ctx, ref, keyChain)
+	}
+	return reg.Reader.PullLayer(ctx, ref)
+}
+
+// read tries the registries in order until one succeeds. The errors of all registries are returned if none does.
+func read[T any](r *RepositoryReader, ref string, attempt func(*registry, string) (T, error)) (T, error) {
+	var zero T
+	primary := r.registries[0]
+	errs := make([]error, 0, len(r.registries))
+	for _, reg := range r.registries {
+		mirrorRef, ok := rewriteRef(ref, primary.Reference, reg.Reference)
+		if !ok {
+			continue
+		}
+		if !r.allow(reg) {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrCircuitOpen, reg.Reference))
+			continue
+		}
+		value, err := attempt(reg, mirrorRef)
+		r.record(reg, err)
+		if err == nil {
+			return value, nil
+		}
+		errs = append(errs, err)
+	}
+	return zero, fmt.Errorf("%w: %w", ErrAllRegistriesFailed, errors.Join(errs...))
+}
+
+func (r *RepositoryReader) allow(reg *registry) bool {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	return !time.Now().Before(reg.openUntil)
+}
+
+// record counts the availability failures of a registry in a row and skips it once they reach the threshold.
+func (r *RepositoryReader) record(reg *registry, err error) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	r.metrics.RecordRequest(reg.Reference, err)
+	if !isAvailabilityFailure(err) {
+		reg.consecutiveFailures = 0
+		r.metrics.RecordCircuitOpen(reg.Reference, false)
+		return
+	}
+	// the failures are only reset once the registry is available again, so a registry still not available after the
+	// cooldown is skipped right away
+	reg.consecutiveFailures++
+	if r.failureThreshold > 0 && reg.consecutiveFailures >= r.failureThreshold {
+		reg.openUntil = time.Now().Add(r.cooldown)
+		r.metrics.RecordCircuitOpen(reg.Reference, true)
+	}
+}
+
+// isAvailabilityFailure reports whether the registry was not available: it timed out, could not be connected to or
+// responded with a server error. Other errors, for example a missing artifact or denied access, show that the
+// registry is available, so they are no reason to skip it for the following requests.
+func isAvailabilityFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		return transportErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// rewriteRef replaces the reference of the primary registry in ref with the reference of another registry.
+// References that do not point to the primary registry are only passed to the primary registry.
+func rewriteRef(ref, primaryReference, reference string) (string, bool) {
+	if reference == primaryReference {
+		return ref, true
+	}
+	path, found := strings.CutPrefix(ref, primaryReference+"/")
+	if !found {
+		return "", false
+	}
+	return reference + "/" + path, true
+}
@@ -0,0 +1,259 @@
+package mirror_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/mirror"
+)
+
+const (
+	primaryRegistry = "europe-docker.pkg.dev/kyma-project/prod"
+	mirrorRegistry  = "mirror.example.com/kyma"
+	artifactPath    = "/component-descriptors/kyma-project.io/module/template-operator:1.0.1"
+)
+
+var (
+	errRegistryDown     = &transport.Error{StatusCode: http.StatusServiceUnavailable}
+	errArtifactNotFound = &transport.Error{StatusCode: http.StatusNotFound}
+)
+
+func TestConfig_OnPrimaryFailure_FallsBackToMirror(t *testing.T) {
+	primary := &readerStub{err: errRegistryDown}
+	secondary := &readerStub{config: []byte("config")}
+	metrics := &metricsStub{}
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: primary},
+		{Reference: mirrorRegistry, Reader: secondary},
+	}, metrics)
+	require.NoError(t, err)
+
+	config, err := reader.Config(t.Context(), primaryRegistry+artifactPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("config"), config)
+	assert.Equal(t, []string{primaryRegistry + artifactPath}, primary.refs)
+	assert.Equal(t, []string{mirrorRegistry + artifactPath}, secondary.refs)
+	assert.Equal(t, 1, metrics.failures[primaryRegistry])
+	assert.Equal(t, 1, metrics.successes[mirrorRegistry])
+}
+
+func TestConfig_OnAllRegistriesFailing_ReturnsAllErrors(t *testing.T) {
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: &readerStub{err: errRegistryDown}},
+		{Reference: mirrorRegistry, Reader: &readerStub{err: errRegistryDown}},
+	}, &metricsStub{})
+	require.NoError(t, err)
+
+	_, err = reader.Config(t.Context(), primaryRegistry+artifactPath)
+
+	require.ErrorIs(t, err, mirror.ErrAllRegistriesFailed)
+	require.ErrorIs(t, err, errRegistryDown)
+}
+
+func TestConfig_AfterRepeatedFailures_SkipsRegistryUntilCooldownPassed(t *testing.T) {
+	primary := &readerStub{err: errRegistryDown}
+	secondary := &readerStub{config: []byte("config")}
+	metrics := &metricsStub{}
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: primary},
+		{Reference: mirrorRegistry, Reader: secondary},
+	}, metrics, mirror.WithFailureThreshold(2), mirror.WithCooldown(50*time.Millisecond))
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err = reader.Config(t.Context(), primaryRegistry+artifactPath)
+		require.NoError(t, err)
+	}
+	assert.Len(t, primary.refs, 2)
+	assert.True(t, metrics.open[primaryRegistry])
+
+	time.Sleep(100 * time.Millisecond)
+	primary.err = nil
+	_, err = reader.Config(t.Context(), primaryRegistry+artifactPath)
+
+	require.NoError(t, err)
+	assert.Len(t, primary.refs, 3)
+	assert.False(t, metrics.open[primaryRegistry])
+}
+
+func TestConfig_AfterRepeatedNonAvailabilityFailures_KeepsTryingRegistry(t *testing.T) {
+	primary := &readerStub{err: errArtifactNotFound}
+	metrics := &metricsStub{}
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: primary},
+		{Reference: mirrorRegistry, Reader: &readerStub{config: []byte("config")}},
+	}, metrics, mirror.WithFailureThreshold(2))
+	require.NoError(t, err)
+
+	for range 3 {
+		_, err = reader.Config(t.Context(), primaryRegistry+artifactPath)
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, primary.refs, 3)
+	assert.False(t, metrics.open[primaryRegistry])
+	assert.Equal(t, 3, metrics.failures[primaryRegistry])
+}
+
+func TestConfig_OnSlowRegistry_FallsBackToMirror(t *testing.T) {
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: &readerStub{delay: time.Second}},
+		{Reference: mirrorRegistry, Reader: &readerStub{config: []byte("config")}},
+	}, &metricsStub{}, mirror.WithTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+
+	config, err := reader.Config(t.Context(), primaryRegistry+artifactPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("config"), config)
+}
+
+func TestPullLayer_OnPrimaryFailure_FallsBackToMirror(t *testing.T) {
+	layer := static.NewLayer([]byte("kind: ConfigMap"), types.OCILayer)
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: &readerStub{err: errRegistryDown}},
+		{Reference: mirrorRegistry, Reader: &readerStub{layer: layer}},
+	}, &metricsStub{})
+	require.NoError(t, err)
+
+	pulledLayer, err := reader.PullLayer(t.Context(), primaryRegistry+artifactPath+"@sha256:1234")
+
+	require.NoError(t, err)
+	assert.Equal(t, layer, pulledLayer)
+}
+
+func TestPullLayer_OnSlowRegistry_CancelsPullAndFallsBackToMirror(t *testing.T) {
+	layer := static.NewLayer([]byte("kind: ConfigMap"), types.OCILayer)
+	primary := &readerStub{delay: time.Second}
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: primary},
+		{Reference: mirrorRegistry, Reader: &readerStub{layer: layer}},
+	}, &metricsStub{}, mirror.WithTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+
+	pulledLayer, err := reader.PullLayer(t.Context(), primaryRegistry+artifactPath+"@sha256:1234")
+
+	require.NoError(t, err)
+	assert.Equal(t, layer, pulledLayer)
+	require.ErrorIs(t, context.Cause(primary.ctx), mirror.ErrTimeout)
+}
+
+func TestPullLayer_KeepsContextOfPulledLayer(t *testing.T) {
+	layer := static.NewLayer([]byte("kind: ConfigMap"), types.OCILayer)
+	primary := &readerStub{layer: layer}
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: primary},
+	}, &metricsStub{}, mirror.WithTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = reader.PullLayer(t.Context(), primaryRegistry+artifactPath+"@sha256:1234")
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, err)
+	assert.NoError(t, primary.ctx.Err())
+}
+
+func TestPullLayerWithKeyChain_PassesKeyChainToPrimaryOnly(t *testing.T) {
+	layer := static.NewLayer([]byte("kind: ConfigMap"), types.OCILayer)
+	primary := &keyChainReaderStub{readerStub: readerStub{err: errRegistryDown}}
+	secondary := &keyChainReaderStub{readerStub: readerStub{layer: layer}}
+	reader, err := mirror.NewRepositoryReader([]mirror.Registry{
+		{Reference: primaryRegistry, Reader: primary},
+		{Reference: mirrorRegistry, Reader: secondary},
+	}, &metricsStub{})
+	require.NoError(t, err)
+
+	pulledLayer, err := reader.PullLayerWithKeyChain(t.Context(), primaryRegistry+artifactPath+"@sha256:1234",
+		authn.DefaultKeychain)
+
+	require.NoError(t, err)
+	assert.Equal(t, layer, pulledLayer)
+	assert.Equal(t, authn.DefaultKeychain, primary.keyChain)
+	assert.Nil(t, secondary.keyChain)
+	assert.Len(t, secondary.refs, 1)
+}
+
+func TestNewRepositoryReader_WithoutRegistries_ReturnsErr(t *testing.T) {
+	_, err := mirror.NewRepositoryReader(nil, &metricsStub{})
+
+	require.ErrorIs(t, err, mirror.ErrNoRegistries)
+}
+
+type readerStub struct {
+	config []byte
+	layer  containerregistryv1.Layer
+	err    error
+	delay  time.Duration
+	refs   []string
+	ctx    context.Context //nolint:containedctx // records the context of the last pull
+}
+
+func (r *readerStub) Config(ctx context.Context, ref string) ([]byte, error) {
+	r.refs = append(r.refs, ref)
+	select {
+	case <-time.After(r.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return r.config, r.err
+}
+
+func (r *readerStub) PullLayer(ctx context.Context, ref string) (containerregistryv1.Layer, error) {
+	r.refs = append(r.refs, ref)
+	r.ctx = ctx
+	select {
+	case <-time.After(r.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return r.layer, r.err
+}
+
+type keyChainReaderStub struct {
+	readerStub
+
+	keyChain authn.Keychain
+}
+
+func (r *keyChainReaderStub) PullLayerWithKeyChain(ctx context.Context,
+	ref string,
+	keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
+	r.keyChain = keyChain
+	return r.PullLayer(ctx, ref)
+}
+
+type metricsStub struct {
+	successes map[string]int
+	failures  map[string]int
+	open      map[string]bool
+}
+
+func (m *metricsStub) RecordRequest(registry string, err error) {
+	if m.successes == nil {
+		m.successes, m.failures = map[string]int{}, map[string]int{}
+	}
+	if err != nil {
+		m.failures[registry]++
+		return
+	}
+	m.successes[registry]++
+}
+
+func (m *metricsStub) RecordCircuitOpen(registry string, open bool) {
+	if m.open == nil {
+		m.open = map[string]bool{}
+	}
+	m.open[registry] = open
+}
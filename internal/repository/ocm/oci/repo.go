@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/crane"
 	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
 
@@ -16,6 +17,7 @@ var ErrKeyChainNotNil = errors.New("keychain lookup must not be nil")
 type (
 	configFunc    func(string, ...crane.Option) ([]byte, error)
 	pullLayerFunc func(string, ...crane.Option) (containerregistryv1.Layer, error)
+	digestFunc    func(string, ...crane.Option) (string, error)
 )
 
 // RepositoryReader provides basic support to read OCI data from OCI repositories.
@@ -24,6 +26,7 @@ type RepositoryReader struct {
 	keyChainLookup spec.KeyChainLookup
 	config         configFunc
 	pullLayer      pullLayerFunc
+	digest         digestFunc
 }
 
 func NewRepository(kcl spec.KeyChainLookup,
@@ -39,6 +42,7 @@ func NewRepository(kcl spec.KeyChainLookup,
 		keyChainLookup: kcl,
 		config:         crane.Config,
 		pullLayer:      crane.PullLayer,
+		digest:         crane.Digest,
 	}
 
 	for _, opt := range opts {
@@ -64,6 +68,14 @@ func WithPullLayerFunction(f pullLayerFunc) func(*RepositoryReader) *RepositoryR
 	}
 }
 
+// WithDigestFunction is a low level primitive that replaces the default crane.Digest function.
+func WithDigestFunction(f digestFunc) func(*RepositoryReader) *RepositoryReader {
+	return func(c *RepositoryReader) *RepositoryReader {
+		c.digest = f
+		return c
+	}
+}
+
 func (c *RepositoryReader) Config(ctx context.Context, ref string) ([]byte, error) {
 	opts, err := c.stdOptions(ctx)
 	if err != nil {
@@ -82,18 +94,40 @@ func (c *RepositoryReader) PullLayer(ctx context.Context, ref string) (container
 	return c.pullLayer(ref, opts...)
 }
 
-func (s *RepositoryReader) stdOptions(ctx context.Context) ([]crane.Option, error) {
-	options := []crane.Option{crane.WithContext(ctx)}
+// PullLayerWithKeyChain is like PullLayer, but authenticates with the given keychain instead of the one of the
+// keychain lookup, for example with the keychain of a Manifest.
+func (c *RepositoryReader) PullLayerWithKeyChain(ctx context.Context,
+	ref string,
+	keyChain authn.Keychain,
+) (containerregistryv1.Layer, error) {
+	return c.pullLayer(ref, c.options(ctx, keyChain)...)
+}
 
+// Digest returns the digest of the manifest the given reference points to, for example "sha256:...".
+func (c *RepositoryReader) Digest(ctx context.Context, ref string) (string, error) {
+	opts, err := c.stdOptions(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get crane options: %w", err)
+	}
+
+	return c.digest(ref, opts...)
+}
+
+func (s *RepositoryReader) stdOptions(ctx context.Context) ([]crane.Option, error) {
 	keyChain, err := s.keyChainLookup.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get keychain: %w", err)
 	}
-	options = append(options, crane.WithAuthFromKeychain(keyChain))
+
+	return s.options(ctx, keyChain), nil
+}
+
+func (s *RepositoryReader) options(ctx context.Context, keyChain authn.Keychain) []crane.Option {
+	options := []crane.Option{crane.WithContext(ctx), crane.WithAuthFromKeychain(keyChain)}
 
 	if s.insecure {
 		options = append(options, crane.Insecure)
 	}
 
-	return options, nil
+	return options
 }
@@ -113,6 +113,51 @@ func TestPullLayer(t *testing.T) {
 		// then
 		require.ErrorIs(t, err, errKeyChain)
 	})
+
+	t.Run("should pull layer with given keychain instead of looking it up", func(t *testing.T) {
+		// given
+		expectedLayer := static.NewLayer([]byte("layer content"), types.OCILayer)
+		pullFunc := func(ref string, opts ...crane.Option) (containerregistryv1.Layer, error) {
+			return expectedLayer, nil
+		}
+
+		kclStub := &kclErrorStub{}
+		repo, err := oci.NewRepository(kclStub, true, oci.WithPullLayerFunction(pullFunc))
+		require.NoError(t, err)
+
+		// when
+		layer, err := repo.PullLayerWithKeyChain(t.Context(), "test-ref", authn.DefaultKeychain)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, expectedLayer, layer)
+	})
+}
+
+func TestDigest(t *testing.T) {
+	t.Run("should return digest successfully", func(t *testing.T) {
+		// given
+		receivedRef := ""
+		digestFunc := func(ref string, opts ...crane.Option) (string, error) {
+			receivedRef = ref
+			return "sha256:abc", nil
+		}
+
+		kclStub := &kclStub{}
+		repo, err := oci.NewRepository(kclStub, false, oci.WithDigestFunction(digestFunc))
+		require.NoError(t, err)
+
+		ctx := t.Context()
+
+		// when
+		digest, err := repo.Digest(ctx, "test-ref")
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, "sha256:abc", digest)
+		require.Equal(t, "test-ref", receivedRef)
+		require.Equal(t, ctx, kclStub.ctx)
+	})
 }
 
 var errKeyChain = errors.New("keychain error")
@@ -0,0 +1,134 @@
+// Package ocidir reads OCI artifacts from an OCI image layout directory instead of a remote registry, for example
+// from a volume in landscapes that cannot reach the module registry.
+package ocidir
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+)
+
+// annotationRefName is the annotation of the OCI image layout index that names the reference of a manifest.
+const annotationRefName = "org.opencontainers.image.ref.name"
+
+var (
+	ErrInvalidLayout    = errors.New("directory is not an OCI image layout")
+	ErrArtifactNotFound = errors.New("artifact not found in OCI image layout")
+	ErrInvalidRef       = errors.New("invalid OCI reference")
+)
+
+// RepositoryReader reads OCI artifacts from a single OCI image layout directory. The manifests in the index.json of
+// the layout are looked up by their "org.opencontainers.image.ref.name" annotation, which holds the reference of the
+// artifact without the registry host, for example
+// "component-descriptors/kyma-project.io/module/template-operator:1.0.0". Layers are looked up by their digest.
+type RepositoryReader struct {
+	path layout.Path
+}
+
+func NewRepository(directory string) (*RepositoryReader, error) {
+	path, err := layout.FromPath(directory)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidLayout, directory, err)
+	}
+	return &RepositoryReader{path: path}, nil
+}
+
+// Config returns the config file of the artifact with the given reference, in the form "host/repository:tag".
+func (r *RepositoryReader) Config(_ context.Context, ref string) ([]byte, error) {
+	repositoryWithTag, _, err := splitRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	index, err := r.path.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI image layout index: %w", err)
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI image layout index: %w", err)
+	}
+	for _, descriptor := range indexManifest.Manifests {
+		if descriptor.Annotations[annotationRefName] != repositoryWithTag {
+			continue
+		}
+		image, err := index.Image(descriptor.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact %s: %w", repositoryWithTag, err)
+		}
+		config, err := image.RawConfigFile()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config of artifact %s: %w", repositoryWithTag, err)
+		}
+		return config, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrArtifactNotFound, repositoryWithTag)
+}
+
+// PullLayer returns the layer with the digest of the given reference, in the form "host/repository[:tag]@digest".
+// As blobs are shared by all artifacts of a layout, the layer is returned from the first artifact containing it.
+func (r *RepositoryReader) PullLayer(_ context.Context, ref string) (containerregistryv1.Layer, error) {
+	_, digest, err := splitRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	if digest == "" {
+		return nil, fmt.Errorf("%w: %s has no digest", ErrInvalidRef, ref)
+	}
+	hash, err := containerregistryv1.NewHash(digest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidRef, ref, err)
+	}
+
+	index, err := r.path.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI image layout index: %w", err)
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI image layout index: %w", err)
+	}
+	for _, descriptor := range indexManifest.Manifests {
+		if !descriptor.MediaType.IsImage() {
+			continue
+		}
+		image, err := index.Image(descriptor.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact %s: %w", descriptor.Digest, err)
+		}
+		layer, err := image.LayerByDigest(hash)
+		if err != nil {
+			continue
+		}
+		pulledLayer, err := partial.CompressedToLayer(layer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer %s: %w", digest, err)
+		}
+		return pulledLayer, nil
+	}
+	return nil, fmt.Errorf("%w: layer %s", ErrArtifactNotFound, digest)
+}
+
+// PullLayerWithKeyChain is like PullLayer. The keychain is not used, as the layout directory is read without
+// authentication.
+func (r *RepositoryReader) PullLayerWithKeyChain(ctx context.Context,
+	ref string,
+	_ authn.Keychain,
+) (containerregistryv1.Layer, error) {
+	return r.PullLayer(ctx, ref)
+}
+
+// splitRef removes the registry host from the reference and splits off its digest, if any.
+func splitRef(ref string) (string, string, error) {
+	_, repository, found := strings.Cut(ref, "/")
+	if !found || repository == "" {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidRef, ref)
+	}
+	repository, digest, _ := strings.Cut(repository, "@")
+	return repository, digest, nil
+}
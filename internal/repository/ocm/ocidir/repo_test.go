@@ -0,0 +1,89 @@
+package ocidir_test
+
+import (
+	"testing"
+
+	containerregistryv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/internal/repository/ocm/ocidir"
+)
+
+const (
+	registryHost = "europe-docker.pkg.dev"
+	artifactRef  = "component-descriptors/kyma-project.io/module/template-operator:1.0.1"
+)
+
+func TestNewRepository_OnMissingLayout_ReturnsErr(t *testing.T) {
+	_, err := ocidir.NewRepository(t.TempDir())
+
+	require.ErrorIs(t, err, ocidir.ErrInvalidLayout)
+}
+
+func TestConfig_ReturnsConfigOfAnnotatedArtifact(t *testing.T) {
+	layoutDir, image := writeLayout(t)
+	repo, err := ocidir.NewRepository(layoutDir)
+	require.NoError(t, err)
+
+	config, err := repo.Config(t.Context(), registryHost+"/"+artifactRef)
+
+	require.NoError(t, err)
+	expected, err := image.RawConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, expected, config)
+}
+
+func TestConfig_OnUnknownTag_ReturnsErr(t *testing.T) {
+	layoutDir, _ := writeLayout(t)
+	repo, err := ocidir.NewRepository(layoutDir)
+	require.NoError(t, err)
+
+	_, err = repo.Config(t.Context(),
+		registryHost+"/component-descriptors/kyma-project.io/module/template-operator:2.0.0")
+
+	require.ErrorIs(t, err, ocidir.ErrArtifactNotFound)
+}
+
+func TestPullLayer_ReturnsLayerByDigest(t *testing.T) {
+	layoutDir, image := writeLayout(t)
+	repo, err := ocidir.NewRepository(layoutDir)
+	require.NoError(t, err)
+	layers, err := image.Layers()
+	require.NoError(t, err)
+	digest, err := layers[0].Digest()
+	require.NoError(t, err)
+
+	layer, err := repo.PullLayer(t.Context(), registryHost+"/"+artifactRef+"@"+digest.String())
+
+	require.NoError(t, err)
+	pulledDigest, err := layer.Digest()
+	require.NoError(t, err)
+	assert.Equal(t, digest, pulledDigest)
+}
+
+func TestPullLayer_OnUnknownDigest_ReturnsErr(t *testing.T) {
+	layoutDir, _ := writeLayout(t)
+	repo, err := ocidir.NewRepository(layoutDir)
+	require.NoError(t, err)
+
+	_, err = repo.PullLayer(t.Context(), registryHost+"/"+artifactRef+
+		"@sha256:0000000000000000000000000000000000000000000000000000000000000000")
+
+	require.ErrorIs(t, err, ocidir.ErrArtifactNotFound)
+}
+
+func writeLayout(t *testing.T) (string, containerregistryv1.Image) {
+	t.Helper()
+	layoutDir := t.TempDir()
+	path, err := layout.Write(layoutDir, empty.Index)
+	require.NoError(t, err)
+	image, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, path.AppendImage(image,
+		layout.WithAnnotations(map[string]string{"org.opencontainers.image.ref.name": artifactRef})))
+	return layoutDir, image
+}
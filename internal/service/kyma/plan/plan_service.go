@@ -0,0 +1,202 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
+	"github.com/kyma-project/lifecycle-manager/internal/service/skrclient"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/dependency"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/util"
+)
+
+type SpecResolver interface {
+	GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*spec.Spec, error)
+}
+
+type SKRClient interface {
+	ResolveClient(ctx context.Context, manifest *v1beta2.Manifest) (*skrclient.SKRClient, error)
+}
+
+type ResourceRenderService interface {
+	RenderTargetResources(ctx context.Context, skrClient skrclient.Client,
+		manifest *v1beta2.Manifest, spec *spec.Spec) ([]client.Object, error)
+}
+
+// Service computes the changes to module Manifests that a reconciliation of a Kyma would apply,
+// without applying them.
+type Service struct {
+	kcpClient     client.Reader
+	specResolver  SpecResolver
+	skrClient     SKRClient
+	renderService ResourceRenderService
+}
+
+func NewService(kcpClient client.Reader, specResolver SpecResolver, skrClient SKRClient,
+	renderService ResourceRenderService,
+) *Service {
+	return &Service{
+		kcpClient:     kcpClient,
+		specResolver:  specResolver,
+		skrClient:     skrClient,
+		renderService: renderService,
+	}
+}
+
+// Plan lists the Manifests that would be created, updated, or deleted for the given modules. For updates and
+// deletions, it also lists the resources the Manifest controller would prune from the SKR.
+func (s *Service) Plan(ctx context.Context, kyma *v1beta2.Kyma,
+	modules modulecommon.Modules,
+) (*v1beta2.ReconcilePlan, error) {
+	dependency.Validate(modules)
+
+	plan := &v1beta2.ReconcilePlan{}
+	for _, module := range modules {
+		planned, err := s.planModule(ctx, kyma, module)
+		if err != nil {
+			return nil, err
+		}
+		if planned != nil {
+			plan.Manifests = append(plan.Manifests, *planned)
+		}
+	}
+
+	for _, moduleStatus := range kyma.GetNoLongerExistingModuleStatus() {
+		if moduleStatus.Manifest == nil || dependency.RequiredByOtherModules(modules, moduleStatus.Name) != nil {
+			continue
+		}
+		manifestInCluster, err := s.getManifest(ctx, moduleStatus.Manifest.GetName(),
+			moduleStatus.Manifest.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if manifestInCluster != nil {
+			plan.Manifests = append(plan.Manifests, plannedDeletion(moduleStatus.Name, manifestInCluster))
+		}
+	}
+
+	slices.SortFunc(plan.Manifests, func(a, b v1beta2.PlannedManifest) int {
+		return strings.Compare(a.Module, b.Module)
+	})
+	return plan, nil
+}
+
+func (s *Service) planModule(ctx context.Context, kyma *v1beta2.Kyma,
+	module *modulecommon.Module,
+) (*v1beta2.PlannedManifest, error) {
+	if module.TemplateInfo == nil || module.Manifest == nil || !module.Enabled {
+		return nil, nil //nolint:nilnil // no change is planned for the module
+	}
+	manifestInCluster, err := s.getManifest(ctx, module.Manifest.GetName(), module.Manifest.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	// Due to module template visibility change, some module previously deployed would be removed.
+	if errors.Is(module.TemplateInfo.Err, templatelookup.ErrTemplateNotAllowed) {
+		if manifestInCluster == nil {
+			return nil, nil //nolint:nilnil // nothing to delete
+		}
+		planned := plannedDeletion(module.ModuleName, manifestInCluster)
+		return &planned, nil
+	}
+	if module.TemplateInfo.Err != nil {
+		return nil, nil //nolint:nilnil // modules in error state are not reconciled
+	}
+
+	newManifest := module.Manifest
+	module.ApplyDefaultMetaToManifest(kyma)
+	if !sync.NeedToUpdate(manifestInCluster, newManifest, kyma.GetModuleStatusMap()[module.ModuleName], module) {
+		return nil, nil //nolint:nilnil // the Manifest is up to date
+	}
+
+	if manifestInCluster == nil {
+		return &v1beta2.PlannedManifest{
+			Module:    module.ModuleName,
+			Name:      newManifest.GetName(),
+			Action:    v1beta2.PlanActionCreate,
+			ToVersion: newManifest.Spec.Version,
+			ToOCIRef:  ociRef(newManifest),
+		}, nil
+	}
+
+	planned := &v1beta2.PlannedManifest{
+		Module:      module.ModuleName,
+		Name:        newManifest.GetName(),
+		Action:      v1beta2.PlanActionUpdate,
+		FromVersion: manifestInCluster.Spec.Version,
+		ToVersion:   newManifest.Spec.Version,
+		FromOCIRef:  ociRef(manifestInCluster),
+		ToOCIRef:    ociRef(newManifest),
+	}
+	// Resources are only pruned by the Manifest controller if the installation layer changes.
+	if planned.FromOCIRef != planned.ToOCIRef {
+		pruned, err := s.prunedResources(ctx, manifestInCluster, newManifest)
+		if err != nil {
+			planned.Message = fmt.Sprintf("pruned resources could not be determined: %s", err)
+		}
+		planned.PrunedResources = pruned
+	}
+	return planned, nil
+}
+
+func (s *Service) prunedResources(ctx context.Context,
+	manifestInCluster, newManifest *v1beta2.Manifest,
+) ([]shared.Resource, error) {
+	manifestSpec, err := s.specResolver.GetSpec(ctx, newManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest spec: %w", err)
+	}
+	defer manifestSpec.Release()
+	skrClient, err := s.skrClient.ResolveClient(ctx, manifestInCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve skr client: %w", err)
+	}
+	target, err := s.renderService.RenderTargetResources(ctx, skrClient, newManifest, manifestSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render target resources: %w", err)
+	}
+	return skrresources.PrunedResources(manifestInCluster.Status.Synced, target, newManifest.Spec.DriftPolicy), nil
+}
+
+func (s *Service) getManifest(ctx context.Context, name, namespace string) (*v1beta2.Manifest, error) {
+	manifest := &v1beta2.Manifest{}
+	if err := s.kcpClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, manifest); err != nil {
+		if util.IsNotFound(err) {
+			return nil, nil //nolint:nilnil //use nil to indicate an empty Manifest
+		}
+		return nil, fmt.Errorf("error get manifest %s/%s: %w", namespace, name, err)
+	}
+	return manifest, nil
+}
+
+func plannedDeletion(moduleName string, manifestInCluster *v1beta2.Manifest) v1beta2.PlannedManifest {
+	return v1beta2.PlannedManifest{
+		Module:          moduleName,
+		Name:            manifestInCluster.GetName(),
+		Action:          v1beta2.PlanActionDelete,
+		FromVersion:     manifestInCluster.Spec.Version,
+		FromOCIRef:      ociRef(manifestInCluster),
+		PrunedResources: skrresources.PrunedResources(manifestInCluster.Status.Synced, nil, nil),
+	}
+}
+
+func ociRef(manifest *v1beta2.Manifest) string {
+	var imageSpec v1beta2.ImageSpec
+	if err := yaml.Unmarshal(manifest.Spec.Install.Source.Raw, &imageSpec); err != nil {
+		return ""
+	}
+	return imageSpec.Ref
+}
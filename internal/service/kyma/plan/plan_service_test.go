@@ -0,0 +1,215 @@
+package plan_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/plan"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
+	"github.com/kyma-project/lifecycle-manager/internal/service/skrclient"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+const kymaNamespace = "kcp-system"
+
+var (
+	deployment = shared.Resource{
+		GroupVersionKind: apimetav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Name:             "manager",
+		Namespace:        "kyma-system",
+	}
+	configMap = shared.Resource{
+		GroupVersionKind: apimetav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+		Name:             "config",
+		Namespace:        "kyma-system",
+	}
+	kymaSystemNamespace = shared.Resource{
+		GroupVersionKind: apimetav1.GroupVersionKind{Version: "v1", Kind: "Namespace"},
+		Name:             shared.DefaultRemoteNamespace,
+	}
+)
+
+func TestService_Plan(t *testing.T) {
+	t.Parallel()
+
+	kyma := newKyma()
+	kyma.Spec.Modules = []v1beta2.Module{{Name: "istio"}, {Name: "serverless"}, {Name: "keda"}}
+	kyma.Status.Modules = []v1beta2.ModuleStatus{
+		{Name: "serverless", Manifest: trackingObject("kyma-serverless")},
+		{Name: "keda", Manifest: trackingObject("kyma-keda")},
+		{Name: "eventing", Manifest: trackingObject("kyma-eventing")},
+	}
+
+	kcpClient := newKCPClient(t,
+		newManifest("kyma-serverless", "1.0.0", "serverless:1.0.0", deployment, configMap, kymaSystemNamespace),
+		newManifest("kyma-keda", "1.0.0", "keda:1.0.0", deployment),
+		newManifest("kyma-eventing", "1.0.0", "eventing:1.0.0", deployment, kymaSystemNamespace),
+	)
+	renderService := &renderServiceStub{target: []*unstructured.Unstructured{deployment.ToUnstructured()}}
+	modules := modulecommon.Modules{
+		newModule("istio", newManifest("kyma-istio", "1.2.0", "istio:1.2.0")),
+		newModule("serverless", newManifest("kyma-serverless", "1.1.0", "serverless:1.1.0")),
+		newModule("keda", newManifest("kyma-keda", "1.0.0", "keda:1.0.0")),
+	}
+
+	result, err := plan.NewService(kcpClient, &specResolverStub{}, &skrClientStub{}, renderService).
+		Plan(t.Context(), kyma, modules)
+
+	require.NoError(t, err)
+	assert.Equal(t, []v1beta2.PlannedManifest{
+		{
+			Module:          "eventing",
+			Name:            "kyma-eventing",
+			Action:          v1beta2.PlanActionDelete,
+			FromVersion:     "1.0.0",
+			FromOCIRef:      "eventing:1.0.0",
+			PrunedResources: []shared.Resource{deployment},
+		},
+		{
+			Module:    "istio",
+			Name:      "kyma-istio",
+			Action:    v1beta2.PlanActionCreate,
+			ToVersion: "1.2.0",
+			ToOCIRef:  "istio:1.2.0",
+		},
+		{
+			Module:          "serverless",
+			Name:            "kyma-serverless",
+			Action:          v1beta2.PlanActionUpdate,
+			FromVersion:     "1.0.0",
+			ToVersion:       "1.1.0",
+			FromOCIRef:      "serverless:1.0.0",
+			ToOCIRef:        "serverless:1.1.0",
+			PrunedResources: []shared.Resource{configMap},
+		},
+	}, result.Manifests)
+	assert.Equal(t, 1, renderService.calls)
+}
+
+func TestService_Plan_ReportsUnknownPrunedResources(t *testing.T) {
+	t.Parallel()
+
+	kyma := newKyma()
+	kyma.Spec.Modules = []v1beta2.Module{{Name: "serverless"}}
+	kcpClient := newKCPClient(t, newManifest("kyma-serverless", "1.0.0", "serverless:1.0.0", deployment))
+	renderService := &renderServiceStub{err: errors.New("layer not found")}
+	modules := modulecommon.Modules{
+		newModule("serverless", newManifest("kyma-serverless", "1.1.0", "serverless:1.1.0")),
+	}
+
+	result, err := plan.NewService(kcpClient, &specResolverStub{}, &skrClientStub{}, renderService).
+		Plan(t.Context(), kyma, modules)
+
+	require.NoError(t, err)
+	require.Len(t, result.Manifests, 1)
+	assert.Equal(t, v1beta2.PlanActionUpdate, result.Manifests[0].Action)
+	assert.Empty(t, result.Manifests[0].PrunedResources)
+	assert.Contains(t, result.Manifests[0].Message, "layer not found")
+}
+
+func TestService_Plan_SkipsModulesInError(t *testing.T) {
+	t.Parallel()
+
+	kyma := newKyma()
+	kyma.Spec.Modules = []v1beta2.Module{{Name: "serverless"}}
+	module := newModule("serverless", newManifest("kyma-serverless", "1.1.0", "serverless:1.1.0"))
+	module.TemplateInfo.Err = errors.New("no template found")
+
+	result, err := plan.NewService(newKCPClient(t), &specResolverStub{}, &skrClientStub{}, &renderServiceStub{}).
+		Plan(t.Context(), kyma, modulecommon.Modules{module})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Manifests)
+}
+
+func newKyma() *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{}
+	kyma.SetName("kyma")
+	kyma.SetNamespace(kymaNamespace)
+	kyma.Spec.Channel = "regular"
+	return kyma
+}
+
+func newKCPClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newManifest(name, version, ref string, synced ...shared.Resource) *v1beta2.Manifest {
+	manifest := &v1beta2.Manifest{}
+	manifest.SetName(name)
+	manifest.SetNamespace(kymaNamespace)
+	manifest.SetLabels(map[string]string{shared.ChannelLabel: "regular"})
+	manifest.Spec.Version = version
+	manifest.Spec.Install.Source = machineryruntime.RawExtension{
+		Raw: []byte(`{"type":"oci-ref","ref":"` + ref + `"}`),
+	}
+	manifest.Status.Synced = synced
+	return manifest
+}
+
+func newModule(name string, manifest *v1beta2.Manifest) *modulecommon.Module {
+	template := &v1beta2.ModuleTemplate{}
+	template.Spec.Version = manifest.Spec.Version
+	return &modulecommon.Module{
+		ModuleName: name,
+		Enabled:    true,
+		Manifest:   manifest,
+		TemplateInfo: &templatelookup.ModuleTemplateInfo{
+			ModuleTemplate: template,
+			DesiredChannel: "regular",
+		},
+	}
+}
+
+func trackingObject(name string) *v1beta2.TrackingObject {
+	return &v1beta2.TrackingObject{
+		PartialMeta: v1beta2.PartialMeta{Name: name, Namespace: kymaNamespace},
+	}
+}
+
+type specResolverStub struct{}
+
+func (*specResolverStub) GetSpec(_ context.Context, manifest *v1beta2.Manifest) (*spec.Spec, error) {
+	return &spec.Spec{ManifestName: manifest.GetName()}, nil
+}
+
+type skrClientStub struct{}
+
+func (*skrClientStub) ResolveClient(_ context.Context, _ *v1beta2.Manifest) (*skrclient.SKRClient, error) {
+	return &skrclient.SKRClient{}, nil
+}
+
+type renderServiceStub struct {
+	target []*unstructured.Unstructured
+	err    error
+	calls  int
+}
+
+func (r *renderServiceStub) RenderTargetResources(_ context.Context, _ skrclient.Client,
+	_ *v1beta2.Manifest, _ *spec.Spec,
+) ([]client.Object, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	objects := make([]client.Object, 0, len(r.target))
+	for _, obj := range r.target {
+		objects = append(objects, obj.DeepCopy())
+	}
+	return objects, nil
+}
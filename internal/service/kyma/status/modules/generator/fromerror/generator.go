@@ -5,6 +5,9 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/dependency"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
@@ -19,6 +22,12 @@ func GenerateModuleStatusFromError(err error, moduleName, desiredChannel, ocmCom
 		return nil, errFunctionCalledWitNilError
 	}
 
+	if errorIsWaitingForDependencies(err) {
+		newStatus := newDefaultErrorStatus(moduleName, desiredChannel, ocmComponentName, err)
+		newStatus.State = shared.StateProcessing
+		return newStatus, nil
+	}
+
 	if status == nil {
 		return newDefaultErrorStatus(moduleName, desiredChannel, ocmComponentName, err), nil
 	}
@@ -37,6 +46,27 @@ func GenerateModuleStatusFromError(err error, moduleName, desiredChannel, ocmCom
 		return newModuleStatus, nil
 	}
 
+	if errorIsUntrustedModule(err) {
+		newModuleStatus := status.DeepCopy()
+		newModuleStatus.Message = err.Error()
+		newModuleStatus.State = shared.StateError
+		return newModuleStatus, nil
+	}
+
+	if errorIsUnmetDependency(err) {
+		newModuleStatus := status.DeepCopy()
+		newModuleStatus.Message = err.Error()
+		newModuleStatus.State = shared.StateError
+		return newModuleStatus, nil
+	}
+
+	if errorIsRequiredByOtherModules(err) {
+		newModuleStatus := status.DeepCopy()
+		newModuleStatus.Message = err.Error()
+		newModuleStatus.State = shared.StateWarning
+		return newModuleStatus, nil
+	}
+
 	if errorIsForbiddenTemplateUpdate(err) {
 		newModuleStatus := status.DeepCopy()
 		newModuleStatus.Message = err.Error()
@@ -66,6 +96,25 @@ func errorIsForbiddenTemplateUpdate(err error) bool {
 		errors.Is(err, templatelookup.ErrNoModuleReleaseMeta)
 }
 
+// errorIsUntrustedModule reports whether the module version failed the verification of its descriptor signature
+// or layer digests. The status of the installed version is kept, so that the untrusted version is not rolled out.
+func errorIsUntrustedModule(err error) bool {
+	return errors.Is(err, provider.ErrUntrustedDescriptor) || errors.Is(err, img.ErrLayerDigestMismatch) ||
+		errors.Is(err, img.ErrLayerDigestMissing)
+}
+
+func errorIsUnmetDependency(err error) bool {
+	return errors.Is(err, dependency.ErrUnmetDependencies)
+}
+
+func errorIsRequiredByOtherModules(err error) bool {
+	return errors.Is(err, dependency.ErrRequiredByOtherModules)
+}
+
+func errorIsWaitingForDependencies(err error) bool {
+	return errors.Is(err, dependency.ErrWaitingForDependencies)
+}
+
 func errorIsTemplateNotFound(err error) bool {
 	return errors.Is(err, common.ErrNoTemplatesInListResult)
 }
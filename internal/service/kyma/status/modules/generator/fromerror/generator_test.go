@@ -2,6 +2,7 @@ package fromerror_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,7 +11,10 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator/fromerror"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/dependency"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
@@ -184,6 +188,75 @@ func TestGenerateModuleStatusFromError_WhenCalledWithNoTemplatesInListResultErro
 	assert.Nil(t, result.Template)
 }
 
+func TestGenerateModuleStatusFromError_WhenCalledWithUnmetDependenciesError_ReturnsDeepCopyAndStateError(
+	t *testing.T,
+) {
+	status := createStatus()
+	templateError := fmt.Errorf("%w: required module istio is not enabled", dependency.ErrUnmetDependencies)
+
+	result, err := fromerror.GenerateModuleStatusFromError(templateError, "some-module", "some-channel",
+		"example.org/some-module/backend", status)
+
+	require.NoError(t, err)
+	expectedStatus := status.DeepCopy()
+	expectedStatus.Message = templateError.Error()
+	expectedStatus.State = shared.StateError
+	assert.Equal(t, expectedStatus, result)
+}
+
+func TestGenerateModuleStatusFromError_WhenCalledWithUntrustedModuleError_ReturnsDeepCopyAndStateError(
+	t *testing.T,
+) {
+	for _, templateError := range []error{
+		fmt.Errorf("failed to get descriptor: %w: no trusted signature", provider.ErrUntrustedDescriptor),
+		fmt.Errorf("could not parse descriptor: %w: resource raw-manifest", img.ErrLayerDigestMismatch),
+		fmt.Errorf("could not parse descriptor: %w: resource raw-manifest", img.ErrLayerDigestMissing),
+	} {
+		status := createStatus()
+
+		result, err := fromerror.GenerateModuleStatusFromError(templateError, "some-module", "some-channel",
+			"example.org/some-module/backend", status)
+
+		require.NoError(t, err)
+		expectedStatus := status.DeepCopy()
+		expectedStatus.Message = templateError.Error()
+		expectedStatus.State = shared.StateError
+		assert.Equal(t, expectedStatus, result)
+	}
+}
+
+func TestGenerateModuleStatusFromError_WhenCalledWithRequiredByOtherModulesError_ReturnsDeepCopyAndStateWarning(
+	t *testing.T,
+) {
+	status := createStatus()
+	templateError := fmt.Errorf("%w: serverless", dependency.ErrRequiredByOtherModules)
+
+	result, err := fromerror.GenerateModuleStatusFromError(templateError, "some-module", "some-channel",
+		"example.org/some-module/backend", status)
+
+	require.NoError(t, err)
+	expectedStatus := status.DeepCopy()
+	expectedStatus.Message = templateError.Error()
+	expectedStatus.State = shared.StateWarning
+	assert.Equal(t, expectedStatus, result)
+}
+
+func TestGenerateModuleStatusFromError_WhenCalledWithWaitingForDependenciesError_ReturnsNewStatusWithStateProcessing(
+	t *testing.T,
+) {
+	expectedModuleName := "some-module"
+	templateError := fmt.Errorf("%w: istio", dependency.ErrWaitingForDependencies)
+
+	result, err := fromerror.GenerateModuleStatusFromError(templateError, expectedModuleName, "some-channel",
+		"example.org/some-module/backend", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedModuleName, result.Name)
+	assert.Equal(t, shared.StateProcessing, result.State)
+	assert.Equal(t, templateError.Error(), result.Message)
+	assert.Nil(t, result.Manifest)
+}
+
 func TestGenerateModuleStatusFromError_WhenCalledWithoutTemplateError_ReturnsErr(t *testing.T) {
 	_, err := fromerror.GenerateModuleStatusFromError(nil, "", "", "", &v1beta2.ModuleStatus{})
 	require.Error(t, err)
@@ -7,7 +7,10 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/moduledeprecation"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerevocation"
 	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 )
 
 var (
@@ -80,6 +83,23 @@ func (m *ModuleStatusGenerator) GenerateModuleStatus(module *modulecommon.Module
 		},
 	}
 
+	moduleStatus.RolledBackFrom = rolledBackFrom(module.TemplateInfo, currentStatus, moduleStatus.Version)
+
+	// A module that keeps running a revoked version works, but needs attention.
+	if module.TemplateInfo.Revocation != nil && moduleStatus.State == shared.StateReady {
+		moduleStatus.State = shared.StateWarning
+		moduleStatus.Message = modulerevocation.Message(module.TemplateInfo.Revocation)
+	}
+	if len(module.TemplateInfo.Deprecations) > 0 && moduleStatus.Message == "" {
+		moduleStatus.Message = moduledeprecation.Message(module.TemplateInfo.Deprecations)
+	}
+
+	// While deleting, the last operation of the Manifest explains what blocks the deletion,
+	// e.g. module CRs or associated resources that still exist.
+	if manifest.Status.State == shared.StateDeleting {
+		moduleStatus.Message = manifest.Status.LastOperation.Operation
+	}
+
 	if manifest.Spec.Resource != nil &&
 		manifest.Spec.CustomResourcePolicy == v1beta2.CustomResourcePolicyCreateAndDelete {
 		moduleCRAPIVersion, moduleCRKind := manifest.Spec.Resource.GetObjectKind().
@@ -108,3 +128,17 @@ func (m *ModuleStatusGenerator) GenerateModuleStatus(module *modulecommon.Module
 
 	return moduleStatus, nil
 }
+
+// rolledBackFrom returns the version the module is rolled back from. A recorded rollback is kept as long as the
+// module stays on the version it was rolled back to.
+func rolledBackFrom(templateInfo *templatelookup.ModuleTemplateInfo, currentStatus *v1beta2.ModuleStatus,
+	version string,
+) string {
+	if templateInfo.RollbackFrom != "" {
+		return templateInfo.RollbackFrom
+	}
+	if currentStatus != nil && currentStatus.Version == version {
+		return currentStatus.RolledBackFrom
+	}
+	return ""
+}
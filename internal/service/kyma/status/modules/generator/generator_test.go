@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator"
+	"github.com/kyma-project/lifecycle-manager/internal/service/moduledeprecation"
 	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/testutils/builder"
@@ -187,6 +188,86 @@ func TestGenerateModuleStatus_WhenModuleIsUnmanaged_StateIsUnmanagedAndTrackingO
 	assert.Nil(t, result.Resource)
 }
 
+func TestGenerateModuleStatus_WhenManifestIsDeleting_MessageContainsLastOperation(t *testing.T) {
+	module := createModule()
+	module.Manifest.Status = shared.Status{State: shared.StateDeleting}.
+		WithOperation("waiting for associated resources deletion: Sample default/user-resource")
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module, &v1beta2.ModuleStatus{})
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateDeleting, result.State)
+	assert.Equal(t, "waiting for associated resources deletion: Sample default/user-resource", result.Message)
+}
+
+func TestGenerateModuleStatus_WhenModuleIsRolledBack_RecordsRollback(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "1.0.0"
+	module.TemplateInfo.RollbackFrom = "1.1.0"
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module, &v1beta2.ModuleStatus{Version: "1.1.0"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", result.Version)
+	assert.Equal(t, "1.1.0", result.RolledBackFrom)
+}
+
+func TestGenerateModuleStatus_WhenModuleStaysOnRolledBackVersion_KeepsRollback(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "1.0.0"
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module,
+		&v1beta2.ModuleStatus{Version: "1.0.0", RolledBackFrom: "1.1.0"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", result.RolledBackFrom)
+}
+
+func TestGenerateModuleStatus_WhenModuleIsUpdatedAfterRollback_ClearsRollback(t *testing.T) {
+	module := createModule()
+	module.Manifest.Spec.Version = "1.1.1"
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module,
+		&v1beta2.ModuleStatus{Version: "1.0.0", RolledBackFrom: "1.1.0"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.RolledBackFrom)
+}
+
+func TestGenerateModuleStatus_WhenModuleKeepsRevokedVersion_StateIsWarning(t *testing.T) {
+	module := createModule()
+	module.Manifest.Status = shared.Status{State: shared.StateReady}
+	module.TemplateInfo.Revocation = &v1beta2.Revocation{Version: "1.1.0", Reason: "data loss on upgrade"}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module, &v1beta2.ModuleStatus{})
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateWarning, result.State)
+	assert.Equal(t, "version 1.1.0 is revoked: data loss on upgrade", result.Message)
+}
+
+func TestGenerateModuleStatus_WhenModuleIsDeprecated_MessageAnnouncesDeprecation(t *testing.T) {
+	module := createModule()
+	module.Manifest.Status = shared.Status{State: shared.StateReady}
+	module.TemplateInfo.Deprecations = []moduledeprecation.Notice{
+		{ModuleName: "test-module", SunsetDate: "2027-01-31", Replacement: "other-module"},
+	}
+
+	statusGenerator := generator.NewModuleStatusGenerator(noOpGenerateFromError)
+	result, err := statusGenerator.GenerateModuleStatus(module, &v1beta2.ModuleStatus{})
+
+	require.NoError(t, err)
+	assert.Equal(t, shared.StateReady, result.State)
+	assert.Equal(t,
+		"module test-module is deprecated and no longer available from 2027-01-31, use module other-module instead",
+		result.Message)
+}
+
 // Resource creator helper functions
 
 func createModule() *modulecommon.Module {
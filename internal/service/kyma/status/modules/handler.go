@@ -99,6 +99,9 @@ func DeleteNoLongerExistingModuleStatus(ctx context.Context, kyma *v1beta2.Kyma,
 			delete(moduleStatusMap, moduleStatus.Name)
 		} else {
 			moduleStatus.State = stateFromManifest(manifestCR)
+			if moduleStatus.State == shared.StateDeleting {
+				moduleStatus.Message = operationFromManifest(manifestCR)
+			}
 		}
 	}
 	kyma.Status.Modules = convertToNewModuleStatus(moduleStatusMap)
@@ -116,6 +119,18 @@ func stateFromManifest(obj client.Object) shared.State {
 	}
 }
 
+func operationFromManifest(obj client.Object) string {
+	switch manifest := obj.(type) {
+	case *v1beta2.Manifest:
+		return manifest.Status.LastOperation.Operation
+	case *unstructured.Unstructured:
+		operation, _, _ := unstructured.NestedString(manifest.Object, "status", "lastOperation", "operation")
+		return operation
+	default:
+		return ""
+	}
+}
+
 func (m *StatusHandler) getModule(ctx context.Context, module client.Object) error {
 	err := m.kcpClient.Get(ctx, client.ObjectKey{Namespace: module.GetNamespace(), Name: module.GetName()}, module)
 	if err != nil {
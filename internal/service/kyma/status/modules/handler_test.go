@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -150,6 +151,28 @@ func TestDeleteNoLongerExistingModuleStatus(t *testing.T) {
 	}
 }
 
+func TestDeleteNoLongerExistingModuleStatus_WhenManifestIsDeleting_ReportsBlockingOperation(t *testing.T) {
+	t.Parallel()
+	const operation = "waiting for associated resources deletion: Sample default/user-resource"
+	kyma := testutils.NewTestKyma("test-kyma")
+	configureModuleInKyma(kyma, []string{}, []string{ModuleToBeRemoved})
+	moduleDeletingMock := func(_ context.Context, module client.Object) error {
+		manifest, ok := module.(*unstructured.Unstructured)
+		require.True(t, ok)
+		require.NoError(t, unstructured.SetNestedField(manifest.Object, string(shared.StateDeleting),
+			"status", "state"))
+		require.NoError(t, unstructured.SetNestedField(manifest.Object, operation,
+			"status", "lastOperation", "operation"))
+		return nil
+	}
+
+	modules.DeleteNoLongerExistingModuleStatus(t.Context(), kyma, moduleDeletingMock, nil)
+
+	require.Len(t, kyma.Status.Modules, 1)
+	assert.Equal(t, shared.StateDeleting, kyma.Status.Modules[0].State)
+	assert.Equal(t, operation, kyma.Status.Modules[0].Message)
+}
+
 func configureModuleInKyma(
 	kyma *v1beta2.Kyma,
 	modulesInKymaSpec, modulesInKymaStatus []string,
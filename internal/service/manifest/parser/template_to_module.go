@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"ocm.software/ocm/api/ocm"
+	"ocm.software/ocm/api/ocm/compdesc"
 	"ocm.software/ocm/api/ocm/extensions/accessmethods/ociartifact"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -14,6 +16,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types"
+	"github.com/kyma-project/lifecycle-manager/internal/imagerewrite"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
@@ -24,28 +27,57 @@ var (
 	ErrConvertingToImgOCI        = errors.New("failed converting layerRepresentation to *img.OCI")
 )
 
+const (
+	ociArtifactDigestHashAlgorithm = "SHA-256"
+	ociArtifactDigestNormalisation = "ociArtifactDigest/v1"
+	ociArtifactDigestPrefix        = "sha256:"
+)
+
+// ImageDigestResolver resolves the digests of images that are referenced by tag in the component descriptor
+// of a module version.
+type ImageDigestResolver interface {
+	Resolve(ctx context.Context, moduleVersion, imageReference string) (string, error)
+}
+
 type Parser struct {
 	client.Client
 
 	descriptorProvider  *provider.CachedDescriptorProvider
 	remoteSyncNamespace string
 	ociRegistry         string
+	imageDigestResolver ImageDigestResolver
 }
 
 func NewParser(clnt client.Client,
 	descriptorProvider *provider.CachedDescriptorProvider,
 	remoteSyncNamespace string,
 	ociRegistry string,
+	opts ...func(*Parser) *Parser,
 ) *Parser {
-	return &Parser{
+	parser := &Parser{
 		Client:              clnt,
 		descriptorProvider:  descriptorProvider,
 		remoteSyncNamespace: remoteSyncNamespace,
 		ociRegistry:         ociRegistry,
 	}
+	for _, opt := range opts {
+		parser = opt(parser)
+	}
+	return parser
 }
 
-func (p *Parser) GenerateModulesFromTemplates(kyma *v1beta2.Kyma, templates templatelookup.ModuleTemplatesByModuleName,
+// WithImageDigestResolver resolves the digests of images that have no digest in the component descriptor
+// with the given resolver. If the resolver is nil, such images are not pinned to a digest.
+func WithImageDigestResolver(resolver ImageDigestResolver) func(*Parser) *Parser {
+	return func(parser *Parser) *Parser {
+		parser.imageDigestResolver = resolver
+		return parser
+	}
+}
+
+func (p *Parser) GenerateModulesFromTemplates(ctx context.Context,
+	kyma *v1beta2.Kyma,
+	templates templatelookup.ModuleTemplatesByModuleName,
 ) modulecommon.Modules {
 	// First, we fetch the module spec from the template and use it to resolve it into an arbitrary object
 	// (since we do not know which module we are dealing with)
@@ -53,7 +85,7 @@ func (p *Parser) GenerateModulesFromTemplates(kyma *v1beta2.Kyma, templates temp
 
 	for _, module := range templatelookup.FetchModuleInfo(kyma) {
 		template := templates[module.Name]
-		modules = p.appendModuleWithInformation(module, kyma, template, modules)
+		modules = p.appendModuleWithInformation(ctx, module, kyma, template, modules)
 	}
 	return modules
 }
@@ -65,7 +97,7 @@ func (p *Parser) GenerateMandatoryModulesFromTemplates(ctx context.Context,
 	modules := make(modulecommon.Modules, 0)
 
 	for _, template := range templates {
-		modules = p.appendModuleWithInformation(templatelookup.ModuleInfo{
+		modules = p.appendModuleWithInformation(ctx, templatelookup.ModuleInfo{
 			Module: v1beta2.Module{
 				Name:                 template.Spec.ModuleName,
 				CustomResourcePolicy: v1beta2.CustomResourcePolicyCreateAndDelete,
@@ -77,8 +109,11 @@ func (p *Parser) GenerateMandatoryModulesFromTemplates(ctx context.Context,
 	return modules
 }
 
-func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, kyma *v1beta2.Kyma,
-	template *templatelookup.ModuleTemplateInfo, modules modulecommon.Modules,
+func (p *Parser) appendModuleWithInformation(ctx context.Context,
+	module templatelookup.ModuleInfo,
+	kyma *v1beta2.Kyma,
+	template *templatelookup.ModuleTemplateInfo,
+	modules modulecommon.Modules,
 ) modulecommon.Modules {
 	if template.Err != nil && !errors.Is(template.Err, templatelookup.ErrTemplateNotAllowed) {
 		modules = append(modules, &modulecommon.Module{
@@ -105,7 +140,17 @@ func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, k
 	setNameAndNamespaceIfEmpty(template, name, p.remoteSyncNamespace)
 	var manifest *v1beta2.Manifest
 	if manifest, err = newManifestFromTemplate(module.Module,
-		template.ModuleTemplate, descriptor, p.ociRegistry); err != nil {
+		template.ModuleTemplate, descriptor, p.ociRegistry, p.descriptorProvider.VerifiesDescriptors()); err != nil {
+		template.Err = err
+		modules = append(modules, &modulecommon.Module{
+			ModuleName:   module.Name,
+			TemplateInfo: template,
+			Enabled:      module.Enabled,
+			IsUnmanaged:  module.Unmanaged,
+		})
+		return modules
+	}
+	if manifest.Spec.ImageDigests, err = p.getImageDigestsFromDescriptor(ctx, descriptor); err != nil {
 		template.Err = err
 		modules = append(modules, &modulecommon.Module{
 			ModuleName:   module.Name,
@@ -115,6 +160,7 @@ func (p *Parser) appendModuleWithInformation(module templatelookup.ModuleInfo, k
 		})
 		return modules
 	}
+	manifest.Spec.Scheduling = kyma.Spec.Scheduling.DeepCopy()
 	// we name the manifest after the module name
 	manifest.SetName(name)
 	// to have correct owner references, the manifest must always have the same namespace as kyma
@@ -149,6 +195,7 @@ func newManifestFromTemplate(
 	template *v1beta2.ModuleTemplate,
 	descriptor *types.Descriptor,
 	ociRegistry string,
+	requireLayerDigests bool,
 ) (*v1beta2.Manifest, error) {
 	manifest := &v1beta2.Manifest{}
 	if manifest.Annotations == nil {
@@ -156,6 +203,9 @@ func newManifestFromTemplate(
 	}
 
 	manifest.Spec.CustomResourcePolicy = module.CustomResourcePolicy
+	if module.Values != nil {
+		manifest.Spec.Values = module.Values.DeepCopy()
+	}
 	if template.Spec.Data != nil {
 		manifest.Spec.Resource = template.Spec.Data.DeepCopy()
 	}
@@ -163,7 +213,13 @@ func newManifestFromTemplate(
 	var layers img.Layers
 	var err error
 
-	if layers, err = img.Parse(descriptor.ComponentDescriptor); err != nil {
+	// verified descriptors only bind the layers to their signatures if every layer has a digest
+	if requireLayerDigests {
+		layers, err = img.ParseWithLayerDigests(descriptor.ComponentDescriptor)
+	} else {
+		layers, err = img.Parse(descriptor.ComponentDescriptor)
+	}
+	if err != nil {
 		return nil, fmt.Errorf("could not parse descriptor: %w", err)
 	}
 
@@ -178,6 +234,18 @@ func newManifestFromTemplate(
 	if template.Spec.Manager != nil {
 		manifest.Spec.Manager = template.Spec.Manager.DeepCopy()
 	}
+	if len(template.Spec.AssociatedResources) > 0 {
+		manifest.Spec.AssociatedResources = slices.Clone(template.Spec.AssociatedResources)
+	}
+	for _, check := range template.Spec.CustomStateCheck {
+		if check != nil {
+			manifest.Spec.CustomStateCheck = append(manifest.Spec.CustomStateCheck, check.DeepCopy())
+		}
+	}
+	// the rules of the module in the Kyma come last, so that they take precedence over the ones of the template
+	for _, rule := range slices.Concat(template.Spec.DriftPolicy, module.DriftPolicy) {
+		manifest.Spec.DriftPolicy = append(manifest.Spec.DriftPolicy, *rule.DeepCopy())
+	}
 	return manifest, nil
 }
 
@@ -186,28 +254,77 @@ func getLocalizedImagesFromDescriptor(descriptor *types.Descriptor) []string {
 		return nil
 	}
 	localizedImages := make([]string, 0)
-	for _, resource := range descriptor.Resources {
-		access := resource.GetAccess()
-		ocmAccessSpec, err := ocm.DefaultContext().AccessSpecForSpec(access)
-		if err != nil {
-			logf.Log.Error(fmt.Errorf("failed to create ocm spec for access: %w", err),
-				"getLocalizedImagesFromDescriptor", "resourceName", resource.Name, "accessType", access.GetType())
-			continue
+	for i := range descriptor.Resources {
+		if imageReference := getOCIArtifactImageReference(&descriptor.Resources[i]); len(imageReference) > 0 {
+			localizedImages = append(localizedImages, imageReference)
 		}
+	}
+	return localizedImages
+}
 
-		if access.GetType() == ociartifact.Type {
-			ociAccessSpec, ok := ocmAccessSpec.(*ociartifact.AccessSpec)
-			if !ok {
-				logf.Log.Error(fmt.Errorf("%w: actual type: %T", ErrConvertingToOCIAccessSpec, access),
-					"getLocalizedImagesFromDescriptor")
-				continue
-			}
-			if len(ociAccessSpec.ImageReference) > 0 {
-				localizedImages = append(localizedImages, ociAccessSpec.ImageReference)
+// getImageDigestsFromDescriptor returns the image references of the descriptor pinned to their digests.
+// The digest is taken from the image reference or the digest of the resource. If the descriptor has neither,
+// it is resolved with the image digest resolver, if any.
+func (p *Parser) getImageDigestsFromDescriptor(ctx context.Context, descriptor *types.Descriptor) ([]string, error) {
+	if descriptor == nil || descriptor.ComponentDescriptor == nil {
+		return nil, nil
+	}
+	var imageDigests []string
+	for i := range descriptor.Resources {
+		resource := &descriptor.Resources[i]
+		imageReference := getOCIArtifactImageReference(resource)
+		if len(imageReference) == 0 {
+			continue
+		}
+		image, err := imagerewrite.NewDockerImageReference(imageReference)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image of resource %s: %w", resource.Name, err)
+		}
+		if len(image.Digest) == 0 {
+			image.Digest = getOCIArtifactDigest(resource)
+		}
+		if len(image.Digest) == 0 && p.imageDigestResolver != nil {
+			moduleVersion := descriptor.GetName() + ":" + descriptor.GetVersion()
+			if image.Digest, err = p.imageDigestResolver.Resolve(ctx, moduleVersion, imageReference); err != nil {
+				return nil, fmt.Errorf("%w: resource %s", err, resource.Name)
 			}
 		}
+		if len(image.Digest) > 0 {
+			imageDigests = append(imageDigests, image.String())
+		}
 	}
-	return localizedImages
+	return imageDigests, nil
+}
+
+// getOCIArtifactImageReference returns the image reference of resources with an ociArtifact access.
+func getOCIArtifactImageReference(resource *compdesc.Resource) string {
+	access := resource.GetAccess()
+	if access.GetType() != ociartifact.Type {
+		return ""
+	}
+	ocmAccessSpec, err := ocm.DefaultContext().AccessSpecForSpec(access)
+	if err != nil {
+		logf.Log.Error(fmt.Errorf("failed to create ocm spec for access: %w", err),
+			"getOCIArtifactImageReference", "resourceName", resource.Name, "accessType", access.GetType())
+		return ""
+	}
+	ociAccessSpec, ok := ocmAccessSpec.(*ociartifact.AccessSpec)
+	if !ok {
+		logf.Log.Error(fmt.Errorf("%w: actual type: %T", ErrConvertingToOCIAccessSpec, access),
+			"getOCIArtifactImageReference")
+		return ""
+	}
+	return ociAccessSpec.ImageReference
+}
+
+// getOCIArtifactDigest returns the digest of the image manifest recorded for the resource, if any.
+func getOCIArtifactDigest(resource *compdesc.Resource) string {
+	digest := resource.Digest
+	if digest == nil || digest.NormalisationAlgorithm != ociArtifactDigestNormalisation ||
+		digest.HashAlgorithm != ociArtifactDigestHashAlgorithm || len(digest.Value) == 0 {
+		return ""
+	}
+	return ociArtifactDigestPrefix + digest.Value
 }
 
 func translateLayersAndMergeIntoManifest(manifest *v1beta2.Manifest, layers img.Layers, ociRegistry string) error {
@@ -229,7 +346,7 @@ func insertLayerIntoManifest(manifest *v1beta2.Manifest, layer img.Layer, ociReg
 			return fmt.Errorf("error while parsing config layer: %w", err)
 		}
 		manifest.Spec.Config = imageSpec
-	case v1beta2.RawManifestLayer:
+	case v1beta2.RawManifestLayer, v1beta2.HelmChartLayer:
 		ociImage, ok := layer.LayerRepresentation.(*img.OCI)
 		if !ok {
 			return fmt.Errorf("%w: actual type: %T", ErrConvertingToImgOCI, layer.LayerRepresentation)
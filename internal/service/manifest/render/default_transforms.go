@@ -3,12 +3,14 @@ package render
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/imagerewrite"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/driftpolicy"
 	"github.com/kyma-project/lifecycle-manager/internal/util/collections"
 )
 
@@ -16,20 +18,41 @@ const (
 	DisclaimerAnnotation      = shared.OperatorGroup + shared.Separator + "managed-by-reconciler-disclaimer"
 	DisclaimerAnnotationValue = "DO NOT EDIT - This resource is managed by Kyma.\n" +
 		"Any modifications are discarded and the resource is reverted to the original state."
+	DisclaimerAnnotationValueKeptResource = "This resource is managed by Kyma.\n" +
+		"Modifications are kept, as the drift policy of the module does not revert them."
+	DisclaimerAnnotationValueKeptFields = "DO NOT EDIT - This resource is managed by Kyma.\n" +
+		"Any modifications, except for the ones of %s, are discarded and the resource is reverted to the " +
+		"original state."
 )
 
-func DisclaimerTransform(_ context.Context, _ *v1beta2.Manifest, resources []*unstructured.Unstructured) error {
+// DisclaimerTransform annotates the provided resources with a disclaimer that modifications are reverted, which
+// names the fields or the resource whose modifications the drift policy in the Spec.DriftPolicy field in the
+// Manifest object keeps.
+func DisclaimerTransform(_ context.Context, manifest *v1beta2.Manifest,
+	resources []*unstructured.Unstructured,
+) error {
+	policy := driftpolicy.Policy(manifest.Spec.DriftPolicy)
 	for _, resource := range resources {
 		annotations := resource.GetAnnotations()
 		if annotations == nil {
 			annotations = make(map[string]string)
 		}
-		annotations[DisclaimerAnnotation] = DisclaimerAnnotationValue
+		annotations[DisclaimerAnnotation] = disclaimer(policy, resource)
 		resource.SetAnnotations(annotations)
 	}
 	return nil
 }
 
+func disclaimer(policy driftpolicy.Policy, resource *unstructured.Unstructured) string {
+	if policy.ResourceAction(resource) != v1beta2.DriftActionRevert {
+		return DisclaimerAnnotationValueKeptResource
+	}
+	if keptPaths := policy.KeptPaths(resource); len(keptPaths) > 0 {
+		return fmt.Sprintf(DisclaimerAnnotationValueKeptFields, strings.Join(keptPaths, ", "))
+	}
+	return DisclaimerAnnotationValue
+}
+
 // DockerImageLocalizationTransform rewrites Docker images in the provided resources
 // according to the Spec.LocalizedImages field in the Manifest object.
 func DockerImageLocalizationTransform(_ context.Context, manifest *v1beta2.Manifest,
@@ -62,6 +85,38 @@ func DockerImageLocalizationTransform(_ context.Context, manifest *v1beta2.Manif
 	return nil
 }
 
+// DockerImageDigestTransform pins Docker images in the provided resources to the digests
+// listed in the Spec.ImageDigests field in the Manifest object. It runs after the
+// DockerImageLocalizationTransform, as the localized images keep the digests of the original ones.
+func DockerImageDigestTransform(_ context.Context, manifest *v1beta2.Manifest,
+	resources []*unstructured.Unstructured,
+) error {
+	if len(manifest.Spec.ImageDigests) == 0 {
+		return nil // No images to pin
+	}
+
+	imageDigests, err := imagerewrite.AsImageReferences(manifest.Spec.ImageDigests)
+	if err != nil {
+		return fmt.Errorf("failed to parse image digests: %w", err)
+	}
+
+	rewriter := (&imagerewrite.ResourceRewriter{}).WithRewriters(
+		&imagerewrite.PodContainerImageDigestRewriter{},
+	)
+
+	for _, resource := range resources {
+		if err = rewriter.ReplaceImages(resource, imageDigests); err != nil {
+			return fmt.Errorf(
+				"failed to pin images in resource %s/%s: %w",
+				resource.GetNamespace(),
+				resource.GetName(),
+				err,
+			)
+		}
+	}
+	return nil
+}
+
 func KymaComponentTransform(_ context.Context, manifest *v1beta2.Manifest,
 	resources []*unstructured.Unstructured,
 ) error {
@@ -95,5 +150,7 @@ func GetDefaultResourceTransforms() []ResourceTransform {
 		KymaComponentTransform,
 		DisclaimerTransform,
 		DockerImageLocalizationTransform,
+		DockerImageDigestTransform,
+		SchedulingPolicyTransform,
 	}
 }
@@ -118,15 +118,49 @@ func Test_defaultTransforms(t *testing.T) {
 	}
 }
 
+func TestDisclaimerTransform_NamesKeptModifications(t *testing.T) {
+	t.Parallel()
+	manifest := &v1beta2.Manifest{}
+	manifest.Spec.DriftPolicy = []v1beta2.DriftRule{
+		{Group: "apps", Kind: "Deployment", Paths: []string{"spec.replicas"}, Action: v1beta2.DriftActionIgnore},
+		{Kind: "ConfigMap", Name: "tuned-config", Action: v1beta2.DriftActionReport},
+	}
+	deployment := &unstructured.Unstructured{}
+	deployment.SetAPIVersion("apps/v1")
+	deployment.SetKind("Deployment")
+	deployment.SetName("manager")
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName("tuned-config")
+	service := &unstructured.Unstructured{}
+	service.SetAPIVersion("v1")
+	service.SetKind("Service")
+	service.SetName("manager")
+
+	err := render.DisclaimerTransform(t.Context(), manifest,
+		[]*unstructured.Unstructured{deployment, configMap, service})
+
+	require.NoError(t, err)
+	assert.Equal(t, "DO NOT EDIT - This resource is managed by Kyma.\n"+
+		"Any modifications, except for the ones of spec.replicas, are discarded and the resource is reverted to "+
+		"the original state.", deployment.GetAnnotations()[render.DisclaimerAnnotation])
+	assert.Equal(t, render.DisclaimerAnnotationValueKeptResource,
+		configMap.GetAnnotations()[render.DisclaimerAnnotation])
+	assert.Equal(t, render.DisclaimerAnnotationValue, service.GetAnnotations()[render.DisclaimerAnnotation])
+}
+
 func TestGetDefaultResourceTransforms(t *testing.T) {
 	t.Parallel()
 	transforms := render.GetDefaultResourceTransforms()
-	require.Len(t, transforms, 4)
+	require.Len(t, transforms, 6)
 	expected := []uintptr{
 		reflect.ValueOf(render.ManagedByOwnedBy).Pointer(),
 		reflect.ValueOf(render.KymaComponentTransform).Pointer(),
 		reflect.ValueOf(render.DisclaimerTransform).Pointer(),
 		reflect.ValueOf(render.DockerImageLocalizationTransform).Pointer(),
+		reflect.ValueOf(render.DockerImageDigestTransform).Pointer(),
+		reflect.ValueOf(render.SchedulingPolicyTransform).Pointer(),
 	}
 	for i, tr := range transforms {
 		require.Equal(t, expected[i], reflect.ValueOf(tr).Pointer())
@@ -57,6 +57,42 @@ func TestDockerImageLocalizationTransform_InvalidLocalizedImageReturnsError(t *t
 	assert.ErrorContains(t, err, "failed to parse localized images")
 }
 
+func TestDockerImageDigestTransform_PinsLocalizedImages(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:c4bb6cae028ee580295d527663d62d5b1ac23a70dd73f7efed2c7ecbdc48a834"
+	manifest := &v1beta2.Manifest{
+		Spec: v1beta2.ManifestSpec{
+			LocalizedImages: []string{"private-registry.com/prod/template-operator:1.0.3"},
+			ImageDigests:    []string{"europe-docker.pkg.dev/kyma-project/prod/template-operator:1.0.3@" + digest},
+		},
+	}
+	resources := []*unstructured.Unstructured{
+		deploymentWithImage(t, "manager", "europe-docker.pkg.dev/kyma-project/prod/template-operator:1.0.3"),
+	}
+
+	require.NoError(t, render.DockerImageLocalizationTransform(t.Context(), manifest, resources))
+	require.NoError(t, render.DockerImageDigestTransform(t.Context(), manifest, resources))
+
+	containers, found, err := unstructured.NestedSlice(resources[0].Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "private-registry.com/prod/template-operator:1.0.3@"+digest,
+		containers[0].(map[string]any)["image"])
+}
+
+func TestDockerImageDigestTransform_InvalidImageDigestReturnsError(t *testing.T) {
+	t.Parallel()
+
+	manifest := &v1beta2.Manifest{
+		Spec: v1beta2.ManifestSpec{ImageDigests: []string{"::not a valid ref::"}},
+	}
+
+	err := render.DockerImageDigestTransform(t.Context(), manifest, nil)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "failed to parse image digests")
+}
+
 func deploymentWithImage(t *testing.T, container, image string) *unstructured.Unstructured {
 	t.Helper()
 	d := &unstructured.Unstructured{
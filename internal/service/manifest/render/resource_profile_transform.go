@@ -0,0 +1,127 @@
+package render
+
+import (
+	"context"
+	"fmt"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
+)
+
+// CreateResourceProfileTransform sizes the Deployments and StatefulSets of a module with the profile for the plan
+// of the Kyma runtime, which the Manifest carries in its broker-plan-name label. Manifests of runtimes without a
+// profile for their plan are left as rendered.
+func CreateResourceProfileTransform(profiles resourceprofile.Profiles) ResourceTransform {
+	return func(_ context.Context, manifest *v1beta2.Manifest, resources []*unstructured.Unstructured) error {
+		profile, ok := profiles.For(manifest.GetLabels()[shared.PlanLabel], manifest.GetLabels()[shared.ModuleName])
+		if !ok {
+			return nil
+		}
+		for _, resource := range resources {
+			if !isWorkloadResource(resource.GetKind()) {
+				continue
+			}
+			if err := applyResourceProfile(resource, &profile); err != nil {
+				return fmt.Errorf("failed to apply resource profile to resource %s/%s: %w",
+					resource.GetNamespace(), resource.GetName(), err)
+			}
+		}
+		return nil
+	}
+}
+
+func applyResourceProfile(resource *unstructured.Unstructured, profile *resourceprofile.WorkloadProfile) error {
+	if profile.Replicas != nil {
+		if err := unstructured.SetNestedField(resource.Object, int64(*profile.Replicas), "spec", "replicas"); err != nil {
+			return fmt.Errorf("failed to set replicas: %w", err)
+		}
+	}
+	if len(profile.Requests) == 0 && len(profile.Limits) == 0 {
+		return nil
+	}
+	podSpec, err := getWorkloadPodSpec(resource)
+	if err != nil {
+		return err
+	}
+	containers, _ := podSpec["containers"].([]any)
+	for _, container := range containers {
+		containerMap, ok := container.(map[string]any)
+		if !ok {
+			continue
+		}
+		if err := applyContainerResources(containerMap, profile); err != nil {
+			return fmt.Errorf("failed to apply resources to container %v: %w", containerMap["name"], err)
+		}
+	}
+	return setWorkloadPodSpec(resource, podSpec)
+}
+
+// applyContainerResources replaces the requests and limits of the container with the ones of the profile. Where this
+// leaves a request above its limit, the value that was not set by the profile is aligned to the one that was, so
+// that the container stays valid.
+func applyContainerResources(container map[string]any, profile *resourceprofile.WorkloadProfile) error {
+	resources, _ := container["resources"].(map[string]any)
+	if resources == nil {
+		resources = map[string]any{}
+	}
+	requests, _ := resources["requests"].(map[string]any)
+	if requests == nil {
+		requests = map[string]any{}
+	}
+	limits, _ := resources["limits"].(map[string]any)
+	if limits == nil {
+		limits = map[string]any{}
+	}
+	for name, quantity := range profile.Requests {
+		requests[string(name)] = quantity.String()
+	}
+	for name, quantity := range profile.Limits {
+		limits[string(name)] = quantity.String()
+	}
+
+	for name, requestValue := range requests {
+		limitValue, ok := limits[name]
+		if !ok {
+			continue
+		}
+		request, err := parseQuantity(requestValue)
+		if err != nil {
+			return fmt.Errorf("invalid request of %s: %w", name, err)
+		}
+		limit, err := parseQuantity(limitValue)
+		if err != nil {
+			return fmt.Errorf("invalid limit of %s: %w", name, err)
+		}
+		if request.Cmp(limit) <= 0 {
+			continue
+		}
+		if _, limitFromProfile := profile.Limits[apicorev1.ResourceName(name)]; limitFromProfile {
+			requests[name] = limit.String()
+		} else {
+			limits[name] = request.String()
+		}
+	}
+
+	if len(requests) > 0 {
+		resources["requests"] = requests
+	}
+	if len(limits) > 0 {
+		resources["limits"] = limits
+	}
+	container["resources"] = resources
+	return nil
+}
+
+// parseQuantity parses a quantity of a rendered manifest, in which plain numbers are decoded as numbers.
+func parseQuantity(value any) (resource.Quantity, error) {
+	quantity, err := resource.ParseQuantity(fmt.Sprint(value))
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("failed to parse quantity %v: %w", value, err)
+	}
+	return quantity, nil
+}
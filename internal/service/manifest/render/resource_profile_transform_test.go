@@ -0,0 +1,101 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/resourceprofile"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/render"
+)
+
+func TestResourceProfileTransform_AppliesProfileOfPlan(t *testing.T) {
+	t.Parallel()
+
+	replicas := int32(1)
+	transform := render.CreateResourceProfileTransform(resourceprofile.Profiles{
+		"trial": {WorkloadProfile: resourceprofile.WorkloadProfile{
+			Replicas: &replicas,
+			Requests: apicorev1.ResourceList{apicorev1.ResourceCPU: resource.MustParse("10m")},
+			Limits:   apicorev1.ResourceList{apicorev1.ResourceMemory: resource.MustParse("128Mi")},
+		}},
+	})
+	deployment := deploymentWithImage(t, "manager", "registry.example/manager:1.0.0")
+	setPodSpecFields(t, deployment, map[string]any{
+		"containers": []any{map[string]any{
+			"name":  "manager",
+			"image": "registry.example/manager:1.0.0",
+			"resources": map[string]any{
+				"requests": map[string]any{"cpu": "500m", "memory": "256Mi"},
+				"limits":   map[string]any{"cpu": int64(1), "memory": "512Mi"},
+			},
+		}},
+	}, "spec", "template", "spec")
+
+	require.NoError(t, transform(t.Context(), manifestOfPlan("trial"), []*unstructured.Unstructured{deployment}))
+
+	replicasInSpec, _, err := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), replicasInSpec)
+	containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	resources, _ := containers[0].(map[string]any)["resources"].(map[string]any)
+	// the memory request is lowered to the memory limit of the profile
+	assert.Equal(t, map[string]any{"cpu": "10m", "memory": "128Mi"}, resources["requests"])
+	assert.Equal(t, map[string]any{"cpu": int64(1), "memory": "128Mi"}, resources["limits"])
+}
+
+func TestResourceProfileTransform_RaisesLimitBelowRequestOfProfile(t *testing.T) {
+	t.Parallel()
+
+	transform := render.CreateResourceProfileTransform(resourceprofile.Profiles{
+		"production": {WorkloadProfile: resourceprofile.WorkloadProfile{
+			Requests: apicorev1.ResourceList{apicorev1.ResourceCPU: resource.MustParse("2")},
+		}},
+	})
+	deployment := deploymentWithImage(t, "manager", "registry.example/manager:1.0.0")
+	setPodSpecFields(t, deployment, map[string]any{
+		"containers": []any{map[string]any{
+			"name":      "manager",
+			"resources": map[string]any{"limits": map[string]any{"cpu": "1"}},
+		}},
+	}, "spec", "template", "spec")
+
+	require.NoError(t, transform(t.Context(), manifestOfPlan("production"), []*unstructured.Unstructured{deployment}))
+
+	containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	resources, _ := containers[0].(map[string]any)["resources"].(map[string]any)
+	assert.Equal(t, map[string]any{"cpu": "2"}, resources["limits"])
+	_, found, _ := unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas")
+	assert.False(t, found)
+}
+
+func TestResourceProfileTransform_WithoutProfileForPlanIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	replicas := int32(1)
+	transform := render.CreateResourceProfileTransform(resourceprofile.Profiles{
+		"trial": {WorkloadProfile: resourceprofile.WorkloadProfile{Replicas: &replicas}},
+	})
+	resources := []*unstructured.Unstructured{deploymentWithImage(t, "manager", "registry.example/manager:1.0.0")}
+	expected := resources[0].DeepCopy()
+
+	require.NoError(t, transform(t.Context(), manifestOfPlan("production"), resources))
+	require.NoError(t, transform(t.Context(), &v1beta2.Manifest{}, resources))
+
+	assert.Equal(t, expected, resources[0])
+}
+
+func manifestOfPlan(plan string) *v1beta2.Manifest {
+	return &v1beta2.Manifest{ObjectMeta: apimetav1.ObjectMeta{
+		Labels: map[string]string{shared.PlanLabel: plan, shared.ModuleName: "template-operator"},
+	}}
+}
@@ -0,0 +1,200 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/imagerewrite"
+)
+
+// immutablePodSpecKinds are the kinds whose Pod spec can not be changed once created, so that a changed scheduling
+// policy could not be applied to them.
+var immutablePodSpecKinds = []string{"Pod", "Job"}
+
+// SchedulingPolicyTransform applies the scheduling policy in the Spec.Scheduling field in the Manifest object,
+// which is taken from the Kyma, to the Pod specs of the provided resources. Only the scheduling fields of the
+// Pod specs are changed, so that the remaining fields are applied as rendered. Pods and Jobs are left as rendered,
+// as their Pod specs are immutable, and DaemonSets keep running on all nodes.
+func SchedulingPolicyTransform(_ context.Context, manifest *v1beta2.Manifest,
+	resources []*unstructured.Unstructured,
+) error {
+	policy := manifest.Spec.Scheduling
+	if policy == nil {
+		return nil // No policy to apply
+	}
+
+	for _, resource := range resources {
+		path, ok := imagerewrite.PodSpecPath(resource.GetKind())
+		if !ok || slices.Contains(immutablePodSpecKinds, resource.GetKind()) {
+			continue
+		}
+		resourcePolicy := policy
+		if resource.GetKind() == "DaemonSet" {
+			resourcePolicy = nodeIndependentPolicy(policy)
+		}
+		podSpec, found, err := unstructured.NestedMap(resource.Object, path...)
+		if err != nil {
+			return fmt.Errorf("failed to get pod spec for resource %s/%s: %w",
+				resource.GetNamespace(), resource.GetName(), err)
+		}
+		if !found {
+			continue
+		}
+		podLabels, _, _ := unstructured.NestedStringMap(resource.Object, podLabelsPath(path)...)
+		if err = applySchedulingPolicy(podSpec, resourcePolicy, podLabels); err != nil {
+			return fmt.Errorf("failed to apply scheduling policy to resource %s/%s: %w",
+				resource.GetNamespace(), resource.GetName(), err)
+		}
+		if err = unstructured.SetNestedMap(resource.Object, podSpec, path...); err != nil {
+			return fmt.Errorf("failed to set pod spec for resource %s/%s: %w",
+				resource.GetNamespace(), resource.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// nodeIndependentPolicy returns the part of the policy that does not restrict the nodes the Pods run on, which is
+// applied to DaemonSets, as their Pods have to run on every node.
+func nodeIndependentPolicy(policy *v1beta2.SchedulingPolicy) *v1beta2.SchedulingPolicy {
+	return &v1beta2.SchedulingPolicy{
+		Tolerations:       policy.Tolerations,
+		PriorityClassName: policy.PriorityClassName,
+	}
+}
+
+// podLabelsPath returns the path of the Pod labels next to the Pod spec at podSpecPath.
+func podLabelsPath(podSpecPath []string) []string {
+	return append(slices.Clone(podSpecPath[:len(podSpecPath)-1]), "metadata", "labels")
+}
+
+func applySchedulingPolicy(podSpec map[string]any, policy *v1beta2.SchedulingPolicy,
+	podLabels map[string]string,
+) error {
+	if err := mergeTolerations(podSpec, policy.Tolerations); err != nil {
+		return err
+	}
+	if len(policy.NodeSelector) > 0 {
+		nodeSelector, _ := podSpec["nodeSelector"].(map[string]any)
+		if nodeSelector == nil {
+			nodeSelector = make(map[string]any, len(policy.NodeSelector))
+		}
+		for key, value := range policy.NodeSelector {
+			nodeSelector[key] = value
+		}
+		podSpec["nodeSelector"] = nodeSelector
+	}
+	if err := mergeAffinity(podSpec, policy.Affinity); err != nil {
+		return err
+	}
+	if policy.PriorityClassName != "" {
+		podSpec["priorityClassName"] = policy.PriorityClassName
+		// the priority is resolved from the priority class on admission and must not contradict it
+		delete(podSpec, "priority")
+	}
+	return mergeTopologySpreadConstraints(podSpec, policy.TopologySpreadConstraints, podLabels)
+}
+
+func mergeTolerations(podSpec map[string]any, tolerations []apicorev1.Toleration) error {
+	if len(tolerations) == 0 {
+		return nil
+	}
+	existing, _ := podSpec["tolerations"].([]any)
+	for i := range tolerations {
+		if containsToleration(existing, &tolerations[i]) {
+			continue
+		}
+		toleration, err := machineryruntime.DefaultUnstructuredConverter.ToUnstructured(&tolerations[i])
+		if err != nil {
+			return fmt.Errorf("failed to convert toleration: %w", err)
+		}
+		existing = append(existing, toleration)
+	}
+	podSpec["tolerations"] = existing
+	return nil
+}
+
+func containsToleration(tolerations []any, toleration *apicorev1.Toleration) bool {
+	for _, item := range tolerations {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		var existing apicorev1.Toleration
+		if err := machineryruntime.DefaultUnstructuredConverter.FromUnstructured(itemMap, &existing); err != nil {
+			continue
+		}
+		if equality.Semantic.DeepEqual(existing, *toleration) {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeAffinity(podSpec map[string]any, affinity *apicorev1.Affinity) error {
+	if affinity == nil {
+		return nil
+	}
+	policyAffinity, err := machineryruntime.DefaultUnstructuredConverter.ToUnstructured(affinity)
+	if err != nil {
+		return fmt.Errorf("failed to convert affinity: %w", err)
+	}
+	if len(policyAffinity) == 0 {
+		return nil
+	}
+	existing, _ := podSpec["affinity"].(map[string]any)
+	if existing == nil {
+		existing = make(map[string]any, len(policyAffinity))
+	}
+	// nodeAffinity, podAffinity and podAntiAffinity are replaced as a whole, as their terms can not be merged
+	// without changing their meaning
+	for key, value := range policyAffinity {
+		existing[key] = value
+	}
+	podSpec["affinity"] = existing
+	return nil
+}
+
+func mergeTopologySpreadConstraints(podSpec map[string]any, constraints []apicorev1.TopologySpreadConstraint,
+	podLabels map[string]string,
+) error {
+	if len(constraints) == 0 {
+		return nil
+	}
+	existing, _ := podSpec["topologySpreadConstraints"].([]any)
+	for i := range constraints {
+		constraint := constraints[i].DeepCopy()
+		if constraint.LabelSelector == nil && len(podLabels) > 0 {
+			constraint.LabelSelector = &apimetav1.LabelSelector{MatchLabels: podLabels}
+		}
+		converted, err := machineryruntime.DefaultUnstructuredConverter.ToUnstructured(constraint)
+		if err != nil {
+			return fmt.Errorf("failed to convert topology spread constraint: %w", err)
+		}
+		existing = slices.DeleteFunc(existing, func(item any) bool {
+			itemMap, _ := item.(map[string]any)
+			return topologySpreadConstraintKey(itemMap) == topologySpreadConstraintKey(converted)
+		})
+		existing = append(existing, converted)
+	}
+	podSpec["topologySpreadConstraints"] = existing
+	return nil
+}
+
+// topologySpreadConstraintKey identifies a topology spread constraint in a Pod spec, which holds at most one
+// constraint per topology key and whenUnsatisfiable action.
+func topologySpreadConstraintKey(constraint map[string]any) string {
+	topologyKey, _ := constraint["topologyKey"].(string)
+	whenUnsatisfiable, _ := constraint["whenUnsatisfiable"].(string)
+	if whenUnsatisfiable == "" {
+		whenUnsatisfiable = string(apicorev1.DoNotSchedule)
+	}
+	return topologyKey + "/" + whenUnsatisfiable
+}
@@ -0,0 +1,225 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apicorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/render"
+)
+
+func TestSchedulingPolicyTransform_NoPolicyIsNoOp(t *testing.T) {
+	t.Parallel()
+
+	resources := []*unstructured.Unstructured{deploymentWithImage(t, "manager", "registry.example/manager:1.0.0")}
+	expected := resources[0].DeepCopy()
+
+	require.NoError(t, render.SchedulingPolicyTransform(t.Context(), &v1beta2.Manifest{}, resources))
+
+	assert.Equal(t, expected, resources[0])
+}
+
+func TestSchedulingPolicyTransform_AppliesPolicyToPodSpec(t *testing.T) {
+	t.Parallel()
+
+	manifest := manifestWithSchedulingPolicy(&v1beta2.SchedulingPolicy{
+		Tolerations: []apicorev1.Toleration{
+			{Key: "kyma-project.io/system", Operator: apicorev1.TolerationOpExists, Effect: apicorev1.TaintEffectNoSchedule},
+		},
+		NodeSelector:      map[string]string{"worker.gardener.cloud/pool": "system"},
+		PriorityClassName: "kyma-system-priority",
+		Affinity: &apicorev1.Affinity{
+			NodeAffinity: &apicorev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &apicorev1.NodeSelector{
+					NodeSelectorTerms: []apicorev1.NodeSelectorTerm{{
+						MatchExpressions: []apicorev1.NodeSelectorRequirement{{
+							Key:      "topology.kubernetes.io/zone",
+							Operator: apicorev1.NodeSelectorOpIn,
+							Values:   []string{"eu-central-1a"},
+						}},
+					}},
+				},
+			},
+		},
+	})
+	resource := deploymentWithImage(t, "manager", "registry.example/manager:1.0.0")
+	podSpec := map[string]any{
+		"nodeSelector": map[string]any{"kubernetes.io/os": "linux", "worker.gardener.cloud/pool": "default"},
+		"tolerations": []any{
+			map[string]any{"key": "kyma-project.io/system", "operator": "Exists", "effect": "NoSchedule"},
+		},
+		"affinity": map[string]any{
+			"podAntiAffinity": map[string]any{"preferredDuringSchedulingIgnoredDuringExecution": []any{}},
+		},
+	}
+	setPodSpecFields(t, resource, podSpec, "spec", "template", "spec")
+
+	require.NoError(t, render.SchedulingPolicyTransform(t.Context(), manifest, []*unstructured.Unstructured{resource}))
+
+	spec, _, err := unstructured.NestedMap(resource.Object, "spec", "template", "spec")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"kubernetes.io/os": "linux", "worker.gardener.cloud/pool": "system"},
+		spec["nodeSelector"])
+	assert.Len(t, spec["tolerations"], 1)
+	assert.Equal(t, "kyma-system-priority", spec["priorityClassName"])
+	affinity, _ := spec["affinity"].(map[string]any)
+	assert.Contains(t, affinity, "nodeAffinity")
+	assert.Contains(t, affinity, "podAntiAffinity")
+	assert.NotEmpty(t, spec["containers"])
+}
+
+func TestSchedulingPolicyTransform_ReplacesTopologySpreadConstraintsWithSameKey(t *testing.T) {
+	t.Parallel()
+
+	manifest := manifestWithSchedulingPolicy(&v1beta2.SchedulingPolicy{
+		TopologySpreadConstraints: []apicorev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: apicorev1.DoNotSchedule,
+		}},
+	})
+	resource := deploymentWithImage(t, "manager", "registry.example/manager:1.0.0")
+	require.NoError(t, unstructured.SetNestedStringMap(resource.Object, map[string]string{"app": "manager"},
+		"spec", "template", "metadata", "labels"))
+	setPodSpecFields(t, resource, map[string]any{
+		"topologySpreadConstraints": []any{
+			map[string]any{"maxSkew": int64(3), "topologyKey": "topology.kubernetes.io/zone"},
+			map[string]any{
+				"maxSkew": int64(1), "topologyKey": "kubernetes.io/hostname", "whenUnsatisfiable": "ScheduleAnyway",
+			},
+		},
+	}, "spec", "template", "spec")
+
+	require.NoError(t, render.SchedulingPolicyTransform(t.Context(), manifest, []*unstructured.Unstructured{resource}))
+
+	constraints, _, err := unstructured.NestedSlice(resource.Object,
+		"spec", "template", "spec", "topologySpreadConstraints")
+	require.NoError(t, err)
+	require.Len(t, constraints, 2)
+	assert.Equal(t, "kubernetes.io/hostname", constraints[0].(map[string]any)["topologyKey"])
+	zone, _ := constraints[1].(map[string]any)
+	assert.Equal(t, int64(1), zone["maxSkew"])
+	assert.Equal(t, map[string]any{"matchLabels": map[string]any{"app": "manager"}}, zone["labelSelector"])
+}
+
+func TestSchedulingPolicyTransform_AppliesPolicyToCronJob(t *testing.T) {
+	t.Parallel()
+
+	manifest := manifestWithSchedulingPolicy(&v1beta2.SchedulingPolicy{PriorityClassName: "kyma-system-priority"})
+	cronJob := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "CronJob",
+		"metadata":   map[string]any{"name": "cleanup", "namespace": "default"},
+	}}
+	setPodSpecFields(t, cronJob, map[string]any{
+		"containers": []any{map[string]any{"name": "cleanup", "image": "registry.example/cleanup:1.0.0"}},
+	}, "spec", "jobTemplate", "spec", "template", "spec")
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "config", "namespace": "default"},
+		"data":       map[string]any{"spec": "value"},
+	}}
+	expectedConfigMap := configMap.DeepCopy()
+
+	require.NoError(t, render.SchedulingPolicyTransform(t.Context(), manifest,
+		[]*unstructured.Unstructured{cronJob, configMap}))
+
+	priorityClassName, _, err := unstructured.NestedString(cronJob.Object,
+		"spec", "jobTemplate", "spec", "template", "spec", "priorityClassName")
+	require.NoError(t, err)
+	assert.Equal(t, "kyma-system-priority", priorityClassName)
+	assert.Equal(t, expectedConfigMap, configMap)
+}
+
+func TestSchedulingPolicyTransform_AppliesOnlyTolerationsAndPriorityToDaemonSet(t *testing.T) {
+	t.Parallel()
+
+	manifest := manifestWithSchedulingPolicy(&v1beta2.SchedulingPolicy{
+		Tolerations: []apicorev1.Toleration{
+			{Key: "kyma-project.io/system", Operator: apicorev1.TolerationOpExists, Effect: apicorev1.TaintEffectNoSchedule},
+		},
+		NodeSelector:      map[string]string{"worker.gardener.cloud/pool": "system"},
+		PriorityClassName: "kyma-system-priority",
+		Affinity: &apicorev1.Affinity{
+			PodAntiAffinity: &apicorev1.PodAntiAffinity{},
+		},
+		TopologySpreadConstraints: []apicorev1.TopologySpreadConstraint{{
+			MaxSkew:     1,
+			TopologyKey: "topology.kubernetes.io/zone",
+		}},
+	})
+	daemonSet := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "DaemonSet",
+		"metadata":   map[string]any{"name": "agent", "namespace": "default"},
+	}}
+	setPodSpecFields(t, daemonSet, map[string]any{
+		"containers": []any{map[string]any{"name": "agent", "image": "registry.example/agent:1.0.0"}},
+	}, "spec", "template", "spec")
+
+	require.NoError(t, render.SchedulingPolicyTransform(t.Context(), manifest,
+		[]*unstructured.Unstructured{daemonSet}))
+
+	spec, _, err := unstructured.NestedMap(daemonSet.Object, "spec", "template", "spec")
+	require.NoError(t, err)
+	assert.Len(t, spec["tolerations"], 1)
+	assert.Equal(t, "kyma-system-priority", spec["priorityClassName"])
+	assert.NotContains(t, spec, "nodeSelector")
+	assert.NotContains(t, spec, "affinity")
+	assert.NotContains(t, spec, "topologySpreadConstraints")
+}
+
+func TestSchedulingPolicyTransform_SkipsImmutablePodSpecs(t *testing.T) {
+	t.Parallel()
+
+	manifest := manifestWithSchedulingPolicy(&v1beta2.SchedulingPolicy{
+		NodeSelector:      map[string]string{"worker.gardener.cloud/pool": "system"},
+		PriorityClassName: "kyma-system-priority",
+	})
+	job := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]any{"name": "migration", "namespace": "default"},
+	}}
+	setPodSpecFields(t, job, map[string]any{
+		"containers": []any{map[string]any{"name": "migration", "image": "registry.example/migration:1.0.0"}},
+	}, "spec", "template", "spec")
+	pod := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]any{"name": "debug", "namespace": "default"},
+	}}
+	setPodSpecFields(t, pod, map[string]any{
+		"containers": []any{map[string]any{"name": "debug", "image": "registry.example/debug:1.0.0"}},
+	}, "spec")
+	expectedJob := job.DeepCopy()
+	expectedPod := pod.DeepCopy()
+
+	require.NoError(t, render.SchedulingPolicyTransform(t.Context(), manifest,
+		[]*unstructured.Unstructured{job, pod}))
+
+	assert.Equal(t, expectedJob, job)
+	assert.Equal(t, expectedPod, pod)
+}
+
+func manifestWithSchedulingPolicy(policy *v1beta2.SchedulingPolicy) *v1beta2.Manifest {
+	return &v1beta2.Manifest{Spec: v1beta2.ManifestSpec{Scheduling: policy}}
+}
+
+func setPodSpecFields(t *testing.T, resource *unstructured.Unstructured, fields map[string]any, path ...string) {
+	t.Helper()
+	podSpec, _, err := unstructured.NestedMap(resource.Object, path...)
+	require.NoError(t, err)
+	if podSpec == nil {
+		podSpec = map[string]any{}
+	}
+	for key, value := range fields {
+		podSpec[key] = value
+	}
+	require.NoError(t, unstructured.SetNestedMap(resource.Object, podSpec, path...))
+}
@@ -2,38 +2,63 @@ package spec
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 )
 
+// valuesDigestLength is the number of hex characters of the values digest appended to the OCIRef of a rendered
+// Helm chart.
+const valuesDigestLength = 12
+
 type KeyChainLookup interface {
 	Get(ctx context.Context) (authn.Keychain, error)
 }
 
+// PathExtractor returns the paths of the layers of an image spec. Each path stays valid until the returned func is
+// called.
 type PathExtractor interface {
-	GetPathFromRawManifest(ctx context.Context, imageSpec v1beta2.ImageSpec, keyChain authn.Keychain) (string, error)
+	GetPathFromRawManifest(ctx context.Context, imageSpec v1beta2.ImageSpec,
+		keyChain authn.Keychain) (string, func(), error)
+	GetPathFromConfig(ctx context.Context, imageSpec v1beta2.ImageSpec,
+		keyChain authn.Keychain) (string, func(), error)
+	GetPathFromHelmChart(ctx context.Context, imageSpec v1beta2.ImageSpec,
+		keyChain authn.Keychain) (string, func(), error)
+}
+
+// ChartRenderer renders the Helm chart archive at chartPath with the given values and returns the path of a
+// file containing the rendered resources, which stays valid until the returned func is called.
+type ChartRenderer interface {
+	RenderChart(chartPath, releaseName, namespace string, values map[string]any) (string, func(), error)
 }
 
 type Resolver struct {
 	keyChainLookup        KeyChainLookup
 	manifestPathExtractor PathExtractor
+	chartRenderer         ChartRenderer
 }
 
-func NewResolver(kcLookup KeyChainLookup, extractor PathExtractor) *Resolver {
+func NewResolver(kcLookup KeyChainLookup, extractor PathExtractor, chartRenderer ChartRenderer) *Resolver {
 	return &Resolver{
 		keyChainLookup:        kcLookup,
 		manifestPathExtractor: extractor,
+		chartRenderer:         chartRenderer,
 	}
 }
 
 var ErrRenderModeInvalid = errors.New("render mode is invalid")
 
+// GetSpec resolves the installation layer of the Manifest. The returned Spec must be released once its file is read.
 func (s *Resolver) GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*Spec, error) {
 	var imageSpec v1beta2.ImageSpec
 	if err := yaml.Unmarshal(manifest.Spec.Install.Source.Raw, &imageSpec); err != nil {
@@ -50,7 +75,11 @@ func (s *Resolver) GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*Sp
 		return nil, fmt.Errorf("failed to fetch keyChain: %w", err)
 	}
 
-	rawManifestPath, err := s.manifestPathExtractor.GetPathFromRawManifest(ctx, imageSpec, keyChain)
+	if manifest.Spec.Install.Name == string(v1beta2.HelmChartLayer) {
+		return s.getHelmChartSpec(ctx, manifest, imageSpec, keyChain)
+	}
+
+	rawManifestPath, release, err := s.manifestPathExtractor.GetPathFromRawManifest(ctx, imageSpec, keyChain)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract raw manifest from layer digest: %w", err)
 	}
@@ -59,5 +88,110 @@ func (s *Resolver) GetSpec(ctx context.Context, manifest *v1beta2.Manifest) (*Sp
 		ManifestName: manifest.Spec.Install.Name,
 		Path:         rawManifestPath,
 		OCIRef:       imageSpec.Ref,
+		release:      release,
+	}, nil
+}
+
+// getHelmChartSpec renders the Helm chart layer with the values of the config layer, overridden by the values of
+// the Manifest. As the rendered resources depend on the values, the digest of the values is appended to the OCIRef,
+// so that a change of the values is handled like a change of the installation layer. The config layer is released
+// before the chart layer is fetched, as both can share the same layer cache entry, and the chart layer is released
+// once the chart is rendered.
+func (s *Resolver) getHelmChartSpec(ctx context.Context, manifest *v1beta2.Manifest,
+	imageSpec v1beta2.ImageSpec, keyChain authn.Keychain,
+) (*Spec, error) {
+	values, err := s.getValues(ctx, manifest, keyChain)
+	if err != nil {
+		return nil, err
+	}
+
+	ociRef := imageSpec.Ref
+	if len(values) > 0 {
+		digest, err := valuesDigest(values)
+		if err != nil {
+			return nil, err
+		}
+		ociRef = fmt.Sprintf("%s+values-%s", imageSpec.Ref, digest)
+	}
+
+	chartPath, releaseChart, err := s.manifestPathExtractor.GetPathFromHelmChart(ctx, imageSpec, keyChain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract helm chart from layer digest: %w", err)
+	}
+	defer releaseChart()
+
+	renderedPath, release, err := s.chartRenderer.RenderChart(chartPath, releaseName(manifest),
+		shared.DefaultRemoteNamespace, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render helm chart: %w", err)
+	}
+
+	return &Spec{
+		ManifestName: manifest.Spec.Install.Name,
+		Path:         renderedPath,
+		OCIRef:       ociRef,
+		release:      release,
 	}, nil
 }
+
+func (s *Resolver) getValues(ctx context.Context, manifest *v1beta2.Manifest,
+	keyChain authn.Keychain,
+) (map[string]any, error) {
+	values := map[string]any{}
+	if manifest.Spec.Config != nil {
+		configPath, releaseConfig, err := s.manifestPathExtractor.GetPathFromConfig(ctx, *manifest.Spec.Config,
+			keyChain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract config from layer digest: %w", err)
+		}
+		content, err := os.ReadFile(configPath)
+		releaseConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config layer: %w", err)
+		}
+		if err := yaml.Unmarshal(content, &values); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal values of config layer: %w", err)
+		}
+	}
+
+	if manifest.Spec.Values != nil && len(manifest.Spec.Values.Raw) > 0 {
+		overrides := map[string]any{}
+		if err := json.Unmarshal(manifest.Spec.Values.Raw, &overrides); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal values of manifest: %w", err)
+		}
+		values = mergeValues(values, overrides)
+	}
+	return values, nil
+}
+
+// mergeValues merges the overrides into the base values. Nested maps are merged recursively, all other values of
+// the overrides replace the base values.
+func mergeValues(base, overrides map[string]any) map[string]any {
+	for key, override := range overrides {
+		overrideMap, overrideIsMap := override.(map[string]any)
+		baseMap, baseIsMap := base[key].(map[string]any)
+		if overrideIsMap && baseIsMap {
+			base[key] = mergeValues(baseMap, overrideMap)
+			continue
+		}
+		base[key] = override
+	}
+	return base
+}
+
+func releaseName(manifest *v1beta2.Manifest) string {
+	if moduleName, found := manifest.GetLabels()[shared.ModuleName]; found && moduleName != "" {
+		return moduleName
+	}
+	return manifest.GetName()
+}
+
+func valuesDigest(values map[string]any) (string, error) {
+	// maps are marshalled with sorted keys, so the digest is stable
+	content, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal values: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:valuesDigestLength], nil
+}
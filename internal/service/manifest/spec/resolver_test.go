@@ -3,20 +3,25 @@ package spec_test
 import (
 	"context"
 	"errors"
+	"os"
 	"path"
 	"strings"
 	"testing"
 
 	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/service/manifest/spec"
 )
 
 const (
 	mockLocalFileCachePath = "/some/local/path"
+	renderedPath           = "/some/local/path/rendered.yaml"
 	testManifest           = `
 apiVersion: operator.kyma-project.io/v1beta2
 kind: Manifest
@@ -47,7 +52,8 @@ spec:
 func Test_GetSpec(t *testing.T) {
 	t.Run("should return a Spec with the correct fields", func(t *testing.T) {
 		// given
-		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{})
+		pathExtractor := &mockPathExtractor{}
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, pathExtractor, &mockChartRenderer{})
 
 		// when
 		mft := v1beta2.Manifest{}
@@ -58,18 +64,18 @@ func Test_GetSpec(t *testing.T) {
 		require.NoError(t, err)
 
 		// then
-		expected := &spec.Spec{
-			ManifestName: mft.Spec.Install.Name,
-			Path:         testPath(),
-			OCIRef:       "sha256:c49b23729d7f12e25a44bbc9c0fb226f998cb443802af4793b4faea79a9bac40",
-		}
-
-		require.Equal(t, expected, actual)
+		assert.Equal(t, mft.Spec.Install.Name, actual.ManifestName)
+		assert.Equal(t, testPath(), actual.Path)
+		assert.Equal(t, "sha256:c49b23729d7f12e25a44bbc9c0fb226f998cb443802af4793b4faea79a9bac40", actual.OCIRef)
+		assert.Zero(t, pathExtractor.released, "the raw manifest must be held until the Spec is released")
+		actual.Release()
+		actual.Release()
+		assert.Equal(t, 1, pathExtractor.released)
 	})
 
 	t.Run("should return an error with incorrect render mode", func(t *testing.T) {
 		// given
-		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{})
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{}, &mockChartRenderer{})
 
 		invalidManifest := strings.ReplaceAll(testManifest, "type: oci-ref", "type: invalid-ref")
 
@@ -86,7 +92,7 @@ func Test_GetSpec(t *testing.T) {
 	t.Run("should return an error when keyChainLookup fails", func(t *testing.T) {
 		// given
 		errorKeychainLookup := &mockKeyChainLookup{mockError: errors.New("unexpected")}
-		specResolver := spec.NewResolver(errorKeychainLookup, &mockPathExtractor{})
+		specResolver := spec.NewResolver(errorKeychainLookup, &mockPathExtractor{}, &mockChartRenderer{})
 
 		// when
 		mft := v1beta2.Manifest{}
@@ -100,7 +106,7 @@ func Test_GetSpec(t *testing.T) {
 	t.Run("should return an error when pathExtractor fails", func(t *testing.T) {
 		// given
 		errorPathExtractor := &mockPathExtractor{mockError: errors.New("unexpected")}
-		specResolver := spec.NewResolver(&mockKeyChainLookup{}, errorPathExtractor)
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, errorPathExtractor, &mockChartRenderer{})
 
 		// when
 		mft := v1beta2.Manifest{}
@@ -112,6 +118,95 @@ func Test_GetSpec(t *testing.T) {
 	})
 }
 
+func Test_GetSpec_HelmChart(t *testing.T) {
+	const chartRef = "sha256:c49b23729d7f12e25a44bbc9c0fb226f998cb443802af4793b4faea79a9bac40"
+
+	t.Run("should render the helm chart with the config values overridden by the manifest values", func(t *testing.T) {
+		// given
+		configPath := path.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(configPath,
+			[]byte("replicas: 1\nimage:\n  tag: 1.0.0\n  pullPolicy: Always\n"), 0o600))
+		renderer := &mockChartRenderer{}
+		pathExtractor := &mockPathExtractor{configPath: configPath}
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, pathExtractor, renderer)
+		mft := helmChartManifest(t)
+		mft.Spec.Config = &v1beta2.ImageSpec{Ref: "sha256:config", Type: v1beta2.OciRefType}
+		mft.Spec.Values = &machineryruntime.RawExtension{Raw: []byte(`{"image":{"tag":"1.1.0"}}`)}
+
+		// when
+		actual, err := specResolver.GetSpec(t.Context(), &mft)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, string(v1beta2.HelmChartLayer), actual.ManifestName)
+		assert.Equal(t, renderedPath, actual.Path)
+		assert.Regexp(t, "^"+chartRef+`\+values-[0-9a-f]{12}$`, actual.OCIRef)
+		assert.Equal(t, path.Join(mockLocalFileCachePath, "helm-chart.tgz"), renderer.chartPath)
+		assert.Equal(t, "template-operator", renderer.releaseName)
+		assert.Equal(t, "kyma-system", renderer.namespace)
+		assert.Equal(t, map[string]any{
+			"replicas": float64(1),
+			"image":    map[string]any{"tag": "1.1.0", "pullPolicy": "Always"},
+		}, renderer.values)
+		assert.Equal(t, 2, pathExtractor.released, "the config and chart layers must be released after rendering")
+		assert.Zero(t, renderer.released, "the rendered chart must be held until the Spec is released")
+		actual.Release()
+		assert.Equal(t, 1, renderer.released)
+	})
+
+	t.Run("should keep the layer ref when the chart is rendered without values", func(t *testing.T) {
+		// given
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{}, &mockChartRenderer{})
+		mft := helmChartManifest(t)
+
+		// when
+		actual, err := specResolver.GetSpec(t.Context(), &mft)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, chartRef, actual.OCIRef)
+	})
+
+	t.Run("should change the ref when the values change", func(t *testing.T) {
+		// given
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{}, &mockChartRenderer{})
+		mft := helmChartManifest(t)
+		mft.Spec.Values = &machineryruntime.RawExtension{Raw: []byte(`{"replicas":1}`)}
+		first, err := specResolver.GetSpec(t.Context(), &mft)
+		require.NoError(t, err)
+
+		// when
+		mft.Spec.Values = &machineryruntime.RawExtension{Raw: []byte(`{"replicas":2}`)}
+		second, err := specResolver.GetSpec(t.Context(), &mft)
+
+		// then
+		require.NoError(t, err)
+		assert.NotEqual(t, first.OCIRef, second.OCIRef)
+	})
+
+	t.Run("should return an error when rendering fails", func(t *testing.T) {
+		// given
+		specResolver := spec.NewResolver(&mockKeyChainLookup{}, &mockPathExtractor{},
+			&mockChartRenderer{mockError: errors.New("parse error in deployment.yaml")})
+		mft := helmChartManifest(t)
+
+		// when
+		_, err := specResolver.GetSpec(t.Context(), &mft)
+
+		// then
+		require.ErrorContains(t, err, "failed to render helm chart: parse error in deployment.yaml")
+	})
+}
+
+func helmChartManifest(t *testing.T) v1beta2.Manifest {
+	t.Helper()
+	mft := v1beta2.Manifest{}
+	require.NoError(t, yaml.Unmarshal([]byte(strings.ReplaceAll(testManifest,
+		"name: raw-manifest", "name: helm-chart")), &mft))
+	mft.SetLabels(map[string]string{shared.ModuleName: "template-operator"})
+	return mft
+}
+
 type mockKeyChainLookup struct {
 	mockError error
 }
@@ -121,18 +216,65 @@ func (m *mockKeyChainLookup) Get(_ context.Context) (authn.Keychain, error) {
 }
 
 type mockPathExtractor struct {
-	mockError error
+	mockError  error
+	configPath string
+	released   int
 }
 
 func (m *mockPathExtractor) GetPathFromRawManifest(
 	_ context.Context,
 	_ v1beta2.ImageSpec,
 	_ authn.Keychain,
-) (string, error) {
+) (string, func(), error) {
+	if m.mockError != nil {
+		return "", nil, m.mockError
+	}
+	return testPath(), m.release, nil
+}
+
+func (m *mockPathExtractor) GetPathFromConfig(
+	_ context.Context,
+	_ v1beta2.ImageSpec,
+	_ authn.Keychain,
+) (string, func(), error) {
+	if m.mockError != nil {
+		return "", nil, m.mockError
+	}
+	return m.configPath, m.release, nil
+}
+
+func (m *mockPathExtractor) GetPathFromHelmChart(
+	_ context.Context,
+	_ v1beta2.ImageSpec,
+	_ authn.Keychain,
+) (string, func(), error) {
+	if m.mockError != nil {
+		return "", nil, m.mockError
+	}
+	return path.Join(mockLocalFileCachePath, string(v1beta2.HelmChartLayer+".tgz")), m.release, nil
+}
+
+func (m *mockPathExtractor) release() {
+	m.released++
+}
+
+type mockChartRenderer struct {
+	mockError   error
+	chartPath   string
+	releaseName string
+	namespace   string
+	values      map[string]any
+	released    int
+}
+
+func (m *mockChartRenderer) RenderChart(chartPath, releaseName, namespace string,
+	values map[string]any,
+) (string, func(), error) {
+	m.chartPath, m.releaseName, m.namespace, m.values = chartPath, releaseName, namespace, values
 	if m.mockError != nil {
-		return "", m.mockError
+		return "", nil, m.mockError
 	}
-	return testPath(), nil
+	return renderedPath, func() { m.released++ }, nil
 }
 
 func testPath() string {
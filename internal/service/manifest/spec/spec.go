@@ -3,9 +3,25 @@ package spec
 // Spec describes the resolved location and identity of a Manifest's installation
 // layer. It is produced by Resolver.GetSpec and consumed by the manifest parser
 // (to look up the manifest YAML on disk) and by the manifest controller (to
-// detect OCI ref changes via the synced-OCI-ref annotation).
+// detect OCI ref changes via the synced-OCI-ref annotation). For a Helm chart
+// layer, Path points to the rendered chart and OCIRef includes the digest of
+// the values it was rendered with. The file at Path is held in the layer cache
+// until the Spec is released.
 type Spec struct {
 	ManifestName string
 	Path         string
 	OCIRef       string
+
+	release func()
+}
+
+// Release lets the layer cache evict or replace the file at Path again. It must
+// be called once the file is read, and can safely be called more than once.
+func (s *Spec) Release() {
+	if s == nil || s.release == nil {
+		return
+	}
+	release := s.release
+	s.release = nil
+	release()
 }
@@ -0,0 +1,117 @@
+// Package moduledeprecation describes the deprecations of modules and module channels announced in the
+// ModuleReleaseMeta to the Kymas that use them.
+package moduledeprecation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const (
+	messageSeparator       = "; "
+	conditionMessagePrefix = v1beta2.ConditionMessageModuleDeprecated + ": "
+)
+
+var ErrDeprecated = errors.New("deprecated module or channel in use")
+
+// Notice is the deprecation of a module, or of the module channel a Kyma uses if Channel is set.
+type Notice struct {
+	ModuleName  string
+	Channel     string
+	SunsetDate  string
+	Replacement string
+}
+
+// Notices returns the deprecations of the module and of the channel that apply to a Kyma using the channel.
+func Notices(mrm *v1beta2.ModuleReleaseMeta, channel string) []Notice {
+	var notices []Notice
+	if deprecation := mrm.Spec.Deprecation; deprecation != nil && deprecation.Deprecated {
+		notices = append(notices, Notice{
+			ModuleName:  mrm.Spec.ModuleName,
+			SunsetDate:  deprecation.SunsetDate,
+			Replacement: deprecation.Replacement,
+		})
+	}
+	for _, assignment := range mrm.Spec.Channels {
+		if assignment.Channel != channel || assignment.Deprecation == nil || !assignment.Deprecation.Deprecated {
+			continue
+		}
+		notices = append(notices, Notice{
+			ModuleName:  mrm.Spec.ModuleName,
+			Channel:     channel,
+			SunsetDate:  assignment.Deprecation.SunsetDate,
+			Replacement: assignment.Deprecation.Replacement,
+		})
+	}
+	return notices
+}
+
+// Message describes the deprecation for users of the module.
+func (n Notice) Message() string {
+	var message strings.Builder
+	if n.Channel == "" {
+		fmt.Fprintf(&message, "module %s is deprecated", n.ModuleName)
+	} else {
+		fmt.Fprintf(&message, "channel %s of module %s is deprecated", n.Channel, n.ModuleName)
+	}
+	if n.SunsetDate != "" {
+		fmt.Fprintf(&message, " and no longer available from %s", n.SunsetDate)
+	}
+	if n.Replacement != "" {
+		kind := "module"
+		if n.Channel != "" {
+			kind = "channel"
+		}
+		fmt.Fprintf(&message, ", use %s %s instead", kind, n.Replacement)
+	}
+	return message.String()
+}
+
+// Message describes all deprecations for the status of the module.
+func Message(notices []Notice) string {
+	messages := make([]string, 0, len(notices))
+	for _, notice := range notices {
+		messages = append(messages, notice.Message())
+	}
+	return strings.Join(messages, messageSeparator)
+}
+
+// Announce records the deprecations in the ModuleDeprecation condition of the Kyma and returns the ones the
+// condition did not record yet, so that each deprecation is announced once. The module messages are not suited for
+// this, as they may report more urgent issues instead, for example a revoked version. The condition is removed if
+// there are no deprecations.
+func Announce(kyma *v1beta2.Kyma, notices []Notice) []Notice {
+	conditionType := string(v1beta2.ConditionTypeModuleDeprecation)
+	var announced []string
+	if condition := meta.FindStatusCondition(kyma.Status.Conditions, conditionType); condition != nil {
+		if messages, found := strings.CutPrefix(condition.Message, conditionMessagePrefix); found {
+			announced = strings.Split(messages, messageSeparator)
+		}
+	}
+	if len(notices) == 0 {
+		meta.RemoveStatusCondition(&kyma.Status.Conditions, conditionType)
+		return nil
+	}
+
+	var unannounced []Notice
+	for _, notice := range notices {
+		if !slices.Contains(announced, notice.Message()) {
+			unannounced = append(unannounced, notice)
+		}
+	}
+	meta.SetStatusCondition(&kyma.Status.Conditions, apimetav1.Condition{
+		Type:               conditionType,
+		Status:             apimetav1.ConditionTrue,
+		Reason:             string(v1beta2.ConditionReason),
+		Message:            conditionMessagePrefix + Message(notices),
+		ObservedGeneration: kyma.GetGeneration(),
+	})
+	return unannounced
+}
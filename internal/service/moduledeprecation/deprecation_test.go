@@ -0,0 +1,101 @@
+package moduledeprecation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/moduledeprecation"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerevocation"
+)
+
+func TestNotices(t *testing.T) {
+	t.Parallel()
+	mrm := &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: "keda",
+			Deprecation: &v1beta2.Deprecation{
+				Deprecated:  true,
+				SunsetDate:  "2027-01-31",
+				Replacement: "keda-v2",
+			},
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{Channel: "regular", Version: "1.0.0"},
+				{
+					Channel:     "fast",
+					Version:     "1.1.0",
+					Deprecation: &v1beta2.Deprecation{Deprecated: true, Replacement: "regular"},
+				},
+				{
+					Channel:     "experimental",
+					Version:     "1.2.0",
+					Deprecation: &v1beta2.Deprecation{Deprecated: false, Replacement: "fast"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []moduledeprecation.Notice{
+		{ModuleName: "keda", SunsetDate: "2027-01-31", Replacement: "keda-v2"},
+	}, moduledeprecation.Notices(mrm, "regular"))
+	assert.Equal(t, []moduledeprecation.Notice{
+		{ModuleName: "keda", SunsetDate: "2027-01-31", Replacement: "keda-v2"},
+		{ModuleName: "keda", Channel: "fast", Replacement: "regular"},
+	}, moduledeprecation.Notices(mrm, "fast"))
+
+	mrm.Spec.Deprecation.Deprecated = false
+	assert.Empty(t, moduledeprecation.Notices(mrm, "regular"))
+	assert.Empty(t, moduledeprecation.Notices(mrm, "experimental"))
+}
+
+func TestMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "module keda is deprecated", moduledeprecation.Message([]moduledeprecation.Notice{
+		{ModuleName: "keda"},
+	}))
+	assert.Equal(t, "module keda is deprecated and no longer available from 2027-01-31, use module keda-v2 instead; "+
+		"channel fast of module keda is deprecated, use channel regular instead",
+		moduledeprecation.Message([]moduledeprecation.Notice{
+			{ModuleName: "keda", SunsetDate: "2027-01-31", Replacement: "keda-v2"},
+			{ModuleName: "keda", Channel: "fast", Replacement: "regular"},
+		}))
+}
+
+func TestAnnounce_ReturnsEachNoticeOnce(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta2.Kyma{}
+	moduleDeprecation := moduledeprecation.Notice{ModuleName: "keda", Replacement: "keda-v2"}
+	channelDeprecation := moduledeprecation.Notice{ModuleName: "keda", Channel: "fast", Replacement: "regular"}
+
+	assert.Equal(t, []moduledeprecation.Notice{moduleDeprecation},
+		moduledeprecation.Announce(kyma, []moduledeprecation.Notice{moduleDeprecation}))
+	assert.Empty(t, moduledeprecation.Announce(kyma, []moduledeprecation.Notice{moduleDeprecation}))
+	assert.Equal(t, []moduledeprecation.Notice{channelDeprecation},
+		moduledeprecation.Announce(kyma, []moduledeprecation.Notice{moduleDeprecation, channelDeprecation}))
+	assert.True(t, meta.IsStatusConditionTrue(kyma.Status.Conditions,
+		string(v1beta2.ConditionTypeModuleDeprecation)))
+
+	assert.Empty(t, moduledeprecation.Announce(kyma, nil))
+	assert.Nil(t, meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta2.ConditionTypeModuleDeprecation)))
+	assert.Equal(t, []moduledeprecation.Notice{moduleDeprecation},
+		moduledeprecation.Announce(kyma, []moduledeprecation.Notice{moduleDeprecation}))
+}
+
+func TestAnnounce_WhenModuleKeepsRevokedVersion_ReturnsNoticeOnce(t *testing.T) {
+	t.Parallel()
+	// the module message reports the revocation instead of the deprecation
+	kyma := &v1beta2.Kyma{Status: v1beta2.KymaStatus{Modules: []v1beta2.ModuleStatus{{
+		Name:    "keda",
+		State:   shared.StateWarning,
+		Message: modulerevocation.Message(&v1beta2.Revocation{Version: "1.1.0", Reason: "data loss on upgrade"}),
+	}}}}
+	notices := []moduledeprecation.Notice{{ModuleName: "keda", SunsetDate: "2027-01-31"}}
+
+	assert.Equal(t, notices, moduledeprecation.Announce(kyma, notices))
+	assert.Empty(t, moduledeprecation.Announce(kyma, notices))
+	assert.Empty(t, moduledeprecation.Announce(kyma, notices))
+}
@@ -0,0 +1,108 @@
+// Package modulerevocation resolves the module versions that Kymas run while the ModuleReleaseMeta of a module
+// freezes its upgrades or revokes versions during an incident.
+package modulerevocation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+var ErrVersionRevoked = errors.New("module version is revoked")
+
+// TargetVersion returns the version that the module runs in the Kyma instead of the version resolved for its
+// channel, and the revocation of that version if the Kyma has to keep running a revoked version.
+//
+// A Kyma running a revoked version is moved to its safe version if the ModuleReleaseMeta allows the change, or
+// upgraded to the resolved version unless the module is frozen. While frozen, Kymas keep the installed version.
+// A revoked version is never installed; Kymas that newly enable the module install its safe version instead.
+func TargetVersion(mrm *v1beta2.ModuleReleaseMeta, kyma *v1beta2.Kyma,
+	resolvedVersion string,
+) (string, *v1beta2.Revocation, error) {
+	installedVersion := kyma.GetModuleVersion(mrm.Spec.ModuleName)
+	if installedVersion == "" {
+		return installableVersion(mrm, resolvedVersion)
+	}
+
+	if revocation := mrm.GetRevocation(installedVersion); revocation != nil {
+		if isAllowedMove(mrm, installedVersion, revocation.SafeVersion) {
+			return revocation.SafeVersion, nil, nil
+		}
+		if mrm.Spec.Freeze == nil && isAllowedMove(mrm, installedVersion, resolvedVersion) {
+			return resolvedVersion, nil, nil
+		}
+		return installedVersion, revocation, nil
+	}
+	if mrm.Spec.Freeze != nil || mrm.GetRevocation(resolvedVersion) != nil {
+		return installedVersion, nil, nil
+	}
+	return resolvedVersion, nil, nil
+}
+
+// CheckPinnedVersion returns an error if the version that the module is pinned to in the Kyma is revoked and not
+// installed yet. Pinned versions are not moved, so a revoked version may only keep running where it is installed.
+func CheckPinnedVersion(mrm *v1beta2.ModuleReleaseMeta, kyma *v1beta2.Kyma, pinnedVersion string) error {
+	revocation := mrm.GetRevocation(pinnedVersion)
+	if revocation != nil && kyma.GetModuleVersion(mrm.Spec.ModuleName) != pinnedVersion {
+		return fmt.Errorf("%w: %s", ErrVersionRevoked, Message(revocation))
+	}
+	return nil
+}
+
+// Message describes the revocation for the status of the module.
+func Message(revocation *v1beta2.Revocation) string {
+	if revocation.Reason == "" {
+		return fmt.Sprintf("version %s is revoked", revocation.Version)
+	}
+	return fmt.Sprintf("version %s is revoked: %s", revocation.Version, revocation.Reason)
+}
+
+func installableVersion(mrm *v1beta2.ModuleReleaseMeta, version string) (string, *v1beta2.Revocation, error) {
+	revocation := mrm.GetRevocation(version)
+	if revocation == nil {
+		return version, nil, nil
+	}
+	if revocation.SafeVersion != "" && mrm.GetRevocation(revocation.SafeVersion) == nil {
+		return revocation.SafeVersion, nil, nil
+	}
+	return "", nil, fmt.Errorf("%w: %s", ErrVersionRevoked, Message(revocation))
+}
+
+// isAllowedMove returns whether a Kyma running the revoked version may be moved to the other version, which must
+// not be revoked itself and must not be lower unless the ModuleReleaseMeta allows the rollback.
+func isAllowedMove(mrm *v1beta2.ModuleReleaseMeta, revokedVersion, version string) bool {
+	if version == "" || version == revokedVersion || mrm.GetRevocation(version) != nil {
+		return false
+	}
+	from, err := semver.NewVersion(revokedVersion)
+	if err != nil {
+		return false
+	}
+	to, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	if !withoutPrerelease(to).LessThan(withoutPrerelease(from)) {
+		return true
+	}
+	return slices.ContainsFunc(mrm.Spec.Rollbacks, func(rollback v1beta2.Rollback) bool {
+		rollbackFrom, err := semver.NewVersion(rollback.FromVersion)
+		if err != nil {
+			return false
+		}
+		rollbackTo, err := semver.NewVersion(rollback.ToVersion)
+		if err != nil {
+			return false
+		}
+		return rollbackFrom.Equal(from) && rollbackTo.Equal(to)
+	})
+}
+
+// withoutPrerelease strips the pre-release and build metadata, like the version skew check of the template lookup.
+func withoutPrerelease(version *semver.Version) *semver.Version {
+	return semver.New(version.Major(), version.Minor(), version.Patch(), "", "")
+}
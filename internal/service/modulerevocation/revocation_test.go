@@ -0,0 +1,164 @@
+package modulerevocation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerevocation"
+)
+
+func TestTargetVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		mrm              *v1beta2.ModuleReleaseMeta
+		installedVersion string
+		resolvedVersion  string
+		version          string
+		revoked          bool
+	}{
+		{
+			name:             "upgrade",
+			mrm:              moduleReleaseMeta(),
+			installedVersion: "1.0.0",
+			resolvedVersion:  "1.2.0",
+			version:          "1.2.0",
+		},
+		{
+			name:             "no upgrade while frozen",
+			mrm:              frozen(moduleReleaseMeta()),
+			installedVersion: "1.0.0",
+			resolvedVersion:  "1.2.0",
+			version:          "1.0.0",
+		},
+		{
+			name:            "install while frozen",
+			mrm:             frozen(moduleReleaseMeta()),
+			resolvedVersion: "1.2.0",
+			version:         "1.2.0",
+		},
+		{
+			name:             "no upgrade to revoked version",
+			mrm:              withRevocation(moduleReleaseMeta(), "1.2.0", ""),
+			installedVersion: "1.0.0",
+			resolvedVersion:  "1.2.0",
+			version:          "1.0.0",
+		},
+		{
+			name:            "install safe version instead of revoked version",
+			mrm:             withRevocation(moduleReleaseMeta(), "1.2.0", "1.0.0"),
+			resolvedVersion: "1.2.0",
+			version:         "1.0.0",
+		},
+		{
+			name:             "move to higher safe version",
+			mrm:              frozen(withRevocation(moduleReleaseMeta(), "1.1.0", "1.1.1")),
+			installedVersion: "1.1.0",
+			resolvedVersion:  "1.1.0",
+			version:          "1.1.1",
+		},
+		{
+			name:             "move to lower safe version with allowed rollback",
+			mrm:              withRollback(withRevocation(moduleReleaseMeta(), "1.1.0", "1.0.0"), "1.1.0", "1.0.0"),
+			installedVersion: "1.1.0",
+			resolvedVersion:  "1.1.0",
+			version:          "1.0.0",
+		},
+		{
+			name:             "move to lower safe version with allowed rollback differing in build metadata",
+			mrm:              withRollback(withRevocation(moduleReleaseMeta(), "1.1.0", "1.0.0"), "1.1.0+1", "1.0.0+1"),
+			installedVersion: "1.1.0",
+			resolvedVersion:  "1.1.0",
+			version:          "1.0.0",
+		},
+		{
+			name:             "keep revoked version without allowed rollback",
+			mrm:              withRevocation(moduleReleaseMeta(), "1.1.0", "1.0.0"),
+			installedVersion: "1.1.0",
+			resolvedVersion:  "1.1.0",
+			version:          "1.1.0",
+			revoked:          true,
+		},
+		{
+			name:             "upgrade from revoked version",
+			mrm:              withRevocation(moduleReleaseMeta(), "1.1.0", ""),
+			installedVersion: "1.1.0",
+			resolvedVersion:  "1.2.0",
+			version:          "1.2.0",
+		},
+		{
+			name:             "keep revoked version while frozen",
+			mrm:              frozen(withRevocation(moduleReleaseMeta(), "1.1.0", "")),
+			installedVersion: "1.1.0",
+			resolvedVersion:  "1.2.0",
+			version:          "1.1.0",
+			revoked:          true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			version, revocation, err := modulerevocation.TargetVersion(testCase.mrm,
+				kymaWithModule(testCase.installedVersion), testCase.resolvedVersion)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.version, version)
+			assert.Equal(t, testCase.revoked, revocation != nil)
+		})
+	}
+}
+
+func TestTargetVersion_ReturnsError_WhenRevokedVersionHasNoSafeVersion(t *testing.T) {
+	t.Parallel()
+	mrm := withRevocation(moduleReleaseMeta(), "1.2.0", "")
+	mrm.Spec.Revocations[0].Reason = "data loss on upgrade"
+
+	_, _, err := modulerevocation.TargetVersion(mrm, kymaWithModule(""), "1.2.0")
+
+	require.ErrorIs(t, err, modulerevocation.ErrVersionRevoked)
+	assert.ErrorContains(t, err, "version 1.2.0 is revoked: data loss on upgrade")
+}
+
+func TestCheckPinnedVersion(t *testing.T) {
+	t.Parallel()
+	mrm := withRevocation(moduleReleaseMeta(), "1.1.0", "")
+
+	require.NoError(t, modulerevocation.CheckPinnedVersion(mrm, kymaWithModule(""), "1.0.0"))
+	require.NoError(t, modulerevocation.CheckPinnedVersion(mrm, kymaWithModule("1.1.0"), "1.1.0"))
+	require.ErrorIs(t, modulerevocation.CheckPinnedVersion(mrm, kymaWithModule("1.0.0"), "1.1.0"),
+		modulerevocation.ErrVersionRevoked)
+}
+
+func moduleReleaseMeta() *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{ModuleName: "test-module"},
+	}
+}
+
+func frozen(mrm *v1beta2.ModuleReleaseMeta) *v1beta2.ModuleReleaseMeta {
+	mrm.Spec.Freeze = &v1beta2.Freeze{Reason: "incident"}
+	return mrm
+}
+
+func withRevocation(mrm *v1beta2.ModuleReleaseMeta, version, safeVersion string) *v1beta2.ModuleReleaseMeta {
+	mrm.Spec.Revocations = append(mrm.Spec.Revocations,
+		v1beta2.Revocation{Version: version, SafeVersion: safeVersion})
+	return mrm
+}
+
+func withRollback(mrm *v1beta2.ModuleReleaseMeta, fromVersion, toVersion string) *v1beta2.ModuleReleaseMeta {
+	mrm.Spec.Rollbacks = append(mrm.Spec.Rollbacks, v1beta2.Rollback{FromVersion: fromVersion, ToVersion: toVersion})
+	return mrm
+}
+
+func kymaWithModule(version string) *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{}
+	if version != "" {
+		kyma.Status.Modules = []v1beta2.ModuleStatus{{Name: "test-module", Version: version}}
+	}
+	return kyma
+}
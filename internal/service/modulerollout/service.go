@@ -0,0 +1,239 @@
+// Package modulerollout rolls out new versions of module channels to the Kymas in waves, as defined by the rollout
+// strategies of the channels in the ModuleReleaseMeta, and records the progress in its status.
+package modulerollout
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+type KymaRepository interface {
+	LookupByLabel(ctx context.Context, labelKey, labelValue string) (*v1beta2.KymaList, error)
+}
+
+type ModuleReleaseMetaRepository interface {
+	UpdateStatus(ctx context.Context, mrm *v1beta2.ModuleReleaseMeta) error
+}
+
+// KymaRequeuer reconciles the Kymas again, so that they upgrade to the version a rollout admits them to.
+type KymaRequeuer interface {
+	Requeue(ctx context.Context, kymas []*v1beta2.Kyma)
+}
+
+type Service struct {
+	kymaRepo      KymaRepository
+	mrmRepo       ModuleReleaseMetaRepository
+	kymaRequeuer  KymaRequeuer
+	checkInterval time.Duration
+	now           func() time.Time
+}
+
+func NewService(kymaRepo KymaRepository, mrmRepo ModuleReleaseMetaRepository, kymaRequeuer KymaRequeuer,
+	checkInterval time.Duration,
+	opts ...func(*Service) *Service,
+) *Service {
+	service := &Service{
+		kymaRepo:      kymaRepo,
+		mrmRepo:       mrmRepo,
+		kymaRequeuer:  kymaRequeuer,
+		checkInterval: checkInterval,
+		now:           time.Now,
+	}
+	for _, opt := range opts {
+		service = opt(service)
+	}
+	return service
+}
+
+// WithClock replaces the clock the soak times are measured with.
+func WithClock(now func() time.Time) func(*Service) *Service {
+	return func(service *Service) *Service {
+		service.now = now
+		return service
+	}
+}
+
+// UpdateRollouts advances the rollouts of the channels of the ModuleReleaseMeta and records them in its status. Once
+// recorded, the Kymas that a rollout newly admits to its version and that do not run it yet are requeued, the others
+// are left to their regular reconciliation. It returns the
+// time after which the rollouts have to be updated again, which is zero once all rollouts are completed.
+func (s *Service) UpdateRollouts(ctx context.Context, mrm *v1beta2.ModuleReleaseMeta) (time.Duration, error) {
+	kymas, err := s.kymaRepo.LookupByLabel(ctx, shared.ManagedBy, shared.OperatorName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list Kymas for the rollout of module %s: %w", mrm.Spec.ModuleName, err)
+	}
+
+	now := s.now()
+	var rollouts []v1beta2.ChannelRollout
+	var pending []*v1beta2.Kyma
+	var requeueAfter time.Duration
+	for i := range mrm.Spec.Channels {
+		assignment := &mrm.Spec.Channels[i]
+		if assignment.Rollout == nil {
+			continue
+		}
+		current := mrm.GetRollout(assignment.Channel)
+		rollout, next := s.updateRollout(current, assignment, mrm.Spec.ModuleName, kymas.Items, now)
+		rollouts = append(rollouts, rollout)
+		pending = append(pending, newlyAdmittedKymas(
+			pendingKymas(kymas.Items, mrm.Spec.ModuleName, assignment, &rollout), assignment, current, &rollout)...)
+		if next > 0 && (requeueAfter == 0 || next < requeueAfter) {
+			requeueAfter = next
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(rollouts, mrm.Status.Rollouts) {
+		mrm.Status.Rollouts = rollouts
+		if err := s.mrmRepo.UpdateStatus(ctx, mrm); err != nil {
+			return 0, err
+		}
+	}
+	// the Kymas are only requeued once the rollouts are recorded, so that they are not held back anymore
+	s.kymaRequeuer.Requeue(ctx, pending)
+	return requeueAfter, nil
+}
+
+// updateRollout returns the next state of the rollout of the version assigned to the channel and the time after
+// which it has to be updated again. A wave soaks once the module runs the version in all Kymas of the waves rolled out
+// to so far, and the next wave is rolled out to once it has soaked for the soak time. The rollout is paused as long as
+// the module is in the Error state in too many of the upgraded Kymas.
+func (s *Service) updateRollout(current *v1beta2.ChannelRollout, assignment *v1beta2.ChannelVersionAssignment,
+	moduleName string, kymas []v1beta2.Kyma, now time.Time,
+) (v1beta2.ChannelRollout, time.Duration) {
+	strategy := assignment.Rollout
+	var rollout v1beta2.ChannelRollout
+	switch {
+	case current == nil:
+		// the version assigned when the rollout strategy is added is not rolled out again
+		rollout = v1beta2.ChannelRollout{
+			Channel:       assignment.Channel,
+			Version:       assignment.Version,
+			Wave:          len(strategy.Waves),
+			WaveStartTime: apimetav1.NewTime(now),
+			State:         v1beta2.RolloutStateCompleted,
+		}
+	case current.Version != assignment.Version:
+		rollout = v1beta2.ChannelRollout{
+			Channel:         assignment.Channel,
+			Version:         assignment.Version,
+			PreviousVersion: rolledOutVersion(current),
+			WaveStartTime:   apimetav1.NewTime(now),
+			State:           v1beta2.RolloutStateProgressing,
+		}
+	default:
+		rollout = *current.DeepCopy()
+	}
+
+	rollout.UpgradedKymas, rollout.FailedKymas = countUpgradedKymas(kymas, moduleName, assignment)
+	if rollout.Wave >= len(strategy.Waves) {
+		// the waves may have been removed from the strategy during the rollout
+		rollout.Wave = len(strategy.Waves)
+		rollout.State = v1beta2.RolloutStateCompleted
+	}
+	if rollout.State == v1beta2.RolloutStateCompleted {
+		rollout.Message = "rolled out to all Kymas"
+		return rollout, 0
+	}
+
+	if rollout.FailedKymas*100 > strategy.MaxErrorPercentage*rollout.UpgradedKymas {
+		rollout.State = v1beta2.RolloutStatePaused
+		rollout.Message = fmt.Sprintf("paused in wave %s: module is in Error state in %d of %d upgraded Kymas, "+
+			"more than %d percent", strategy.Waves[rollout.Wave].Name, rollout.FailedKymas, rollout.UpgradedKymas,
+			strategy.MaxErrorPercentage)
+		return rollout, s.checkInterval
+	}
+	if rollout.State == v1beta2.RolloutStatePaused {
+		// the wave soaks again once enough modules recovered
+		rollout.State = v1beta2.RolloutStateProgressing
+		rollout.WaveStartTime = apimetav1.NewTime(now)
+	}
+
+	if waiting := len(pendingKymas(kymas, moduleName, assignment, &rollout)); waiting > 0 {
+		// the soak time starts once the wave is upgraded
+		rollout.WaveStartTime = apimetav1.NewTime(now)
+		rollout.Message = fmt.Sprintf("rolling out to wave %s: waiting for %d Kymas to upgrade",
+			strategy.Waves[rollout.Wave].Name, waiting)
+		return rollout, s.checkInterval
+	}
+
+	soaked := now.Sub(rollout.WaveStartTime.Time)
+	if soaked >= strategy.SoakTime.Duration {
+		rollout.Wave++
+		rollout.WaveStartTime = apimetav1.NewTime(now)
+		soaked = 0
+		if rollout.Wave >= len(strategy.Waves) {
+			rollout.Wave = len(strategy.Waves)
+			rollout.State = v1beta2.RolloutStateCompleted
+			rollout.Message = "rolled out to all Kymas"
+			return rollout, 0
+		}
+	}
+	rollout.Message = fmt.Sprintf("rolled out to wave %s", strategy.Waves[rollout.Wave].Name)
+	return rollout, min(s.checkInterval, strategy.SoakTime.Duration-soaked)
+}
+
+// countUpgradedKymas returns the number of Kymas that run the assigned version of the module in the channel and the
+// number of those in which the module is in the Error state.
+func countUpgradedKymas(kymas []v1beta2.Kyma, moduleName string,
+	assignment *v1beta2.ChannelVersionAssignment,
+) (int, int) {
+	var upgraded, failed int
+	for i := range kymas {
+		for _, module := range kymas[i].Status.Modules {
+			if module.Name != moduleName || module.Channel != assignment.Channel ||
+				module.Version != assignment.Version {
+				continue
+			}
+			upgraded++
+			if module.State == shared.StateError {
+				failed++
+			}
+		}
+	}
+	return upgraded, failed
+}
+
+// newlyAdmittedKymas returns the pending Kymas that the previously recorded rollout did not admit to the version yet.
+// Without a previously recorded rollout, the version is not rolled out and no Kyma is newly admitted.
+func newlyAdmittedKymas(pending []*v1beta2.Kyma, assignment *v1beta2.ChannelVersionAssignment,
+	previous, rollout *v1beta2.ChannelRollout,
+) []*v1beta2.Kyma {
+	if previous == nil {
+		return nil
+	}
+	if previous.Version != rollout.Version {
+		return pending
+	}
+	return slices.DeleteFunc(pending, func(kyma *v1beta2.Kyma) bool {
+		return admitted(assignment.Rollout, previous, kyma)
+	})
+}
+
+// pendingKymas returns the Kymas that run the module in the channel, are admitted to the version by the rollout, and
+// do not run the version yet.
+func pendingKymas(kymas []v1beta2.Kyma, moduleName string, assignment *v1beta2.ChannelVersionAssignment,
+	rollout *v1beta2.ChannelRollout,
+) []*v1beta2.Kyma {
+	var pending []*v1beta2.Kyma
+	for i := range kymas {
+		kyma := &kymas[i]
+		for _, module := range kyma.Status.Modules {
+			if module.Name != moduleName || module.Channel != assignment.Channel ||
+				module.Version == assignment.Version {
+				continue
+			}
+			if admitted(assignment.Rollout, rollout, kyma) {
+				pending = append(pending, kyma)
+			}
+		}
+	}
+	return pending
+}
@@ -0,0 +1,348 @@
+package modulerollout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerollout"
+)
+
+var (
+	now           = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	checkInterval = time.Minute
+)
+
+func TestUpdateRollouts_CompletesInitialVersion(t *testing.T) {
+	mrmRepo := &mrmRepositoryStub{}
+	service := newService(&kymaRepositoryStub{}, mrmRepo)
+	mrm := moduleReleaseMeta("1.0.0")
+
+	requeueAfter, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assert.True(t, mrmRepo.updated)
+	rollout := mrm.GetRollout("regular")
+	require.NotNil(t, rollout)
+	assert.Equal(t, v1beta2.RolloutStateCompleted, rollout.State)
+	assert.Equal(t, "1.0.0", rollout.Version)
+	assert.Equal(t, 2, rollout.Wave)
+}
+
+func TestUpdateRollouts_StartsRolloutOfNewVersion(t *testing.T) {
+	service := newService(&kymaRepositoryStub{}, &mrmRepositoryStub{})
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{completedRollout("1.0.0")}
+
+	requeueAfter, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, checkInterval, requeueAfter)
+	rollout := mrm.GetRollout("regular")
+	assert.Equal(t, v1beta2.RolloutStateProgressing, rollout.State)
+	assert.Equal(t, "1.1.0", rollout.Version)
+	assert.Equal(t, "1.0.0", rollout.PreviousVersion)
+	assert.Equal(t, 0, rollout.Wave)
+	assert.Equal(t, now, rollout.WaveStartTime.Time)
+	assert.Equal(t, "rolled out to wave canary", rollout.Message)
+}
+
+func TestUpdateRollouts_PromotesNextWaveAfterSoakTime(t *testing.T) {
+	kymaRepo := &kymaRepositoryStub{kymas: []v1beta2.Kyma{
+		kymaWithModule("kyma-1", "1.1.0", shared.StateReady),
+		kymaWithModule("kyma-2", "1.1.0", shared.StateError),
+		kymaWithModule("kyma-3", "1.0.0", shared.StateError),
+	}}
+	service := newService(kymaRepo, &mrmRepositoryStub{})
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Spec.Channels[0].Rollout.MaxErrorPercentage = 50
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(0, now.Add(-time.Hour))}
+
+	_, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	rollout := mrm.GetRollout("regular")
+	assert.Equal(t, v1beta2.RolloutStateProgressing, rollout.State)
+	assert.Equal(t, 1, rollout.Wave)
+	assert.Equal(t, now, rollout.WaveStartTime.Time)
+	assert.Equal(t, 2, rollout.UpgradedKymas)
+	assert.Equal(t, 1, rollout.FailedKymas)
+}
+
+func TestUpdateRollouts_KeepsWaveDuringSoakTime(t *testing.T) {
+	service := newService(&kymaRepositoryStub{}, &mrmRepositoryStub{})
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Spec.Channels[0].Rollout.SoakTime = apimetav1.Duration{Duration: 10 * time.Minute}
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(0, now.Add(-9*time.Minute))}
+
+	requeueAfter, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, requeueAfter)
+	assert.Equal(t, 0, mrm.GetRollout("regular").Wave)
+}
+
+func TestUpdateRollouts_CompletesAfterLastWave(t *testing.T) {
+	service := newService(&kymaRepositoryStub{}, &mrmRepositoryStub{})
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(1, now.Add(-time.Hour))}
+
+	requeueAfter, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	rollout := mrm.GetRollout("regular")
+	assert.Equal(t, v1beta2.RolloutStateCompleted, rollout.State)
+	assert.Equal(t, 2, rollout.Wave)
+}
+
+func TestUpdateRollouts_PausesWhenTooManyUpgradedModulesFail(t *testing.T) {
+	kymaRepo := &kymaRepositoryStub{kymas: []v1beta2.Kyma{
+		kymaWithModule("kyma-1", "1.1.0", shared.StateReady),
+		kymaWithModule("kyma-2", "1.1.0", shared.StateError),
+	}}
+	service := newService(kymaRepo, &mrmRepositoryStub{})
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(0, now.Add(-time.Hour))}
+
+	requeueAfter, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, checkInterval, requeueAfter)
+	rollout := mrm.GetRollout("regular")
+	assert.Equal(t, v1beta2.RolloutStatePaused, rollout.State)
+	assert.Equal(t, 0, rollout.Wave)
+	assert.Equal(t, "paused in wave canary: module is in Error state in 1 of 2 upgraded Kymas, more than 10 percent",
+		rollout.Message)
+}
+
+func TestUpdateRollouts_ResumesWithNewSoakTimeWhenModulesRecovered(t *testing.T) {
+	kymaRepo := &kymaRepositoryStub{kymas: []v1beta2.Kyma{
+		kymaWithModule("kyma-1", "1.1.0", shared.StateReady),
+	}}
+	service := newService(kymaRepo, &mrmRepositoryStub{})
+	mrm := moduleReleaseMeta("1.1.0")
+	paused := progressingRollout(0, now.Add(-time.Hour))
+	paused.State = v1beta2.RolloutStatePaused
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{paused}
+
+	_, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	rollout := mrm.GetRollout("regular")
+	assert.Equal(t, v1beta2.RolloutStateProgressing, rollout.State)
+	assert.Equal(t, 0, rollout.Wave)
+	assert.Equal(t, now, rollout.WaveStartTime.Time)
+}
+
+func TestUpdateRollouts_SkipsUpdateWithoutChanges(t *testing.T) {
+	mrmRepo := &mrmRepositoryStub{}
+	service := newService(&kymaRepositoryStub{}, mrmRepo)
+	mrm := moduleReleaseMeta("1.0.0")
+	completed := completedRollout("1.0.0")
+	completed.Message = "rolled out to all Kymas"
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{completed}
+
+	_, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.False(t, mrmRepo.updated)
+}
+
+func TestUpdateRollouts_RequeuesKymasOfPromotedWave(t *testing.T) {
+	canaryKyma := kymaWithModule("kyma-canary", "1.1.0", shared.StateReady)
+	canaryKyma.SetLabels(map[string]string{"stage": "canary"})
+	kymaRepo := &kymaRepositoryStub{kymas: []v1beta2.Kyma{
+		canaryKyma,
+		kymaWithModule("kyma-2", "1.0.0", shared.StateReady), // early wave
+		kymaWithModule("kyma-1", "1.0.0", shared.StateReady), // in no wave
+	}}
+	requeuer := &kymaRequeuerStub{}
+	service := newServiceWithRequeuer(kymaRepo, &mrmRepositoryStub{}, requeuer)
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(0, now.Add(-time.Hour))}
+
+	_, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mrm.GetRollout("regular").Wave)
+	assert.Equal(t, []string{"kyma-2"}, requeuer.requeued)
+}
+
+func TestUpdateRollouts_RequeuesKymasOfFirstWaveOnceRolloutIsRecorded(t *testing.T) {
+	canaryKyma := kymaWithModule("kyma-canary", "1.0.0", shared.StateReady)
+	canaryKyma.SetLabels(map[string]string{"stage": "canary"})
+	kymaRepo := &kymaRepositoryStub{kymas: []v1beta2.Kyma{
+		canaryKyma,
+		kymaWithModule("kyma-2", "1.0.0", shared.StateReady),
+	}}
+	mrmRepo := &mrmRepositoryStub{}
+	requeuer := &kymaRequeuerStub{mrmRepo: mrmRepo}
+	service := newServiceWithRequeuer(kymaRepo, mrmRepo, requeuer)
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{completedRollout("1.0.0")}
+
+	_, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kyma-canary"}, requeuer.requeued)
+	assert.True(t, requeuer.recordedBeforeRequeue)
+}
+
+func TestUpdateRollouts_DoesNotRequeueKymasOfWaveAgain(t *testing.T) {
+	canaryKyma := kymaWithModule("kyma-canary", "1.0.0", shared.StateReady)
+	canaryKyma.SetLabels(map[string]string{"stage": "canary"})
+	requeuer := &kymaRequeuerStub{}
+	service := newServiceWithRequeuer(&kymaRepositoryStub{kymas: []v1beta2.Kyma{canaryKyma}}, &mrmRepositoryStub{},
+		requeuer)
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{completedRollout("1.0.0")}
+
+	_, err := service.UpdateRollouts(t.Context(), mrm)
+	require.NoError(t, err)
+	_, err = service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kyma-canary"}, requeuer.requeued)
+}
+
+func TestUpdateRollouts_StartsSoakTimeOnceWaveIsUpgraded(t *testing.T) {
+	canaryKyma := kymaWithModule("kyma-canary", "1.0.0", shared.StateReady)
+	canaryKyma.SetLabels(map[string]string{"stage": "canary"})
+	requeuer := &kymaRequeuerStub{}
+	service := newServiceWithRequeuer(&kymaRepositoryStub{kymas: []v1beta2.Kyma{canaryKyma}}, &mrmRepositoryStub{},
+		requeuer)
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(0, now.Add(-time.Hour))}
+
+	requeueAfter, err := service.UpdateRollouts(t.Context(), mrm)
+
+	require.NoError(t, err)
+	assert.Equal(t, checkInterval, requeueAfter)
+	rollout := mrm.GetRollout("regular")
+	assert.Equal(t, 0, rollout.Wave)
+	assert.Equal(t, now, rollout.WaveStartTime.Time)
+	assert.Equal(t, "rolling out to wave canary: waiting for 1 Kymas to upgrade", rollout.Message)
+	// the Kymas of the wave were requeued when it was rolled out to
+	assert.Empty(t, requeuer.requeued)
+}
+
+func TestUpdateRollouts_ReturnsError_WhenKymasCannotBeListed(t *testing.T) {
+	service := newService(&kymaRepositoryStub{err: errors.New("list failed")}, &mrmRepositoryStub{})
+
+	_, err := service.UpdateRollouts(t.Context(), moduleReleaseMeta("1.0.0"))
+
+	require.ErrorContains(t, err, "list failed")
+}
+
+func newService(kymaRepo *kymaRepositoryStub, mrmRepo *mrmRepositoryStub) *modulerollout.Service {
+	return newServiceWithRequeuer(kymaRepo, mrmRepo, &kymaRequeuerStub{})
+}
+
+func newServiceWithRequeuer(kymaRepo *kymaRepositoryStub, mrmRepo *mrmRepositoryStub,
+	requeuer *kymaRequeuerStub,
+) *modulerollout.Service {
+	return modulerollout.NewService(kymaRepo, mrmRepo, requeuer, checkInterval,
+		modulerollout.WithClock(func() time.Time { return now }))
+}
+
+func moduleReleaseMeta(version string) *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: "test-module",
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{
+					Channel: "regular",
+					Version: version,
+					Rollout: &v1beta2.RolloutStrategy{
+						Waves: []v1beta2.RolloutWave{
+							{Name: "canary", KymaSelector: &apimetav1.LabelSelector{
+								MatchLabels: map[string]string{"stage": "canary"},
+							}},
+							{Name: "early", Percentage: ptr(50)},
+						},
+						SoakTime:           apimetav1.Duration{Duration: time.Hour},
+						MaxErrorPercentage: 10,
+					},
+				},
+				{Channel: "fast", Version: version},
+			},
+		},
+	}
+}
+
+func completedRollout(version string) v1beta2.ChannelRollout {
+	return v1beta2.ChannelRollout{
+		Channel:       "regular",
+		Version:       version,
+		Wave:          2,
+		WaveStartTime: apimetav1.NewTime(now.Add(-24 * time.Hour)),
+		State:         v1beta2.RolloutStateCompleted,
+	}
+}
+
+func progressingRollout(wave int, waveStartTime time.Time) v1beta2.ChannelRollout {
+	return v1beta2.ChannelRollout{
+		Channel:         "regular",
+		Version:         "1.1.0",
+		PreviousVersion: "1.0.0",
+		Wave:            wave,
+		WaveStartTime:   apimetav1.NewTime(waveStartTime),
+		State:           v1beta2.RolloutStateProgressing,
+	}
+}
+
+func kymaWithModule(name, version string, state shared.State) v1beta2.Kyma {
+	kyma := v1beta2.Kyma{}
+	kyma.SetName(name)
+	kyma.Status.Modules = []v1beta2.ModuleStatus{
+		{Name: "test-module", Channel: "regular", Version: version, State: state},
+	}
+	return kyma
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+type kymaRepositoryStub struct {
+	kymas []v1beta2.Kyma
+	err   error
+}
+
+func (s *kymaRepositoryStub) LookupByLabel(_ context.Context, _, _ string) (*v1beta2.KymaList, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &v1beta2.KymaList{Items: s.kymas}, nil
+}
+
+type mrmRepositoryStub struct {
+	updated bool
+}
+
+func (s *mrmRepositoryStub) UpdateStatus(_ context.Context, _ *v1beta2.ModuleReleaseMeta) error {
+	s.updated = true
+	return nil
+}
+
+type kymaRequeuerStub struct {
+	mrmRepo               *mrmRepositoryStub
+	requeued              []string
+	recordedBeforeRequeue bool
+}
+
+func (s *kymaRequeuerStub) Requeue(_ context.Context, kymas []*v1beta2.Kyma) {
+	for _, kyma := range kymas {
+		s.requeued = append(s.requeued, kyma.GetName())
+	}
+	s.recordedBeforeRequeue = s.mrmRepo != nil && s.mrmRepo.updated
+}
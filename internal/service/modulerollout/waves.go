@@ -0,0 +1,104 @@
+package modulerollout
+
+import (
+	"hash/fnv"
+
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+const percentageBuckets = 100
+
+// WaveOf returns the index of the first wave of the strategy that the Kyma belongs to, or the number of waves for
+// Kymas in no wave, which are rolled out to last.
+func WaveOf(strategy *v1beta2.RolloutStrategy, kyma *v1beta2.Kyma) int {
+	for i := range strategy.Waves {
+		if matchesWave(&strategy.Waves[i], kyma) {
+			return i
+		}
+	}
+	return len(strategy.Waves)
+}
+
+// HeldBackVersion returns the version that the module stays at in the Kyma while a new version of the channel is
+// rolled out, and whether the Kyma is held back at all. A held back Kyma keeps the version it runs, or installs the
+// version rolled out before if it does not run the module yet.
+func HeldBackVersion(mrm *v1beta2.ModuleReleaseMeta, channel string, kyma *v1beta2.Kyma) (string, bool) {
+	assignment := channelAssignment(mrm, channel)
+	if assignment == nil || assignment.Rollout == nil {
+		return "", false
+	}
+	rollout := mrm.GetRollout(channel)
+	if rollout == nil {
+		return "", false
+	}
+
+	var previousVersion string
+	switch {
+	case rollout.Version != assignment.Version:
+		// the rollout of the assigned version has not been started yet
+		previousVersion = rolledOutVersion(rollout)
+	case admitted(assignment.Rollout, rollout, kyma):
+		return "", false
+	default:
+		previousVersion = rollout.PreviousVersion
+	}
+
+	if installedVersion := kyma.GetModuleVersion(mrm.Spec.ModuleName); installedVersion != "" {
+		if installedVersion == assignment.Version {
+			return "", false
+		}
+		return installedVersion, true
+	}
+	if previousVersion == "" {
+		return "", false
+	}
+	return previousVersion, true
+}
+
+// admitted returns whether the rollout has reached the wave of the Kyma.
+func admitted(strategy *v1beta2.RolloutStrategy, rollout *v1beta2.ChannelRollout, kyma *v1beta2.Kyma) bool {
+	return rollout.State == v1beta2.RolloutStateCompleted || WaveOf(strategy, kyma) <= rollout.Wave
+}
+
+func matchesWave(wave *v1beta2.RolloutWave, kyma *v1beta2.Kyma) bool {
+	if wave.Percentage != nil {
+		return percentageBucket(kyma.GetName()) < *wave.Percentage
+	}
+	if wave.KymaSelector == nil {
+		return false
+	}
+	selector, err := apimetav1.LabelSelectorAsSelector(wave.KymaSelector)
+	if err != nil {
+		// an invalid selector matches no Kyma, so that its Kymas are rolled out to last
+		return false
+	}
+	return selector.Matches(k8slabels.Set(kyma.GetLabels()))
+}
+
+// percentageBucket assigns the Kyma to one of 100 buckets by a stable hash of its name, so that a Kyma stays in the
+// same percentage-based wave across rollouts.
+func percentageBucket(kymaName string) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(kymaName))
+	return int(hash.Sum32() % percentageBuckets)
+}
+
+// rolledOutVersion returns the version that all Kymas run or are about to run once the rollout is completed.
+func rolledOutVersion(rollout *v1beta2.ChannelRollout) string {
+	if rollout.State == v1beta2.RolloutStateCompleted {
+		return rollout.Version
+	}
+	return rollout.PreviousVersion
+}
+
+func channelAssignment(mrm *v1beta2.ModuleReleaseMeta, channel string) *v1beta2.ChannelVersionAssignment {
+	for i := range mrm.Spec.Channels {
+		if mrm.Spec.Channels[i].Channel == channel {
+			return &mrm.Spec.Channels[i]
+		}
+	}
+	return nil
+}
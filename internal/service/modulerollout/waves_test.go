@@ -0,0 +1,128 @@
+package modulerollout_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerollout"
+)
+
+func TestWaveOf(t *testing.T) {
+	t.Parallel()
+	strategy := &v1beta2.RolloutStrategy{
+		Waves: []v1beta2.RolloutWave{
+			{Name: "canary", KymaSelector: &apimetav1.LabelSelector{MatchLabels: map[string]string{"stage": "canary"}}},
+			{Name: "half", Percentage: ptr(50)},
+			{Name: "all", Percentage: ptr(100)},
+		},
+	}
+
+	assert.Equal(t, 0, modulerollout.WaveOf(strategy, kyma("kyma-1", "canary")))
+	var inHalf, inAll int
+	for i := range 200 {
+		switch modulerollout.WaveOf(strategy, kyma(fmt.Sprintf("kyma-%d", i), "production")) {
+		case 1:
+			inHalf++
+		case 2:
+			inAll++
+		default:
+			t.Fatalf("kyma-%d is not in a percentage wave", i)
+		}
+	}
+	assert.InDelta(t, 100, inHalf, 30)
+	assert.Equal(t, 200, inHalf+inAll)
+	assert.Equal(t, modulerollout.WaveOf(strategy, kyma("kyma-7", "production")),
+		modulerollout.WaveOf(strategy, kyma("kyma-7", "production")))
+
+	strategy.Waves = strategy.Waves[:1]
+	assert.Equal(t, 1, modulerollout.WaveOf(strategy, kyma("kyma-1", "production")))
+}
+
+func TestHeldBackVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		rollout          v1beta2.ChannelRollout
+		kyma             *v1beta2.Kyma
+		installedVersion string
+		version          string
+		heldBack         bool
+	}{
+		{
+			name:    "Kyma in rolled out wave",
+			rollout: progressingRollout(0, now),
+			kyma:    kyma("kyma-1", "canary"),
+		},
+		{
+			name:     "Kyma not in rolled out wave without module",
+			rollout:  progressingRollout(0, now),
+			kyma:     kyma("kyma-1", "production"),
+			version:  "1.0.0",
+			heldBack: true,
+		},
+		{
+			name:             "Kyma not in rolled out wave with module",
+			rollout:          progressingRollout(0, now),
+			kyma:             kyma("kyma-1", "production"),
+			installedVersion: "0.9.0",
+			version:          "0.9.0",
+			heldBack:         true,
+		},
+		{
+			name:             "Kyma not in rolled out wave with upgraded module",
+			rollout:          progressingRollout(0, now),
+			kyma:             kyma("kyma-1", "production"),
+			installedVersion: "1.1.0",
+		},
+		{
+			name:     "rollout not started yet",
+			rollout:  completedRollout("1.0.0"),
+			kyma:     kyma("kyma-1", "canary"),
+			version:  "1.0.0",
+			heldBack: true,
+		},
+		{
+			name:    "rollout completed",
+			rollout: completedRollout("1.1.0"),
+			kyma:    kyma("kyma-1", "production"),
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			mrm := moduleReleaseMeta("1.1.0")
+			mrm.Status.Rollouts = []v1beta2.ChannelRollout{testCase.rollout}
+			if testCase.installedVersion != "" {
+				testCase.kyma.Status.Modules = []v1beta2.ModuleStatus{
+					{Name: "test-module", Channel: "regular", Version: testCase.installedVersion},
+				}
+			}
+
+			version, heldBack := modulerollout.HeldBackVersion(mrm, "regular", testCase.kyma)
+
+			assert.Equal(t, testCase.heldBack, heldBack)
+			assert.Equal(t, testCase.version, version)
+		})
+	}
+}
+
+func TestHeldBackVersion_NotHeldBackWithoutRolloutStrategy(t *testing.T) {
+	t.Parallel()
+	mrm := moduleReleaseMeta("1.1.0")
+	mrm.Status.Rollouts = []v1beta2.ChannelRollout{progressingRollout(0, now)}
+
+	_, heldBack := modulerollout.HeldBackVersion(mrm, "fast", kyma("kyma-1", "production"))
+
+	assert.False(t, heldBack)
+}
+
+func kyma(name, stage string) *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{}
+	kyma.SetName(name)
+	kyma.SetLabels(map[string]string{"stage": stage})
+	return kyma
+}
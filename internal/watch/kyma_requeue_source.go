@@ -0,0 +1,39 @@
+package watch
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+)
+
+// KymaRequeueSource passes the Kymas that other controllers want to be reconciled again to the Kyma controller,
+// e.g. the Kymas a new module version is rolled out to. Status updates of watched objects do not trigger the Kyma
+// controller, so that changes recorded in a status have to be passed on this way.
+type KymaRequeueSource struct {
+	events chan event.GenericEvent
+}
+
+func NewKymaRequeueSource() *KymaRequeueSource {
+	return &KymaRequeueSource{
+		events: make(chan event.GenericEvent),
+	}
+}
+
+// Requeue passes the Kymas to the Kyma controller. It blocks until the Kyma controller received them or the
+// context is done.
+func (s *KymaRequeueSource) Requeue(ctx context.Context, kymas []*v1beta2.Kyma) {
+	for _, kyma := range kymas {
+		select {
+		case s.events <- event.GenericEvent{Object: kyma}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Events returns the channel the Kyma controller receives the Kymas from.
+func (s *KymaRequeueSource) Events() <-chan event.GenericEvent {
+	return s.events
+}
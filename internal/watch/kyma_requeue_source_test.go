@@ -0,0 +1,33 @@
+package watch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
+)
+
+func TestKymaRequeueSource_Requeue_PassesKymasToEvents(t *testing.T) {
+	source := watch.NewKymaRequeueSource()
+	kymas := []*v1beta2.Kyma{
+		{ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-1", Namespace: "kcp-system"}},
+		{ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-2", Namespace: "kcp-system"}},
+	}
+
+	go source.Requeue(t.Context(), kymas)
+
+	assert.Equal(t, "kyma-1", (<-source.Events()).Object.GetName())
+	assert.Equal(t, "kyma-2", (<-source.Events()).Object.GetName())
+}
+
+func TestKymaRequeueSource_Requeue_ReturnsWhenContextIsDone(t *testing.T) {
+	source := watch.NewKymaRequeueSource()
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	source.Requeue(ctx, []*v1beta2.Kyma{{ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-1"}}})
+}
@@ -30,6 +30,19 @@ func getAffectedKymas(
 	return affectedKymas
 }
 
+func getKymasWithModule(kymaList *v1beta2.KymaList, affectedModule string) []*types.NamespacedName {
+	affectedKymas := make([]*types.NamespacedName, 0)
+	for _, kyma := range kymaList.Items {
+		if slices.ContainsFunc(kyma.Status.Modules, func(module v1beta2.ModuleStatus) bool {
+			return module.Name == affectedModule
+		}) {
+			affectedKymas = append(affectedKymas,
+				&types.NamespacedName{Name: kyma.GetName(), Namespace: kyma.GetNamespace()})
+		}
+	}
+	return affectedKymas
+}
+
 func getModuleChannel(moduleChannel, kymaChannel string) string {
 	if moduleChannel == "" {
 		return kymaChannel
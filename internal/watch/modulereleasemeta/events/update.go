@@ -1,8 +1,10 @@
 package events
 
 import (
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 )
 
@@ -10,10 +12,42 @@ import (
 func AffectedKymasOnUpdate(oldMRM, newMRM *v1beta2.ModuleReleaseMeta,
 	kymaList *v1beta2.KymaList,
 ) []*types.NamespacedName {
-	affectedChannels := diffModuleReleaseMetaChannels(oldMRM, newMRM)
+	if revocationsOrFreezeChanged(oldMRM, newMRM) {
+		// revocations and the freeze apply to the module in all channels
+		return getKymasWithModule(kymaList, newMRM.Spec.ModuleName)
+	}
+
+	changedChannels := diffModuleReleaseMetaChannels(oldMRM, newMRM)
+	affectedChannels := withoutRolloutChannels(changedChannels, newMRM)
+	if len(changedChannels) > 0 {
+		// modules pinned to a version are tracked with the "none" channel and have to re-validate
+		// that their version is still assigned to a channel
+		affectedChannels = append(affectedChannels, string(shared.NoneChannel))
+	}
 	return getAffectedKymas(kymaList, newMRM.Spec.ModuleName, affectedChannels)
 }
 
+// withoutRolloutChannels drops the channels with a rollout strategy. Their Kymas are requeued by the module rollout
+// once it admits them to the new version.
+func withoutRolloutChannels(channels []string, mrm *v1beta2.ModuleReleaseMeta) []string {
+	rolledOut := make(map[string]bool)
+	for _, assignment := range mrm.Spec.Channels {
+		rolledOut[assignment.Channel] = assignment.Rollout != nil
+	}
+	var remaining []string
+	for _, channel := range channels {
+		if !rolledOut[channel] {
+			remaining = append(remaining, channel)
+		}
+	}
+	return remaining
+}
+
+func revocationsOrFreezeChanged(oldMRM, newMRM *v1beta2.ModuleReleaseMeta) bool {
+	return !equality.Semantic.DeepEqual(oldMRM.Spec.Revocations, newMRM.Spec.Revocations) ||
+		!equality.Semantic.DeepEqual(oldMRM.Spec.Freeze, newMRM.Spec.Freeze)
+}
+
 func diffModuleReleaseMetaChannels(
 	oldModuleReleaseMeta, newModuleReleaseMeta *v1beta2.ModuleReleaseMeta,
 ) []string {
@@ -218,6 +218,117 @@ func Test_AffectedKymasOnUpdate(t *testing.T) {
 				{Name: "kyma-2", Namespace: "kcp-system"},
 			},
 		},
+		{
+			name: "updated version of channel with rollout strategy is left to the rollout",
+			oldMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels: []v1beta2.ChannelVersionAssignment{
+						{Channel: "regular", Version: "1.0.0", Rollout: &v1beta2.RolloutStrategy{}},
+					},
+				},
+			},
+			newMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels: []v1beta2.ChannelVersionAssignment{
+						{Channel: "regular", Version: "1.1.0", Rollout: &v1beta2.RolloutStrategy{}},
+					},
+				},
+			},
+			kymas: &v1beta2.KymaList{
+				Items: []v1beta2.Kyma{
+					{
+						ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-1", Namespace: "kcp-system"},
+						Status: v1beta2.KymaStatus{
+							Modules: []v1beta2.ModuleStatus{
+								{
+									Name:    "module",
+									Channel: "regular",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []*types.NamespacedName{},
+		},
+		{
+			name: "added revocation requeues all kymas using the module",
+			oldMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.1.0"}},
+				},
+			},
+			newMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName:  "module",
+					Channels:    []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.1.0"}},
+					Revocations: []v1beta2.Revocation{{Version: "1.1.0", SafeVersion: "1.1.1"}},
+				},
+			},
+			kymas: kymasWithModuleInChannels("regular", "none"),
+			want: []*types.NamespacedName{
+				{Name: "kyma-none", Namespace: "kcp-system"},
+				{Name: "kyma-regular", Namespace: "kcp-system"},
+			},
+		},
+		{
+			name: "added freeze requeues all kymas using the module",
+			oldMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.1.0"}},
+				},
+			},
+			newMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.1.0"}},
+					Freeze:     &v1beta2.Freeze{Reason: "incident"},
+				},
+			},
+			kymas: kymasWithModuleInChannels("regular", "fast"),
+			want: []*types.NamespacedName{
+				{Name: "kyma-fast", Namespace: "kcp-system"},
+				{Name: "kyma-regular", Namespace: "kcp-system"},
+			},
+		},
+		{
+			name: "updated channel version requeues kyma with pinned module version",
+			oldMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.0.0"}},
+				},
+			},
+			newMRM: &v1beta2.ModuleReleaseMeta{
+				Spec: v1beta2.ModuleReleaseMetaSpec{
+					ModuleName: "module",
+					Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.1.0"}},
+				},
+			},
+			kymas: &v1beta2.KymaList{
+				Items: []v1beta2.Kyma{
+					{
+						ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-1", Namespace: "kcp-system"},
+						Status: v1beta2.KymaStatus{
+							Modules: []v1beta2.ModuleStatus{
+								{
+									Name:    "module",
+									Channel: "none",
+									Version: "1.0.0",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []*types.NamespacedName{
+				{Name: "kyma-1", Namespace: "kcp-system"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -229,6 +340,19 @@ func Test_AffectedKymasOnUpdate(t *testing.T) {
 	}
 }
 
+func kymasWithModuleInChannels(channels ...string) *v1beta2.KymaList {
+	kymas := &v1beta2.KymaList{}
+	for _, channel := range channels {
+		kymas.Items = append(kymas.Items, v1beta2.Kyma{
+			ObjectMeta: apimetav1.ObjectMeta{Name: "kyma-" + channel, Namespace: "kcp-system"},
+			Status: v1beta2.KymaStatus{
+				Modules: []v1beta2.ModuleStatus{{Name: "module", Channel: channel}},
+			},
+		})
+	}
+	return kymas
+}
+
 func sortNamespacedNames(names []*types.NamespacedName) {
 	sort.Slice(names, func(i, j int) bool {
 		return names[i].Name < names[j].Name
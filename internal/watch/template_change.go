@@ -1,47 +1,126 @@
 package watch
 
 import (
+	"bytes"
 	"context"
 
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
 )
 
 type kymaRepository interface {
 	LookupByLabel(ctx context.Context, labelName, labelValue string) (*v1beta2.KymaList, error)
 }
 
+type moduleReleaseMetaRepository interface {
+	Get(ctx context.Context, mrmName string) (*v1beta2.ModuleReleaseMeta, error)
+}
+
+type descriptorInvalidator interface {
+	Invalidate(ocmId ocmidentity.ComponentId)
+}
+
 // TemplateChangeHandler handles changes to ModuleTemplate objects.
-// Uses handler.MapFunc instead of a typed EventHandler: any change (create/update/delete)
-// requeues all Kymas referencing the template with no per-event distinction, so the typed machinery adds no value.
+// Any change (create/update/delete) requeues all Kymas referencing the template with no per-event distinction,
+// which is done by a handler.MapFunc. Only updates changing the descriptor of the template invalidate its cached
+// descriptor.
 type TemplateChangeHandler struct {
-	kymaRepository kymaRepository
+	kymaRepository        kymaRepository
+	mrmRepository         moduleReleaseMetaRepository
+	descriptorInvalidator descriptorInvalidator
 }
 
-func NewTemplateChangeHandler(kymaRepo kymaRepository) *TemplateChangeHandler {
-	return &TemplateChangeHandler{
+func NewTemplateChangeHandler(kymaRepo kymaRepository,
+	opts ...func(*TemplateChangeHandler) *TemplateChangeHandler,
+) *TemplateChangeHandler {
+	templateChangeHandler := &TemplateChangeHandler{
 		kymaRepository: kymaRepo,
 	}
+	for _, opt := range opts {
+		templateChangeHandler = opt(templateChangeHandler)
+	}
+	return templateChangeHandler
 }
 
-func (h *TemplateChangeHandler) Watch() handler.MapFunc {
-	return func(ctx context.Context, o client.Object) []reconcile.Request {
-		template, ok := o.(*v1beta2.ModuleTemplate)
-		if !ok {
-			return nil
-		}
+// WithDescriptorInvalidation makes the handler drop the cached descriptor of a ModuleTemplate whose descriptor is
+// updated before the Kymas are requeued, so that a descriptor re-pushed under the same version is loaded again.
+// Creating a ModuleTemplate, which also happens for all of them when the controller starts, does not invalidate the
+// descriptor. The OCM component name of the template is resolved from the ModuleReleaseMeta of its module.
+func WithDescriptorInvalidation(mrmRepo moduleReleaseMetaRepository,
+	invalidator descriptorInvalidator,
+) func(*TemplateChangeHandler) *TemplateChangeHandler {
+	return func(handler *TemplateChangeHandler) *TemplateChangeHandler {
+		handler.mrmRepository = mrmRepo
+		handler.descriptorInvalidator = invalidator
+		return handler
+	}
+}
 
-		kymas, err := h.kymaRepository.LookupByLabel(ctx, shared.ManagedBy, shared.OperatorName)
-		if err != nil {
-			return nil
-		}
+// Watch returns the event handler for ModuleTemplate changes.
+func (h *TemplateChangeHandler) Watch() handler.EventHandler {
+	requeue := handler.EnqueueRequestsFromMapFunc(h.requeueKymas)
+	return handler.Funcs{
+		CreateFunc: requeue.Create,
+		UpdateFunc: func(ctx context.Context, evt event.UpdateEvent,
+			rli workqueue.TypedRateLimitingInterface[reconcile.Request],
+		) {
+			h.invalidateChangedDescriptor(ctx, evt.ObjectOld, evt.ObjectNew)
+			requeue.Update(ctx, evt, rli)
+		},
+		DeleteFunc:  requeue.Delete,
+		GenericFunc: requeue.Generic,
+	}
+}
+
+func (h *TemplateChangeHandler) requeueKymas(ctx context.Context, o client.Object) []reconcile.Request {
+	template, ok := o.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return nil
+	}
+
+	kymas, err := h.kymaRepository.LookupByLabel(ctx, shared.ManagedBy, shared.OperatorName)
+	if err != nil {
+		return nil
+	}
+
+	return getRequestItems(filterKymasWithTemplate(kymas, template))
+}
+
+func (h *TemplateChangeHandler) invalidateChangedDescriptor(ctx context.Context, oldObj, newObj client.Object) {
+	oldTemplate, ok := oldObj.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return
+	}
+	newTemplate, ok := newObj.(*v1beta2.ModuleTemplate)
+	if !ok {
+		return
+	}
+	if bytes.Equal(oldTemplate.Spec.Descriptor.Raw, newTemplate.Spec.Descriptor.Raw) {
+		return
+	}
+	h.invalidateDescriptor(ctx, newTemplate)
+}
 
-		return getRequestItems(filterKymasWithTemplate(kymas, template))
+func (h *TemplateChangeHandler) invalidateDescriptor(ctx context.Context, template *v1beta2.ModuleTemplate) {
+	if h.descriptorInvalidator == nil || template.Spec.ModuleName == "" {
+		return
+	}
+	mrm, err := h.mrmRepository.Get(ctx, template.Spec.ModuleName)
+	if err != nil {
+		return
+	}
+	ocmId, err := ocmidentity.NewComponentId(mrm.Spec.OcmComponentName, template.Spec.Version)
+	if err != nil {
+		return
 	}
+	h.descriptorInvalidator.Invalidate(*ocmId)
 }
 
 func getRequestItems(kymas []v1beta2.Kyma) []reconcile.Request {
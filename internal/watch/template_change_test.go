@@ -0,0 +1,115 @@
+package watch_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
+)
+
+func TestTemplateChangeHandler_WithDescriptorInvalidation_OnDescriptorUpdate_InvalidatesDescriptorOfTemplate(
+	t *testing.T,
+) {
+	invalidator := &descriptorInvalidatorStub{}
+	eventHandler := watch.NewTemplateChangeHandler(&kymaRepositoryStub{},
+		watch.WithDescriptorInvalidation(newMrmRepositoryStub(), invalidator)).Watch()
+	oldTemplate := newTemplate("template-operator", "1.0.1")
+	newTemplate := oldTemplate.DeepCopy()
+	newTemplate.Spec.Descriptor.Raw = []byte(`{"component":{"name":"re-pushed"}}`)
+
+	eventHandler.Update(t.Context(), event.UpdateEvent{ObjectOld: oldTemplate, ObjectNew: newTemplate}, newQueue(t))
+
+	require.Len(t, invalidator.invalidated, 1)
+	assert.Equal(t, "kyma-project.io/module/template-operator", invalidator.invalidated[0].Name())
+	assert.Equal(t, "1.0.1", invalidator.invalidated[0].Version())
+}
+
+func TestTemplateChangeHandler_WithDescriptorInvalidation_OnOtherEvents_DoesNotInvalidate(t *testing.T) {
+	invalidator := &descriptorInvalidatorStub{}
+	eventHandler := watch.NewTemplateChangeHandler(&kymaRepositoryStub{},
+		watch.WithDescriptorInvalidation(newMrmRepositoryStub(), invalidator)).Watch()
+	template := newTemplate("template-operator", "1.0.1")
+	relabeledTemplate := template.DeepCopy()
+	relabeledTemplate.SetLabels(map[string]string{"operator.kyma-project.io/internal": "true"})
+	queue := newQueue(t)
+
+	eventHandler.Create(t.Context(), event.CreateEvent{Object: template}, queue)
+	eventHandler.Update(t.Context(), event.UpdateEvent{ObjectOld: template, ObjectNew: relabeledTemplate}, queue)
+	eventHandler.Delete(t.Context(), event.DeleteEvent{Object: template}, queue)
+
+	assert.Empty(t, invalidator.invalidated)
+}
+
+func TestTemplateChangeHandler_WithDescriptorInvalidation_OnMissingModuleReleaseMeta_DoesNotInvalidate(
+	t *testing.T,
+) {
+	invalidator := &descriptorInvalidatorStub{}
+	mrmRepo := &mrmRepositoryStub{err: errMrmNotFound}
+	eventHandler := watch.NewTemplateChangeHandler(&kymaRepositoryStub{},
+		watch.WithDescriptorInvalidation(mrmRepo, invalidator)).Watch()
+	oldTemplate := newTemplate("template-operator", "1.0.1")
+	newTemplate := oldTemplate.DeepCopy()
+	newTemplate.Spec.Descriptor.Raw = []byte(`{"component":{"name":"re-pushed"}}`)
+
+	eventHandler.Update(t.Context(), event.UpdateEvent{ObjectOld: oldTemplate, ObjectNew: newTemplate}, newQueue(t))
+
+	assert.Empty(t, invalidator.invalidated)
+}
+
+func newMrmRepositoryStub() *mrmRepositoryStub {
+	return &mrmRepositoryStub{mrm: &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName:       "template-operator",
+			OcmComponentName: "kyma-project.io/module/template-operator",
+		},
+	}}
+}
+
+func newQueue(t *testing.T) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	t.Helper()
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	t.Cleanup(queue.ShutDown)
+	return queue
+}
+
+var errMrmNotFound = errors.New("module release meta not found")
+
+func newTemplate(moduleName, version string) *v1beta2.ModuleTemplate {
+	return &v1beta2.ModuleTemplate{
+		ObjectMeta: apimetav1.ObjectMeta{Name: moduleName + "-" + version},
+		Spec:       v1beta2.ModuleTemplateSpec{ModuleName: moduleName, Version: version},
+	}
+}
+
+type kymaRepositoryStub struct{}
+
+func (k *kymaRepositoryStub) LookupByLabel(_ context.Context, _, _ string) (*v1beta2.KymaList, error) {
+	return &v1beta2.KymaList{}, nil
+}
+
+type mrmRepositoryStub struct {
+	mrm *v1beta2.ModuleReleaseMeta
+	err error
+}
+
+func (m *mrmRepositoryStub) Get(_ context.Context, _ string) (*v1beta2.ModuleReleaseMeta, error) {
+	return m.mrm, m.err
+}
+
+type descriptorInvalidatorStub struct {
+	invalidated []ocmidentity.ComponentId
+}
+
+func (d *descriptorInvalidatorStub) Invalidate(ocmId ocmidentity.ComponentId) {
+	d.invalidated = append(d.invalidated, ocmId)
+}
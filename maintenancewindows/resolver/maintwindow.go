@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"slices"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -22,8 +25,14 @@ var (
 	ErrNoWindowInPolicies = errors.New("matched policies did not provide a window")
 	ErrNoWindowFound      = errors.New("matches and defaults also failed to provide a window")
 	ErrJSONUnmarshal      = errors.New("error during unmarshal")
+	ErrYAMLUnmarshal      = errors.New("error during YAML unmarshal")
 )
 
+// policyExtensions are the file extensions of the policies, in the order they are looked up.
+var policyExtensions = []string{".json", ".yaml", ".yml"}
+
+var weekDays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
 type ResolvedWindow struct {
 	Begin time.Time
 	End   time.Time
@@ -64,6 +73,7 @@ type FirstMatchOnly bool
 type FallbackDefault bool
 
 // GetMaintenancePolicy gets the maintenance window policy based on the specified policy name.
+// The policy is looked up as a JSON file first, then as a YAML file.
 //
 // A non-nil error is returned if:
 //   - the specified maintenance policy doesn't exist.
@@ -73,18 +83,41 @@ func GetMaintenancePolicy(pool map[string]*[]byte, name string) (*MaintenanceWin
 		return nil, nil //nolint:nilnil //changing that now would break the API
 	}
 
-	extName := name + ".json"
-	data, exist := pool[extName]
-	if !exist {
-		return nil, fmt.Errorf("%w: %s", ErrPolicyNotExists, name)
+	for _, fileName := range PolicyFileNames(name) {
+		data, exist := pool[fileName]
+		if !exist {
+			continue
+		}
+
+		var policy MaintenanceWindowPolicy
+		var err error
+		if filepath.Ext(fileName) == ".json" {
+			policy, err = NewMaintenanceWindowPolicyFromJSON(*data)
+		} else {
+			policy, err = NewMaintenanceWindowPolicyFromYAML(*data)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &policy, nil
 	}
 
-	policy, err := NewMaintenanceWindowPolicyFromJSON(*data)
-	if err != nil {
-		return nil, err
+	return nil, fmt.Errorf("%w: %s", ErrPolicyNotExists, name)
+}
+
+// PolicyFileNames returns the names of the files the policy with the specified name is
+// looked up in, in the order they are looked up.
+func PolicyFileNames(name string) []string {
+	fileNames := make([]string, 0, len(policyExtensions))
+	for _, ext := range policyExtensions {
+		fileNames = append(fileNames, name+ext)
 	}
+	return fileNames
+}
 
-	return &policy, nil
+// IsPolicyFile returns whether the file name has the extension of a JSON or YAML policy.
+func IsPolicyFile(name string) bool {
+	return slices.Contains(policyExtensions, filepath.Ext(name))
 }
 
 // NewMaintenanceWindowPolicyFromJSON parses a JSON document from a byte array into a
@@ -102,6 +135,25 @@ func NewMaintenanceWindowPolicyFromJSON(raw []byte) (MaintenanceWindowPolicy, er
 	return ruleset, nil
 }
 
+// NewMaintenanceWindowPolicyFromYAML parses a YAML document from a byte array into a
+// MaintenanceWindowPolicy structure. The document has the same structure as the JSON one.
+func NewMaintenanceWindowPolicyFromYAML(raw []byte) (MaintenanceWindowPolicy, error) {
+	var document any
+	if err := yaml.Unmarshal(raw, &document); err != nil {
+		return MaintenanceWindowPolicy{}, fmt.Errorf("%w: %w", ErrYAMLUnmarshal, err)
+	}
+	if document == nil {
+		return MaintenanceWindowPolicy{}, fmt.Errorf("%w: empty document", ErrYAMLUnmarshal)
+	}
+
+	// the policy types only know how to unmarshal themselves from JSON
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return MaintenanceWindowPolicy{}, fmt.Errorf("%w: %w", ErrYAMLUnmarshal, err)
+	}
+	return NewMaintenanceWindowPolicyFromJSON(raw)
+}
+
 // Resolve finds the next applicable maintenance window for a given runtime on the policy.
 //
 // The algorithm can be parameterized using the following typed varargs:
@@ -111,6 +163,8 @@ func NewMaintenanceWindowPolicyFromJSON(raw []byte) (MaintenanceWindowPolicy, er
 //   - FirstMatchOnly: whether to stop at the first matching rule before proceeding to defaults. Defaults to true.
 //   - FallbackDefault: whether to fall back to the default rules if matches provided no window. Defaults to true.
 //
+// The maintenance window chosen for the runtime is resolved before the rules of the policy.
+//
 // If a match is found then a ResolvedWindow pointer is returned with a nil error. Otherwise an
 // error is returned and the ResolvedWindow pointer is nil.
 func (mwp *MaintenanceWindowPolicy) Resolve(runtime *Runtime, opts ...any) (*ResolvedWindow, error) {
@@ -143,6 +197,13 @@ func (mwp *MaintenanceWindowPolicy) Resolve(runtime *Runtime, opts ...any) (*Res
 		}
 	}
 
+	// the window chosen for the runtime wins over the policy
+	if window := runtime.maintenanceWindow(); window != nil {
+		if rw := window.NextWindow(&options); rw != nil {
+			return rw, nil
+		}
+	}
+
 	// first let's see whether any policies are having matching rules
 	matched := false
 	for _, policyrule := range mwp.Rules {
@@ -197,6 +258,13 @@ type MaintenancePolicyMatch struct {
 	Plan            *Regexp `json:"plan,omitempty"`
 	Region          *Regexp `json:"region,omitempty"`
 	PlatformRegion  *Regexp `json:"platformRegion,omitempty"`
+	RuntimeID       *Regexp `json:"runtimeID,omitempty"`    //nolint:tagliatelle,revive // consistent with globalAccountID
+	SubAccountID    *Regexp `json:"subAccountID,omitempty"` //nolint:tagliatelle,revive // consistent with globalAccountID
+	ShootName       *Regexp `json:"shootName,omitempty"`
+}
+
+var matchFields = []string{
+	"GlobalAccountID", "Plan", "Region", "PlatformRegion", "RuntimeID", "SubAccountID", "ShootName",
 }
 
 func (mpm MaintenancePolicyMatch) String() string {
@@ -213,11 +281,20 @@ func (mpm MaintenancePolicyMatch) String() string {
 	if mpm.PlatformRegion != nil && mpm.PlatformRegion.IsValid() {
 		ret += fmt.Sprintf(" PlatformRegion:'%s'", mpm.PlatformRegion)
 	}
+	if mpm.RuntimeID != nil && mpm.RuntimeID.IsValid() {
+		ret += fmt.Sprintf(" RuntimeID:'%s'", mpm.RuntimeID)
+	}
+	if mpm.SubAccountID != nil && mpm.SubAccountID.IsValid() {
+		ret += fmt.Sprintf(" SubAccountID:'%s'", mpm.SubAccountID)
+	}
+	if mpm.ShootName != nil && mpm.ShootName.IsValid() {
+		ret += fmt.Sprintf(" ShootName:'%s'", mpm.ShootName)
+	}
 	return ret + ">"
 }
 
 func (mpm MaintenancePolicyMatch) Match(runtime *Runtime) bool {
-	for _, field := range []string{"GlobalAccountID", "Plan", "Region", "PlatformRegion"} {
+	for _, field := range matchFields {
 		v := reflect.Indirect(reflect.ValueOf(mpm)).FieldByName(field)
 		if v.IsNil() {
 			continue
@@ -32,6 +32,23 @@ func createRuntime(gaid string, plan string, region string,
 	}
 }
 
+func createRuntimeWithWindow(plan string, begin string, end string, days ...string) resolver.Runtime {
+	bTime, err := time.Parse("15:04:05Z07:00", begin)
+	if err != nil {
+		panic(err.Error())
+	}
+	eTime, err := time.Parse("15:04:05Z07:00", end)
+	if err != nil {
+		panic(err.Error())
+	}
+	return resolver.Runtime{
+		Plan:                   plan,
+		MaintenanceWindowBegin: bTime,
+		MaintenanceWindowEnd:   eTime,
+		MaintenanceDays:        days,
+	}
+}
+
 func resWin(begin string, end string) resolver.ResolvedWindow {
 	bTime, err := time.Parse(time.RFC3339, begin)
 	if err != nil {
@@ -168,6 +185,32 @@ func (suite *MaintWindowSuite) SetupSuite() {
 			errors:   true,
 			expected: resWin("2024-12-14T00:00:00Z", "2024-12-15T00:00:00Z"),
 		},
+		{
+			name:     "runtime window on days",
+			runtime:  createRuntimeWithWindow("trial", "22:00:00Z", "02:00:00Z", "Fri"),
+			options:  []any{at("2024-10-03T05:05:00Z")},
+			errors:   false,
+			expected: resWin("2024-10-04T22:00:00Z", "2024-10-05T02:00:00Z"),
+		},
+		{
+			name:    "runtime window ongoing on all days",
+			runtime: createRuntimeWithWindow("", "22:00:00Z", "02:00:00Z"),
+			options: []any{
+				at("2024-10-10T23:00:00Z"),
+				resolver.OngoingWindow(true),
+			},
+			errors:   false,
+			expected: resWin("2024-10-10T22:00:00Z", "2024-10-11T02:00:00Z"),
+		},
+		{
+			name: "runtime matched by runtime ID",
+			runtime: resolver.Runtime{
+				RuntimeID: "runtime-vip-1",
+			},
+			options:  []any{at("2024-10-03T05:05:00Z")},
+			errors:   false,
+			expected: resWin("2024-10-12T02:00:00Z", "2024-10-12T06:00:00Z"),
+		},
 		{
 			name:    "wrong arg",
 			runtime: createRuntime("", "", "uksouth-vikings", ""),
@@ -284,6 +327,32 @@ func (suite *MaintWindowSuite) Test_Match_PlatformRegion() {
 	}
 }
 
+func (suite *MaintWindowSuite) Test_Match_RuntimeIdentifiers() {
+	testdata := []testData{
+		{
+			runtime:  resolver.Runtime{RuntimeID: "runtime-vip-1"},
+			expected: true,
+		},
+		{
+			runtime:  resolver.Runtime{SubAccountID: "sub-vip-1"},
+			expected: true,
+		},
+		{
+			runtime:  resolver.Runtime{ShootName: "c-vip42"},
+			expected: true,
+		},
+		{
+			runtime:  resolver.Runtime{RuntimeID: "runtime-1", SubAccountID: "sub-1", ShootName: "c-1"},
+			expected: false,
+		},
+	}
+
+	matcher := suite.plan.Rules[len(suite.plan.Rules)-1].Match
+	for _, subject := range testdata {
+		suite.Require().Equal(subject.expected, matcher.Match(&subject.runtime))
+	}
+}
+
 func (suite *MaintWindowSuite) Test_Match_TestCases() {
 	/*
 		runtime resolver.Runtime
@@ -315,18 +384,28 @@ func Test_MPMString(t *testing.T) {
 	plan := "blah2"
 	reg := "blah3"
 	preg := "blah4"
+	rid := "blah5"
+	said := "blah6"
+	shoot := "blah7"
 	data := resolver.MaintenancePolicyMatch{
 		GlobalAccountID: func() *resolver.Regexp { r := resolver.NewRegexp(gaid); return &r }(),
 		Plan:            func() *resolver.Regexp { r := resolver.NewRegexp(plan); return &r }(),
 		Region:          func() *resolver.Regexp { r := resolver.NewRegexp(reg); return &r }(),
 		PlatformRegion:  func() *resolver.Regexp { r := resolver.NewRegexp(preg); return &r }(),
+		RuntimeID:       func() *resolver.Regexp { r := resolver.NewRegexp(rid); return &r }(),
+		SubAccountID:    func() *resolver.Regexp { r := resolver.NewRegexp(said); return &r }(),
+		ShootName:       func() *resolver.Regexp { r := resolver.NewRegexp(shoot); return &r }(),
 	}
 	expected := fmt.Sprintf(
-		"<MaintenancePolicyMatch GlobalAccountID:'%s' Plan:'%s' Region:'%s' PlatformRegion:'%s'>",
+		"<MaintenancePolicyMatch GlobalAccountID:'%s' Plan:'%s' Region:'%s' PlatformRegion:'%s'"+
+			" RuntimeID:'%s' SubAccountID:'%s' ShootName:'%s'>",
 		gaid,
 		plan,
 		reg,
 		preg,
+		rid,
+		said,
+		shoot,
 	)
 	require.Equal(t, expected, data.String())
 }
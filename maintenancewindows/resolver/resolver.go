@@ -19,6 +19,11 @@ var (
 
 // Runtime is the data type which captures the needed runtime specific attributes
 // to perform orchestrations on a given runtime.
+//
+// MaintenanceWindowBegin and MaintenanceWindowEnd are the times of the day of the
+// maintenance window chosen for the runtime, which recurs on the MaintenanceDays or
+// on all days if none are set. If both are set, the window takes precedence over
+// the windows of the policy.
 type Runtime struct {
 	InstanceID             string
 	RuntimeID              string
@@ -33,15 +38,38 @@ type Runtime struct {
 	MaintenanceDays        []string
 }
 
+// maintenanceWindow returns the maintenance window chosen for the runtime, or nil
+// if it has none.
+func (r *Runtime) maintenanceWindow() *MaintenanceWindow {
+	if r.MaintenanceWindowBegin.IsZero() || r.MaintenanceWindowEnd.IsZero() {
+		return nil
+	}
+	days := r.MaintenanceDays
+	if len(days) == 0 {
+		days = weekDays
+	}
+	return &MaintenanceWindow{
+		Days:  days,
+		Begin: WindowTime(r.MaintenanceWindowBegin),
+		End:   WindowTime(r.MaintenanceWindowEnd),
+	}
+}
+
 // GetMaintenancePolicyPool extracts and returns the maintenance policies we have under the policy directory.
 func GetMaintenancePolicyPool() (map[string]*[]byte, error) {
-	pool := map[string]*[]byte{}
-
 	path := os.Getenv(PolicyPathENV)
 	if path == "" {
 		return nil, ErrNoPolicyPathEnvVar
 	}
 
+	return ReadMaintenancePolicyPool(path)
+}
+
+// ReadMaintenancePolicyPool reads the JSON and YAML maintenance policies in the directory
+// at path, keyed by their file names.
+func ReadMaintenancePolicyPool(path string) (map[string]*[]byte, error) {
+	pool := map[string]*[]byte{}
+
 	entries, err := os.ReadDir(path)
 	if err != nil {
 		return nil, fmt.Errorf("%w %s: %w", ErrReadingDirectory, path, err)
@@ -49,7 +77,7 @@ func GetMaintenancePolicyPool() (map[string]*[]byte, error) {
 
 	for _, entry := range entries {
 		name := entry.Name()
-		if entry.IsDir() || filepath.Ext(name) != ".json" {
+		if entry.IsDir() || !IsPolicyFile(name) {
 			continue
 		}
 
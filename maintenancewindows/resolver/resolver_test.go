@@ -15,12 +15,60 @@ func TestGetMaintenancePolicyPool(t *testing.T) {
 	pool, err := resolver.GetMaintenancePolicyPool()
 	require.NoError(t, err)
 
-	assert.Len(t, pool, 2)
+	assert.Len(t, pool, 3)
 	assert.Contains(t, pool, "ruleset-1.json")
 	assert.Contains(t, pool, "ruleset-2.json")
+	assert.Contains(t, pool, "ruleset-3.yaml")
 
 	data1 := pool["ruleset-1.json"]
 	data2 := pool["ruleset-2.json"]
 	assert.NotNil(t, data1)
 	assert.NotNil(t, data2)
 }
+
+func TestGetMaintenancePolicy_FromYAML(t *testing.T) {
+	pool, err := resolver.ReadMaintenancePolicyPool("./testdata")
+	require.NoError(t, err)
+
+	policy, err := resolver.GetMaintenancePolicy(pool, "ruleset-3")
+	require.NoError(t, err)
+
+	require.Len(t, policy.Rules, 2)
+	assert.Equal(t, "trial", policy.Rules[0].Match.Plan.String())
+	assert.Equal(t, "free", policy.Rules[1].Match.Plan.String())
+}
+
+func TestNewMaintenanceWindowPolicyFromYAML_EqualsJSON(t *testing.T) {
+	fromJSON, err := resolver.NewMaintenanceWindowPolicyFromJSON([]byte(`{
+		"rules": [{
+			"match": {"region": "europe|eu-", "shootName": "^c-"},
+			"windows": [{"days": ["Sat"], "begin": "21:00:00Z", "end": "00:00:00Z"}]
+		}],
+		"default": {"begin": "2024-10-10T20:00:00Z", "end": "2024-10-11T00:00:00Z"}
+	}`))
+	require.NoError(t, err)
+
+	fromYAML, err := resolver.NewMaintenanceWindowPolicyFromYAML([]byte(`
+rules:
+  - match:
+      region: europe|eu-
+      shootName: ^c-
+    windows:
+      - days: [Sat]
+        begin: "21:00:00Z"
+        end: "00:00:00Z"
+default:
+  begin: 2024-10-10T20:00:00Z
+  end: 2024-10-11T00:00:00Z
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, fromJSON, fromYAML)
+}
+
+func TestNewMaintenanceWindowPolicyFromYAML_InvalidDocument(t *testing.T) {
+	for _, document := range []string{"", "rules: [", "rules:\n  - match:\n      plan: \"(\"\n"} {
+		_, err := resolver.NewMaintenanceWindowPolicyFromYAML([]byte(document))
+		require.Error(t, err, document)
+	}
+}
@@ -44,6 +44,10 @@ func (m *Module) ApplyDefaultMetaToManifest(kyma *v1beta2.Kyma) {
 		lbls[shared.ControllerName] = m.TemplateInfo.GetLabels()[shared.ControllerName]
 	}
 	lbls[shared.ModuleName] = m.ModuleName
+	// the plan selects the resource profile applied to the module workloads
+	if plan := kyma.GetPlan(); plan != "" {
+		lbls[shared.PlanLabel] = plan
+	}
 
 	if !m.TemplateInfo.IsMandatory() {
 		lbls[shared.ChannelLabel] = m.TemplateInfo.DesiredChannel
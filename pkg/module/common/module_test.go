@@ -54,6 +54,17 @@ func TestApplyDefaultMetaToManifest_WhenCalledWithControllerName_SetsControllerN
 	assert.Equal(t, "some-controller", resultLabels["operator.kyma-project.io/controller-name"])
 }
 
+func TestApplyDefaultMetaToManifest_WhenCalledWithPlan_SetsPlanLabel(t *testing.T) {
+	module := createModule()
+	kyma := &v1beta2.Kyma{}
+	kyma.SetLabels(map[string]string{"kyma-project.io/broker-plan-name": "trial"})
+
+	module.ApplyDefaultMetaToManifest(kyma)
+
+	resultLabels := module.Manifest.GetLabels()
+	assert.Equal(t, "trial", resultLabels["kyma-project.io/broker-plan-name"])
+}
+
 func TestApplyDefaultMetaToManifest_WhenCalledWithMandatoryModule_NoChannelLabelIsSet(t *testing.T) {
 	module := createModule()
 	module.TemplateInfo.Spec.Mandatory = true
@@ -0,0 +1,217 @@
+package dependency
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+)
+
+var (
+	ErrUnmetDependencies      = errors.New("module dependencies are not met")
+	ErrRequiredByOtherModules = errors.New("module can not be removed as it is required by other modules")
+	ErrWaitingForDependencies = errors.New("waiting for required modules to become ready")
+)
+
+// Validate checks the requirements of all modules and records violations on their template info.
+// An enabled module whose required modules are not enabled, do not satisfy the version constraint, or
+// depend on each other in a cycle fails with ErrUnmetDependencies. A disabled module that is still
+// required by an enabled module fails with ErrRequiredByOtherModules to protect it from removal.
+func Validate(modules modulecommon.Modules) {
+	enabled := make(map[string]*modulecommon.Module, len(modules))
+	for _, module := range modules {
+		if module.Enabled && module.TemplateInfo != nil {
+			enabled[module.ModuleName] = module
+		}
+	}
+
+	for _, module := range enabled {
+		if module.TemplateInfo.Err != nil {
+			continue
+		}
+		if violations := unmetRequirements(module, enabled); len(violations) != 0 {
+			module.TemplateInfo.Err = fmt.Errorf("%w: %s", ErrUnmetDependencies, strings.Join(violations, "; "))
+		}
+	}
+
+	if _, cyclic := orderWaves(sortedModules(enabled)); len(cyclic) != 0 {
+		cycle := strings.Join(moduleNames(cyclic), ", ")
+		for _, module := range cyclic {
+			if module.TemplateInfo.Err == nil {
+				module.TemplateInfo.Err = fmt.Errorf("%w: cyclic dependency among modules %s",
+					ErrUnmetDependencies, cycle)
+			}
+		}
+	}
+
+	for _, module := range modules {
+		if module.Enabled || module.TemplateInfo == nil {
+			continue
+		}
+		if dependents := requiredBy(module.ModuleName, enabled); len(dependents) != 0 {
+			module.TemplateInfo.Err = fmt.Errorf("%w: %s", ErrRequiredByOtherModules, strings.Join(dependents, ", "))
+		}
+	}
+}
+
+// InstallOrder groups the modules into waves so that every module is placed after the modules it requires.
+// Modules of the same wave do not depend on each other. Modules that can not be ordered because of a
+// dependency cycle are placed into the last wave.
+func InstallOrder(modules modulecommon.Modules) []modulecommon.Modules {
+	waves, cyclic := orderWaves(modules)
+	if len(cyclic) != 0 {
+		waves = append(waves, cyclic)
+	}
+	return waves
+}
+
+// CheckReady returns ErrWaitingForDependencies if any module required by the given module is not Ready yet.
+func CheckReady(module *modulecommon.Module, modules modulecommon.Modules) error {
+	if module.TemplateInfo == nil {
+		return nil
+	}
+	var pending []string
+	for _, requirement := range module.TemplateInfo.Requires {
+		idx := slices.IndexFunc(modules, func(candidate *modulecommon.Module) bool {
+			return candidate.ModuleName == requirement.Name
+		})
+		if idx < 0 || !isReady(modules[idx]) {
+			pending = append(pending, requirement.Name)
+		}
+	}
+	if len(pending) != 0 {
+		return fmt.Errorf("%w: %s", ErrWaitingForDependencies, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// RequiredByOtherModules returns the error recorded by Validate if the named module is disabled but still required
+// by enabled modules, and nil otherwise. Such modules are kept until their dependents are removed.
+func RequiredByOtherModules(modules modulecommon.Modules, moduleName string) error {
+	for _, module := range modules {
+		if module.ModuleName == moduleName && module.TemplateInfo != nil &&
+			errors.Is(module.TemplateInfo.Err, ErrRequiredByOtherModules) {
+			return module.TemplateInfo.Err
+		}
+	}
+	return nil
+}
+
+func unmetRequirements(module *modulecommon.Module, enabled map[string]*modulecommon.Module) []string {
+	var violations []string
+	for _, requirement := range module.TemplateInfo.Requires {
+		required, found := enabled[requirement.Name]
+		if !found {
+			violations = append(violations, fmt.Sprintf("required module %s is not enabled", requirement.Name))
+			continue
+		}
+		if requirement.Version == "" || required.TemplateInfo.ModuleTemplate == nil {
+			continue
+		}
+		if err := checkVersion(requirement.Version, required.TemplateInfo.Spec.Version); err != nil {
+			violations = append(violations, fmt.Sprintf("required module %s: %s", requirement.Name, err))
+		}
+	}
+	return violations
+}
+
+func checkVersion(constraint, version string) error {
+	versionConstraint, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+	resolvedVersion, err := semver.NewVersion(version)
+	if err != nil {
+		return fmt.Errorf("invalid version %q: %w", version, err)
+	}
+	if !versionConstraint.Check(resolvedVersion) {
+		return fmt.Errorf("version %s does not satisfy %q", version, constraint)
+	}
+	return nil
+}
+
+// orderWaves sorts the modules topologically into waves and returns the modules that can not be ordered
+// because they are part of, or depend on, a dependency cycle.
+func orderWaves(modules modulecommon.Modules) ([]modulecommon.Modules, modulecommon.Modules) {
+	known := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		known[module.ModuleName] = true
+	}
+
+	placed := make(map[string]bool, len(modules))
+	remaining := slices.Clone(modules)
+	var waves []modulecommon.Modules
+	for len(remaining) != 0 {
+		var wave, blocked modulecommon.Modules
+		for _, module := range remaining {
+			if requirementsPlaced(module, known, placed) {
+				wave = append(wave, module)
+			} else {
+				blocked = append(blocked, module)
+			}
+		}
+		if len(wave) == 0 {
+			return waves, blocked
+		}
+		for _, module := range wave {
+			placed[module.ModuleName] = true
+		}
+		waves = append(waves, wave)
+		remaining = blocked
+	}
+	return waves, nil
+}
+
+func requiredBy(moduleName string, enabled map[string]*modulecommon.Module) []string {
+	var dependents []string
+	for _, module := range enabled {
+		if slices.ContainsFunc(module.TemplateInfo.Requires, func(requirement v1beta2.ModuleDependency) bool {
+			return requirement.Name == moduleName
+		}) {
+			dependents = append(dependents, module.ModuleName)
+		}
+	}
+	slices.Sort(dependents)
+	return dependents
+}
+
+func requirementsPlaced(module *modulecommon.Module, known, placed map[string]bool) bool {
+	if module.TemplateInfo == nil {
+		return true
+	}
+	for _, requirement := range module.TemplateInfo.Requires {
+		if known[requirement.Name] && !placed[requirement.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+func isReady(module *modulecommon.Module) bool {
+	return module.Manifest != nil && module.Manifest.Status.State == shared.StateReady
+}
+
+func sortedModules(modulesByName map[string]*modulecommon.Module) modulecommon.Modules {
+	modules := make(modulecommon.Modules, 0, len(modulesByName))
+	for _, module := range modulesByName {
+		modules = append(modules, module)
+	}
+	slices.SortFunc(modules, func(a, b *modulecommon.Module) int {
+		return strings.Compare(a.ModuleName, b.ModuleName)
+	})
+	return modules
+}
+
+func moduleNames(modules modulecommon.Modules) []string {
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, module.ModuleName)
+	}
+	return names
+}
@@ -0,0 +1,196 @@
+package dependency_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/dependency"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+func newModule(name, version string, enabled bool, requires ...v1beta2.ModuleDependency) *modulecommon.Module {
+	template := &v1beta2.ModuleTemplate{}
+	template.Spec.Version = version
+	return &modulecommon.Module{
+		ModuleName: name,
+		Enabled:    enabled,
+		TemplateInfo: &templatelookup.ModuleTemplateInfo{
+			ModuleTemplate: template,
+			Requires:       requires,
+		},
+	}
+}
+
+func withManifestState(module *modulecommon.Module, state shared.State) *modulecommon.Module {
+	module.Manifest = &v1beta2.Manifest{}
+	module.Manifest.Status.State = state
+	return module
+}
+
+func moduleNames(modules modulecommon.Modules) []string {
+	names := make([]string, 0, len(modules))
+	for _, module := range modules {
+		names = append(names, module.ModuleName)
+	}
+	return names
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		modules        modulecommon.Modules
+		expectedErrors map[string]error
+	}{
+		{
+			name: "passes when all required modules are enabled",
+			modules: modulecommon.Modules{
+				newModule("istio", "1.2.0", true),
+				newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio", Version: ">=1.0.0"}),
+			},
+		},
+		{
+			name: "fails when a required module is not enabled",
+			modules: modulecommon.Modules{
+				newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio"}),
+			},
+			expectedErrors: map[string]error{"serverless": dependency.ErrUnmetDependencies},
+		},
+		{
+			name: "fails when a required module does not satisfy the version constraint",
+			modules: modulecommon.Modules{
+				newModule("istio", "0.9.0", true),
+				newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio", Version: ">=1.0.0"}),
+			},
+			expectedErrors: map[string]error{"serverless": dependency.ErrUnmetDependencies},
+		},
+		{
+			name: "fails when modules require each other",
+			modules: modulecommon.Modules{
+				newModule("eventing", "1.0.0", true, v1beta2.ModuleDependency{Name: "serverless"}),
+				newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "eventing"}),
+			},
+			expectedErrors: map[string]error{
+				"eventing":   dependency.ErrUnmetDependencies,
+				"serverless": dependency.ErrUnmetDependencies,
+			},
+		},
+		{
+			name: "protects a disabled module that is still required",
+			modules: modulecommon.Modules{
+				newModule("istio", "1.2.0", false),
+				newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio"}),
+			},
+			expectedErrors: map[string]error{
+				"istio":      dependency.ErrRequiredByOtherModules,
+				"serverless": dependency.ErrUnmetDependencies,
+			},
+		},
+		{
+			name: "allows removing a module that is no longer required",
+			modules: modulecommon.Modules{
+				newModule("istio", "1.2.0", false),
+				newModule("serverless", "1.0.0", true),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dependency.Validate(testCase.modules)
+
+			for _, module := range testCase.modules {
+				expectedErr, expected := testCase.expectedErrors[module.ModuleName]
+				if expected {
+					require.ErrorIs(t, module.TemplateInfo.Err, expectedErr, module.ModuleName)
+				} else {
+					require.NoError(t, module.TemplateInfo.Err, module.ModuleName)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_ReportsUnmetRequirementsInMessage(t *testing.T) {
+	t.Parallel()
+	serverless := newModule("serverless", "1.0.0", true,
+		v1beta2.ModuleDependency{Name: "istio", Version: ">=1.0.0"},
+		v1beta2.ModuleDependency{Name: "api-gateway"},
+	)
+
+	dependency.Validate(modulecommon.Modules{newModule("istio", "0.9.0", true), serverless})
+
+	require.ErrorContains(t, serverless.TemplateInfo.Err,
+		`required module istio: version 0.9.0 does not satisfy ">=1.0.0"; `+
+			"required module api-gateway is not enabled")
+}
+
+func TestInstallOrder(t *testing.T) {
+	t.Parallel()
+	modules := modulecommon.Modules{
+		newModule("serverless", "1.0.0", true,
+			v1beta2.ModuleDependency{Name: "istio"}, v1beta2.ModuleDependency{Name: "api-gateway"}),
+		newModule("api-gateway", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio"}),
+		newModule("istio", "1.0.0", true),
+		newModule("keda", "1.0.0", true, v1beta2.ModuleDependency{Name: "not-enabled"}),
+	}
+
+	waves := dependency.InstallOrder(modules)
+
+	require.Len(t, waves, 3)
+	assert.ElementsMatch(t, []string{"istio", "keda"}, moduleNames(waves[0]))
+	assert.Equal(t, []string{"api-gateway"}, moduleNames(waves[1]))
+	assert.Equal(t, []string{"serverless"}, moduleNames(waves[2]))
+}
+
+func TestInstallOrder_PlacesCyclicModulesLast(t *testing.T) {
+	t.Parallel()
+	modules := modulecommon.Modules{
+		newModule("eventing", "1.0.0", true, v1beta2.ModuleDependency{Name: "serverless"}),
+		newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "eventing"}),
+		newModule("istio", "1.0.0", true),
+	}
+
+	waves := dependency.InstallOrder(modules)
+
+	require.Len(t, waves, 2)
+	assert.Equal(t, []string{"istio"}, moduleNames(waves[0]))
+	assert.ElementsMatch(t, []string{"eventing", "serverless"}, moduleNames(waves[1]))
+}
+
+func TestCheckReady(t *testing.T) {
+	t.Parallel()
+	istio := newModule("istio", "1.0.0", true)
+	serverless := newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio"})
+	modules := modulecommon.Modules{istio, serverless}
+
+	require.ErrorIs(t, dependency.CheckReady(serverless, modules), dependency.ErrWaitingForDependencies)
+
+	withManifestState(istio, shared.StateProcessing)
+	require.ErrorIs(t, dependency.CheckReady(serverless, modules), dependency.ErrWaitingForDependencies)
+
+	withManifestState(istio, shared.StateReady)
+	require.NoError(t, dependency.CheckReady(serverless, modules))
+}
+
+func TestRequiredByOtherModules(t *testing.T) {
+	t.Parallel()
+	istio := newModule("istio", "1.0.0", false)
+	serverless := newModule("serverless", "1.0.0", true, v1beta2.ModuleDependency{Name: "istio"})
+	eventing := newModule("eventing", "1.0.0", false)
+	modules := modulecommon.Modules{istio, serverless, eventing}
+
+	dependency.Validate(modules)
+
+	require.ErrorIs(t, dependency.RequiredByOtherModules(modules, "istio"), dependency.ErrRequiredByOtherModules)
+	require.NoError(t, dependency.RequiredByOtherModules(modules, "eventing"))
+	require.NoError(t, dependency.RequiredByOtherModules(modules, "unknown"))
+}
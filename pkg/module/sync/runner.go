@@ -1,10 +1,13 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +20,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/pkg/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	modulecommon "github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/dependency"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
 )
@@ -41,10 +45,27 @@ type Runner struct {
 func (r *Runner) ReconcileManifests(ctx context.Context, kyma *v1beta2.Kyma,
 	modules modulecommon.Modules,
 ) error {
+	dependency.Validate(modules)
+
+	var errs []error
+	// Modules are reconciled in waves so that required modules are installed before the modules requiring them.
+	for _, wave := range dependency.InstallOrder(modules) {
+		errs = append(errs, r.reconcileWave(ctx, kyma, wave, modules)...)
+	}
+	if len(errs) != 0 {
+		errs = append(errs, fmt.Errorf("%w for Kyma %s", ErrManifestSSAFailed, kyma.GetName()))
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+func (r *Runner) reconcileWave(ctx context.Context, kyma *v1beta2.Kyma,
+	wave, modules modulecommon.Modules,
+) []error {
 	baseLogger := logf.FromContext(ctx)
 
-	results := make(chan error, len(modules))
-	for _, module := range modules {
+	results := make(chan error, len(wave))
+	for _, module := range wave {
 		go func(module *modulecommon.Module) {
 			// Should not happen, but in case of NPE, we should stop process further.
 			if module.TemplateInfo == nil {
@@ -61,7 +82,7 @@ func (r *Runner) ReconcileManifests(ctx context.Context, kyma *v1beta2.Kyma,
 				results <- nil
 				return
 			}
-			if err := r.updateManifest(ctx, kyma, module); err != nil {
+			if err := r.updateManifest(ctx, kyma, module, modules); err != nil {
 				results <- fmt.Errorf("could not update module %s: %w", module.Manifest.GetName(), err)
 				return
 			}
@@ -70,20 +91,16 @@ func (r *Runner) ReconcileManifests(ctx context.Context, kyma *v1beta2.Kyma,
 		}(module)
 	}
 	var errs []error
-	for range modules {
+	for range wave {
 		if err := <-results; err != nil {
 			errs = append(errs, err)
 		}
 	}
-	if len(errs) != 0 {
-		errs = append(errs, fmt.Errorf("%w for Kyma %s", ErrManifestSSAFailed, kyma.GetName()))
-		return errors.Join(errs...)
-	}
-	return nil
+	return errs
 }
 
 func (r *Runner) updateManifest(ctx context.Context, kyma *v1beta2.Kyma,
-	module *modulecommon.Module,
+	module *modulecommon.Module, modules modulecommon.Modules,
 ) error {
 	if err := r.setupModule(module, kyma); err != nil {
 		return err
@@ -103,6 +120,14 @@ func (r *Runner) updateManifest(ctx context.Context, kyma *v1beta2.Kyma,
 		return err
 	}
 
+	// A module is only installed once all modules it requires are ready.
+	if manifestInCluster == nil && module.Enabled {
+		if err := dependency.CheckReady(module, modules); err != nil {
+			module.TemplateInfo.Err = err
+			return nil
+		}
+	}
+
 	if err := r.doUpdateWithStrategy(ctx, module, manifestInCluster, newManifest, moduleStatus); err != nil {
 		return err
 	}
@@ -195,7 +220,12 @@ func NeedToUpdate(manifestInCluster, newManifest *v1beta2.Manifest, moduleInStat
 	}
 
 	diffInSpec := newManifest.Spec.Version != manifestInCluster.Spec.Version ||
-		!newManifest.IsSameChannel(manifestInCluster)
+		!newManifest.IsSameChannel(manifestInCluster) ||
+		!bytes.Equal(rawValues(newManifest), rawValues(manifestInCluster)) ||
+		!equality.Semantic.DeepEqual(newManifest.Spec.Scheduling, manifestInCluster.Spec.Scheduling) ||
+		!equality.Semantic.DeepEqual(newManifest.Spec.DriftPolicy, manifestInCluster.Spec.DriftPolicy) ||
+		!slices.Equal(newManifest.Spec.ImageDigests, manifestInCluster.Spec.ImageDigests) ||
+		newManifest.GetLabels()[shared.PlanLabel] != manifestInCluster.GetLabels()[shared.PlanLabel]
 	if manifestInCluster.IsMandatoryModule() || moduleInStatus == nil {
 		return diffInSpec
 	}
@@ -205,6 +235,13 @@ func NeedToUpdate(manifestInCluster, newManifest *v1beta2.Manifest, moduleInStat
 	return diffInTemplate || diffInSpec
 }
 
+func rawValues(manifest *v1beta2.Manifest) []byte {
+	if manifest.Spec.Values == nil {
+		return nil
+	}
+	return manifest.Spec.Values.Raw
+}
+
 func (r *Runner) deleteManifest(ctx context.Context, module *modulecommon.Module) error {
 	err := r.Delete(ctx, module.Manifest)
 	if util.IsNotFound(err) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
@@ -120,6 +121,172 @@ func TestNeedToUpdate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"When module values change, expect need to update",
+			args{
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version: "0.1",
+						Values:  &machineryruntime.RawExtension{Raw: []byte(`{"replicas":1}`)},
+					},
+				},
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version: "0.1",
+						Values:  &machineryruntime.RawExtension{Raw: []byte(`{"replicas":2}`)},
+					},
+				},
+				&v1beta2.ModuleStatus{
+					Version: "0.1", Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: trackedModuleTemplateGeneration,
+						},
+					},
+				},
+				&modulecommon.Module{
+					TemplateInfo: &templatelookup.ModuleTemplateInfo{
+						ModuleTemplate: &v1beta2.ModuleTemplate{
+							ObjectMeta: apimetav1.ObjectMeta{
+								Generation: trackedModuleTemplateGeneration,
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"When Kyma scheduling policy changes, expect need to update",
+			args{
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version:    "0.1",
+						Scheduling: &v1beta2.SchedulingPolicy{PriorityClassName: "kyma-system-priority"},
+					},
+				},
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.ModuleStatus{
+					Version: "0.1", Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: trackedModuleTemplateGeneration,
+						},
+					},
+				},
+				&modulecommon.Module{
+					TemplateInfo: &templatelookup.ModuleTemplateInfo{
+						ModuleTemplate: &v1beta2.ModuleTemplate{
+							ObjectMeta: apimetav1.ObjectMeta{
+								Generation: trackedModuleTemplateGeneration,
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"When drift policy of the Kyma module changes, expect need to update",
+			args{
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version: "0.1",
+						DriftPolicy: []v1beta2.DriftRule{
+							{Kind: "ConfigMap", Name: "tuned-config", Action: v1beta2.DriftActionIgnore},
+						},
+					},
+				},
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.ModuleStatus{
+					Version: "0.1", Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: trackedModuleTemplateGeneration,
+						},
+					},
+				},
+				&modulecommon.Module{
+					TemplateInfo: &templatelookup.ModuleTemplateInfo{
+						ModuleTemplate: &v1beta2.ModuleTemplate{
+							ObjectMeta: apimetav1.ObjectMeta{
+								Generation: trackedModuleTemplateGeneration,
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"When image digests of the Kyma module change, expect need to update",
+			args{
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version:      "0.1",
+						ImageDigests: []string{"europe-docker.pkg.dev/kyma-project/prod/template-operator@sha256:1234"},
+					},
+				},
+				&v1beta2.Manifest{
+					Spec: v1beta2.ManifestSpec{
+						Version:      "0.1",
+						ImageDigests: []string{"europe-docker.pkg.dev/kyma-project/prod/template-operator@sha256:5678"},
+					},
+				},
+				&v1beta2.ModuleStatus{
+					Version: "0.1", Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: trackedModuleTemplateGeneration,
+						},
+					},
+				},
+				&modulecommon.Module{
+					TemplateInfo: &templatelookup.ModuleTemplateInfo{
+						ModuleTemplate: &v1beta2.ModuleTemplate{
+							ObjectMeta: apimetav1.ObjectMeta{
+								Generation: trackedModuleTemplateGeneration,
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"When Kyma plan changes, expect need to update",
+			args{
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.PlanLabel: "trial"},
+					},
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.Manifest{
+					ObjectMeta: apimetav1.ObjectMeta{
+						Labels: map[string]string{shared.PlanLabel: "production"},
+					},
+					Spec: v1beta2.ManifestSpec{Version: "0.1"},
+				},
+				&v1beta2.ModuleStatus{
+					Version: "0.1", Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: trackedModuleTemplateGeneration,
+						},
+					},
+				},
+				&modulecommon.Module{
+					TemplateInfo: &templatelookup.ModuleTemplateInfo{
+						ModuleTemplate: &v1beta2.ModuleTemplate{
+							ObjectMeta: apimetav1.ObjectMeta{
+								Generation: trackedModuleTemplateGeneration,
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
 		{
 			"When cluster Manifest in divergent state, expect need to update",
 			args{
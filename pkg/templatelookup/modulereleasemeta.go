@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,6 +16,7 @@ var (
 	ErrChannelNotFound  = errors.New("no versions found for channel")
 	ErrNoChannelsFound  = errors.New("no channels found for module")
 	ErrNoMandatoryFound = errors.New("no mandatory version found for module")
+	ErrVersionNotFound  = errors.New("version not available for module")
 )
 
 // GetModuleReleaseMeta finds the MRM by the name of module in the Kyma spec.
@@ -55,3 +58,28 @@ func GetMandatoryVersionForModule(moduleReleaseMeta *v1beta2.ModuleReleaseMeta)
 	}
 	return mandatory.Version, nil
 }
+
+// GetPinnedVersionForModule validates that the pinned version is assigned to at least one channel
+// of the ModuleReleaseMeta and returns it.
+func GetPinnedVersionForModule(moduleReleaseMeta *v1beta2.ModuleReleaseMeta, pinnedVersion string) (string, error) {
+	for _, channelAssignment := range moduleReleaseMeta.Spec.Channels {
+		if channelAssignment.Version == pinnedVersion {
+			return pinnedVersion, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s in module %s, available versions: %s", ErrVersionNotFound,
+		pinnedVersion, moduleReleaseMeta.Name, strings.Join(GetAvailableVersionsForModule(moduleReleaseMeta), ", "))
+}
+
+// GetAvailableVersionsForModule returns the distinct versions assigned to the channels of the ModuleReleaseMeta
+// in the order of the channel assignments.
+func GetAvailableVersionsForModule(moduleReleaseMeta *v1beta2.ModuleReleaseMeta) []string {
+	versions := make([]string, 0, len(moduleReleaseMeta.Spec.Channels))
+	for _, channelAssignment := range moduleReleaseMeta.Spec.Channels {
+		if !slices.Contains(versions, channelAssignment.Version) {
+			versions = append(versions, channelAssignment.Version)
+		}
+	}
+	return versions
+}
@@ -77,3 +77,45 @@ func Test_GetMandatoryVersionForModule_WhenMandatoryNotFound(t *testing.T) {
 
 	require.ErrorIs(t, err, templatelookup.ErrNoMandatoryFound)
 }
+
+func Test_GetPinnedVersionForModule_WhenVersionAssignedToChannel(t *testing.T) {
+	moduleReleaseMeta := &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{
+					Channel: "regular",
+					Version: "1.0.0",
+				},
+				{
+					Channel: "fast",
+					Version: "1.1.0",
+				},
+			},
+		},
+	}
+	version, err := templatelookup.GetPinnedVersionForModule(moduleReleaseMeta, "1.1.0")
+
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", version)
+}
+
+func Test_GetPinnedVersionForModule_WhenVersionNotAssignedToChannel(t *testing.T) {
+	moduleReleaseMeta := &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			Channels: []v1beta2.ChannelVersionAssignment{
+				{
+					Channel: "regular",
+					Version: "1.0.0",
+				},
+				{
+					Channel: "fast",
+					Version: "1.0.0",
+				},
+			},
+		},
+	}
+	_, err := templatelookup.GetPinnedVersionForModule(moduleReleaseMeta, "0.9.0")
+
+	require.ErrorIs(t, err, templatelookup.ErrVersionNotFound)
+	require.ErrorContains(t, err, "available versions: 1.0.0")
+}
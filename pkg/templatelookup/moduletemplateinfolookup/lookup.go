@@ -6,6 +6,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
@@ -40,9 +41,13 @@ func (l Lookup) Lookup(ctx context.Context,
 
 	var resolvedModuleVersion string
 	var err error
-	if moduleReleaseMeta.Spec.Mandatory != nil {
+	switch {
+	case moduleReleaseMeta.Spec.Mandatory != nil:
 		resolvedModuleVersion, err = templatelookup.GetMandatoryVersionForModule(moduleReleaseMeta)
-	} else {
+	case moduleInfo.IsInstalledByVersion():
+		moduleTemplateInfo.DesiredChannel = string(shared.NoneChannel)
+		resolvedModuleVersion, err = getPinnedVersion(moduleInfo, moduleReleaseMeta)
+	default:
 		resolvedModuleVersion, err = templatelookup.GetChannelVersionForModule(moduleReleaseMeta,
 			moduleTemplateInfo.DesiredChannel)
 	}
@@ -72,3 +77,15 @@ func (l Lookup) Lookup(ctx context.Context,
 	moduleTemplateInfo.ModuleTemplate = template
 	return moduleTemplateInfo
 }
+
+// getPinnedVersion returns the version a module is pinned to. A version pinned in the Kyma spec must be
+// known to the ModuleReleaseMeta, whereas a module that is only left in the Kyma status keeps the version
+// it was installed with to allow its clean removal.
+func getPinnedVersion(moduleInfo *templatelookup.ModuleInfo,
+	moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) (string, error) {
+	if !moduleInfo.Enabled {
+		return moduleInfo.Version, nil
+	}
+	return templatelookup.GetPinnedVersionForModule(moduleReleaseMeta, moduleInfo.Version)
+}
@@ -10,6 +10,7 @@ import (
 	machineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
@@ -371,3 +372,113 @@ func TestLookup_UsesDefaultChannelWhenNeitherChannelSpecified(t *testing.T) {
 	assert.Equal(t, v1beta2.DefaultChannel, result.DesiredChannel)
 	assert.NotNil(t, result.ComponentId)
 }
+
+func TestLookup_WithPinnedVersion_Success(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	moduleTemplate := builder.NewModuleTemplateBuilder().
+		WithName(v1beta2.CreateModuleTemplateName("test-module", "1.0.0")).
+		WithModuleName("test-module").
+		WithVersion("1.0.0").
+		WithNamespace("kyma-system").
+		Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(moduleTemplate).
+		Build()
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("test-module").
+		WithOcmComponentName("kyma-project.io/test-module").
+		WithModuleChannelAndVersions([]v1beta2.ChannelVersionAssignment{
+			{Channel: "regular", Version: "2.0.0"},
+			{Channel: "stable", Version: "1.0.0"},
+		}).
+		Build()
+
+	lookup := moduletemplateinfolookup.NewLookup(fakeClient)
+
+	result := lookup.Lookup(context.Background(),
+		&templatelookup.ModuleInfo{
+			Module:  v1beta2.Module{Name: "test-module", Version: "1.0.0"},
+			Enabled: true,
+		},
+		&v1beta2.Kyma{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Namespace: "kyma-system",
+			},
+			Spec: v1beta2.KymaSpec{
+				Channel: "regular",
+			},
+		},
+		moduleReleaseMeta)
+
+	require.NoError(t, result.Err)
+	assert.NotNil(t, result.ModuleTemplate)
+	assert.Equal(t, "1.0.0", result.Spec.Version)
+	assert.Equal(t, string(shared.NoneChannel), result.DesiredChannel)
+	assert.Equal(t, "1.0.0", result.ComponentId.Version())
+}
+
+func TestLookup_WithPinnedVersionUnknownToModuleReleaseMeta_ReturnsError(t *testing.T) {
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("test-module").
+		WithOcmComponentName("kyma-project.io/test-module").
+		WithSingleModuleChannelAndVersions("regular", "2.0.0").
+		Build()
+
+	lookup := moduletemplateinfolookup.NewLookup(nil)
+
+	result := lookup.Lookup(context.Background(),
+		&templatelookup.ModuleInfo{
+			Module:  v1beta2.Module{Name: "test-module", Version: "1.0.0"},
+			Enabled: true,
+		},
+		&v1beta2.Kyma{},
+		moduleReleaseMeta)
+
+	require.ErrorIs(t, result.Err, templatelookup.ErrVersionNotFound)
+	assert.Equal(t, string(shared.NoneChannel), result.DesiredChannel)
+	assert.Nil(t, result.ModuleTemplate)
+}
+
+func TestLookup_WithPinnedVersionOnlyInStatus_UsesInstalledVersion(t *testing.T) {
+	scheme := machineryruntime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	moduleTemplate := builder.NewModuleTemplateBuilder().
+		WithName(v1beta2.CreateModuleTemplateName("test-module", "1.0.0")).
+		WithModuleName("test-module").
+		WithVersion("1.0.0").
+		WithNamespace("kyma-system").
+		Build()
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(moduleTemplate).
+		Build()
+
+	moduleReleaseMeta := builder.NewModuleReleaseMetaBuilder().
+		WithModuleName("test-module").
+		WithOcmComponentName("kyma-project.io/test-module").
+		WithSingleModuleChannelAndVersions("regular", "2.0.0").
+		Build()
+
+	lookup := moduletemplateinfolookup.NewLookup(fakeClient)
+
+	result := lookup.Lookup(context.Background(),
+		&templatelookup.ModuleInfo{
+			Module: v1beta2.Module{Name: "test-module", Channel: string(shared.NoneChannel), Version: "1.0.0"},
+		},
+		&v1beta2.Kyma{
+			ObjectMeta: apimetav1.ObjectMeta{
+				Namespace: "kyma-system",
+			},
+		},
+		moduleReleaseMeta)
+
+	require.NoError(t, result.Err)
+	assert.Equal(t, "1.0.0", result.Spec.Version)
+}
@@ -58,6 +58,11 @@ func (p WithMaintenanceWindowDecorator) Lookup(ctx context.Context,
 		return moduleTemplateInfo
 	}
 
+	// moving away from a revoked version is an incident response that does not wait for a maintenance window
+	if moduleTemplateInfo.RevokedFrom != "" {
+		return moduleTemplateInfo
+	}
+
 	if !p.maintenanceWindow.IsRequired(moduleTemplateInfo.ModuleTemplate, kyma) {
 		return moduleTemplateInfo
 	}
@@ -171,6 +171,37 @@ func Test_WithMWDecorator_Lookup_ReturnsModuleTemplateInfo_WhenMWIsRequiredAndAc
 	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
 }
 
+func Test_WithMWDecorator_Lookup_ReturnsModuleTemplateInfo_WhenModuleIsMovedAwayFromRevokedVersion(t *testing.T) {
+	maintenanceWindow := &maintenanceWindowStub{
+		required: true,
+		active:   false,
+	}
+	expectedModuleTemplateInfo := templatelookup.ModuleTemplateInfo{
+		DesiredChannel: "test",
+		ModuleTemplate: &v1beta2.ModuleTemplate{
+			Spec: v1beta2.ModuleTemplateSpec{
+				Channel: "test",
+				Version: "1.1.1",
+			},
+		},
+		RevokedFrom: "1.1.0",
+	}
+	decorated := &lookupStrategyStub{
+		moduleTemplateInfo: expectedModuleTemplateInfo,
+	}
+	withMaintenanceWindowDecorator := moduletemplateinfolookup.NewWithMaintenanceWindowDecorator(maintenanceWindow,
+		decorated)
+
+	moduleTemplateInfo := withMaintenanceWindowDecorator.Lookup(t.Context(),
+		nil,
+		nil,
+		nil)
+
+	assert.False(t, maintenanceWindow.requiredCalled)
+	assert.False(t, maintenanceWindow.activeCalled)
+	assert.Equal(t, expectedModuleTemplateInfo, moduleTemplateInfo)
+}
+
 type lookupStrategyStub struct {
 	moduleTemplateInfo templatelookup.ModuleTemplateInfo
 }
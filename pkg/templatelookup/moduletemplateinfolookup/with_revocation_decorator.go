@@ -0,0 +1,77 @@
+package moduletemplateinfolookup
+
+import (
+	"context"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerevocation"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+// WithRevocationDecorator stops the upgrades of frozen modules and moves Kymas away from revoked module versions,
+// as defined by the ModuleReleaseMeta.
+type WithRevocationDecorator struct {
+	lookup ModuleLookup
+}
+
+func NewWithRevocationDecorator(lookup ModuleLookup) WithRevocationDecorator {
+	return WithRevocationDecorator{lookup: lookup}
+}
+
+func (p WithRevocationDecorator) Lookup(ctx context.Context,
+	moduleInfo *templatelookup.ModuleInfo,
+	kyma *v1beta2.Kyma,
+	moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) templatelookup.ModuleTemplateInfo {
+	moduleTemplateInfo := p.lookup.Lookup(ctx,
+		moduleInfo,
+		kyma,
+		moduleReleaseMeta)
+
+	// lookup returns an error case => return immediately
+	if moduleTemplateInfo.ModuleTemplate == nil || moduleTemplateInfo.Err != nil {
+		return moduleTemplateInfo
+	}
+	// mandatory modules are not resolved through channels
+	if moduleReleaseMeta.Spec.Mandatory != nil {
+		return moduleTemplateInfo
+	}
+
+	if moduleTemplateInfo.DesiredChannel == string(shared.NoneChannel) {
+		if err := modulerevocation.CheckPinnedVersion(moduleReleaseMeta, kyma,
+			moduleTemplateInfo.Spec.Version); err != nil {
+			moduleTemplateInfo.Err = err
+			moduleTemplateInfo.ModuleTemplate = nil
+			return moduleTemplateInfo
+		}
+		moduleTemplateInfo.Revocation = moduleReleaseMeta.GetRevocation(moduleTemplateInfo.Spec.Version)
+		return moduleTemplateInfo
+	}
+
+	targetVersion, revocation, err := modulerevocation.TargetVersion(moduleReleaseMeta, kyma,
+		moduleTemplateInfo.Spec.Version)
+	if err != nil {
+		moduleTemplateInfo.Err = err
+		moduleTemplateInfo.ModuleTemplate = nil
+		return moduleTemplateInfo
+	}
+	if targetVersion != moduleTemplateInfo.Spec.Version {
+		targetModuleReleaseMeta := moduleReleaseMeta.DeepCopy()
+		for i := range targetModuleReleaseMeta.Spec.Channels {
+			if targetModuleReleaseMeta.Spec.Channels[i].Channel == moduleTemplateInfo.DesiredChannel {
+				targetModuleReleaseMeta.Spec.Channels[i].Version = targetVersion
+			}
+		}
+		moduleTemplateInfo = p.lookup.Lookup(ctx, moduleInfo, kyma, targetModuleReleaseMeta)
+		if moduleTemplateInfo.ModuleTemplate == nil || moduleTemplateInfo.Err != nil {
+			return moduleTemplateInfo
+		}
+	}
+	moduleTemplateInfo.Revocation = revocation
+	installedVersion := kyma.GetModuleVersion(moduleReleaseMeta.Spec.ModuleName)
+	if targetVersion != installedVersion && moduleReleaseMeta.GetRevocation(installedVersion) != nil {
+		moduleTemplateInfo.RevokedFrom = installedVersion
+	}
+	return moduleTemplateInfo
+}
@@ -0,0 +1,82 @@
+package moduletemplateinfolookup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerevocation"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+func Test_WithRevocationDecorator_Lookup_ReturnsInstalledVersion_WhenModuleIsFrozen(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRevocationDecorator(&channelLookupStub{})
+	mrm := revocationModuleReleaseMeta()
+	mrm.Spec.Freeze = &v1beta2.Freeze{Reason: "incident"}
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, revocationKyma("1.0.0"), mrm)
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.0.0", moduleTemplateInfo.Spec.Version)
+	assert.Nil(t, moduleTemplateInfo.Revocation)
+	assert.Empty(t, moduleTemplateInfo.RevokedFrom)
+}
+
+func Test_WithRevocationDecorator_Lookup_ReturnsSafeVersion_WhenInstalledVersionIsRevoked(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRevocationDecorator(&channelLookupStub{})
+	mrm := revocationModuleReleaseMeta()
+	mrm.Spec.Revocations = []v1beta2.Revocation{{Version: "1.1.0", SafeVersion: "1.1.1"}}
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, revocationKyma("1.1.0"), mrm)
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.1.1", moduleTemplateInfo.Spec.Version)
+	assert.Nil(t, moduleTemplateInfo.Revocation)
+	assert.Equal(t, "1.1.0", moduleTemplateInfo.RevokedFrom)
+}
+
+func Test_WithRevocationDecorator_Lookup_ReturnsRevocation_WhenRevokedVersionCannotBeMoved(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRevocationDecorator(&channelLookupStub{})
+	mrm := revocationModuleReleaseMeta()
+	mrm.Spec.Revocations = []v1beta2.Revocation{{Version: "1.1.0", SafeVersion: "1.0.0"}}
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, revocationKyma("1.1.0"), mrm)
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.1.0", moduleTemplateInfo.Spec.Version)
+	require.NotNil(t, moduleTemplateInfo.Revocation)
+	assert.Equal(t, "1.1.0", moduleTemplateInfo.Revocation.Version)
+}
+
+func Test_WithRevocationDecorator_Lookup_ReturnsError_WhenRevokedVersionWouldBeInstalled(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRevocationDecorator(&channelLookupStub{})
+	mrm := revocationModuleReleaseMeta()
+	mrm.Spec.Revocations = []v1beta2.Revocation{{Version: "1.1.0"}}
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, revocationKyma(""), mrm)
+
+	require.ErrorIs(t, moduleTemplateInfo.Err, modulerevocation.ErrVersionRevoked)
+	assert.Nil(t, moduleTemplateInfo.ModuleTemplate)
+}
+
+func revocationModuleReleaseMeta() *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: "test-module",
+			Channels:   []v1beta2.ChannelVersionAssignment{{Channel: "regular", Version: "1.1.0"}},
+		},
+	}
+}
+
+func revocationKyma(installedVersion string) *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{}
+	kyma.SetName("test-kyma")
+	if installedVersion != "" {
+		kyma.Status.Modules = []v1beta2.ModuleStatus{
+			{Name: "test-module", Channel: "regular", Version: installedVersion},
+		}
+	}
+	return kyma
+}
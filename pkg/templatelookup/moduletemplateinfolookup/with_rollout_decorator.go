@@ -0,0 +1,58 @@
+package moduletemplateinfolookup
+
+import (
+	"context"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/internal/service/modulerollout"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+)
+
+// WithRolloutDecorator holds Kymas back at their module version while a new version of the channel is rolled out
+// in waves that do not include them yet, unless that version is revoked.
+type WithRolloutDecorator struct {
+	lookup ModuleLookup
+}
+
+func NewWithRolloutDecorator(lookup ModuleLookup) WithRolloutDecorator {
+	return WithRolloutDecorator{lookup: lookup}
+}
+
+func (p WithRolloutDecorator) Lookup(ctx context.Context,
+	moduleInfo *templatelookup.ModuleInfo,
+	kyma *v1beta2.Kyma,
+	moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) templatelookup.ModuleTemplateInfo {
+	moduleTemplateInfo := p.lookup.Lookup(ctx,
+		moduleInfo,
+		kyma,
+		moduleReleaseMeta)
+
+	// lookup returns an error case => return immediately
+	if moduleTemplateInfo.ModuleTemplate == nil || moduleTemplateInfo.Err != nil {
+		return moduleTemplateInfo
+	}
+	// mandatory and pinned modules are not rolled out through channels
+	if moduleReleaseMeta.Spec.Mandatory != nil || moduleTemplateInfo.DesiredChannel == string(shared.NoneChannel) {
+		return moduleTemplateInfo
+	}
+
+	heldBackVersion, heldBack := modulerollout.HeldBackVersion(moduleReleaseMeta,
+		moduleTemplateInfo.DesiredChannel, kyma)
+	if !heldBack || heldBackVersion == moduleTemplateInfo.Spec.Version {
+		return moduleTemplateInfo
+	}
+	// Kymas are not held back at a revoked version, so that they are moved away from it like outside of rollouts
+	if moduleReleaseMeta.GetRevocation(heldBackVersion) != nil {
+		return moduleTemplateInfo
+	}
+
+	heldBackModuleReleaseMeta := moduleReleaseMeta.DeepCopy()
+	for i := range heldBackModuleReleaseMeta.Spec.Channels {
+		if heldBackModuleReleaseMeta.Spec.Channels[i].Channel == moduleTemplateInfo.DesiredChannel {
+			heldBackModuleReleaseMeta.Spec.Channels[i].Version = heldBackVersion
+		}
+	}
+	return p.lookup.Lookup(ctx, moduleInfo, kyma, heldBackModuleReleaseMeta)
+}
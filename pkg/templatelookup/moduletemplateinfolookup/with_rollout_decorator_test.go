@@ -0,0 +1,122 @@
+package moduletemplateinfolookup_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/shared"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
+	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/moduletemplateinfolookup"
+)
+
+func Test_WithRolloutDecorator_Lookup_ReturnsRolledOutVersion_WhenKymaIsInRolledOutWave(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRolloutDecorator(&channelLookupStub{})
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, rolloutKyma("canary"), rolloutModuleReleaseMeta())
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.1.0", moduleTemplateInfo.Spec.Version)
+}
+
+func Test_WithRolloutDecorator_Lookup_ReturnsInstalledVersion_WhenKymaIsNotInRolledOutWave(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRolloutDecorator(&channelLookupStub{})
+	kyma := rolloutKyma("production")
+	kyma.Status.Modules = []v1beta2.ModuleStatus{{Name: "test-module", Channel: "regular", Version: "0.9.0"}}
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, kyma, rolloutModuleReleaseMeta())
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "0.9.0", moduleTemplateInfo.Spec.Version)
+}
+
+func Test_WithRolloutDecorator_Lookup_ReturnsPreviousVersion_WhenModuleIsNotInstalledYet(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRolloutDecorator(&channelLookupStub{})
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, rolloutKyma("production"), rolloutModuleReleaseMeta())
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.0.0", moduleTemplateInfo.Spec.Version)
+}
+
+func Test_WithRolloutDecorator_Lookup_ReturnsChannelVersion_WhenRolloutIsCompleted(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRolloutDecorator(&channelLookupStub{})
+	mrm := rolloutModuleReleaseMeta()
+	mrm.Status.Rollouts[0].State = v1beta2.RolloutStateCompleted
+	mrm.Status.Rollouts[0].Wave = 1
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, rolloutKyma("production"), mrm)
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.1.0", moduleTemplateInfo.Spec.Version)
+}
+
+func Test_WithRolloutDecorator_Lookup_DoesNotHoldBack_WhenInstalledVersionIsRevoked(t *testing.T) {
+	decorator := moduletemplateinfolookup.NewWithRolloutDecorator(
+		moduletemplateinfolookup.NewWithRevocationDecorator(&channelLookupStub{}))
+	kyma := rolloutKyma("production")
+	kyma.Status.Modules = []v1beta2.ModuleStatus{{Name: "test-module", Channel: "regular", Version: "0.9.0"}}
+	mrm := rolloutModuleReleaseMeta()
+	mrm.Spec.Revocations = []v1beta2.Revocation{{Version: "0.9.0"}}
+
+	moduleTemplateInfo := decorator.Lookup(t.Context(), nil, kyma, mrm)
+
+	require.NoError(t, moduleTemplateInfo.Err)
+	assert.Equal(t, "1.1.0", moduleTemplateInfo.Spec.Version)
+	assert.Nil(t, moduleTemplateInfo.Revocation)
+	assert.Equal(t, "0.9.0", moduleTemplateInfo.RevokedFrom)
+}
+
+func rolloutModuleReleaseMeta() *v1beta2.ModuleReleaseMeta {
+	return &v1beta2.ModuleReleaseMeta{
+		Spec: v1beta2.ModuleReleaseMetaSpec{
+			ModuleName: "test-module",
+			Channels: []v1beta2.ChannelVersionAssignment{{
+				Channel: "regular",
+				Version: "1.1.0",
+				Rollout: &v1beta2.RolloutStrategy{
+					Waves: []v1beta2.RolloutWave{{
+						Name:         "canary",
+						KymaSelector: &apimetav1.LabelSelector{MatchLabels: map[string]string{"stage": "canary"}},
+					}},
+				},
+			}},
+		},
+		Status: v1beta2.ModuleReleaseMetaStatus{
+			Rollouts: []v1beta2.ChannelRollout{{
+				Channel:         "regular",
+				Version:         "1.1.0",
+				PreviousVersion: "1.0.0",
+				Wave:            0,
+				State:           v1beta2.RolloutStateProgressing,
+			}},
+		},
+	}
+}
+
+func rolloutKyma(stage string) *v1beta2.Kyma {
+	kyma := &v1beta2.Kyma{}
+	kyma.SetName("test-kyma")
+	kyma.SetLabels(map[string]string{"stage": stage, shared.ManagedBy: shared.OperatorName})
+	return kyma
+}
+
+// channelLookupStub returns the ModuleTemplate of the version assigned to the regular channel.
+type channelLookupStub struct{}
+
+func (s *channelLookupStub) Lookup(_ context.Context,
+	_ *templatelookup.ModuleInfo,
+	_ *v1beta2.Kyma,
+	moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+) templatelookup.ModuleTemplateInfo {
+	version, err := templatelookup.GetChannelVersionForModule(moduleReleaseMeta, "regular")
+	return templatelookup.ModuleTemplateInfo{
+		DesiredChannel: "regular",
+		ModuleTemplate: &v1beta2.ModuleTemplate{Spec: v1beta2.ModuleTemplateSpec{Version: version}},
+		Err:            err,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/provider"
 	"github.com/kyma-project/lifecycle-manager/internal/descriptor/types/ocmidentity"
+	"github.com/kyma-project/lifecycle-manager/internal/service/moduledeprecation"
 	restrictedmodulesvc "github.com/kyma-project/lifecycle-manager/internal/service/restrictedmodule"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup/common"
 )
@@ -33,6 +34,21 @@ type ModuleTemplateInfo struct {
 
 	ComponentId *ocmidentity.ComponentId // Identifies the OCM Component that is
 	//                                          represented by this ModuleTemplateInfo.
+
+	Requires []v1beta2.ModuleDependency // Modules required by the module, declared in the
+	//                                     ModuleReleaseMeta and the ModuleTemplate.
+
+	RollbackFrom string // The installed version that is rolled back to the version of the
+	//                     ModuleTemplate, as allowed by the ModuleReleaseMeta.
+
+	Revocation *v1beta2.Revocation // The revocation of the version of the ModuleTemplate, which the
+	//                                module keeps running as it cannot be moved to another version.
+
+	RevokedFrom string // The installed revoked version that the module is moved away from to the version
+	//                    of the ModuleTemplate, which does not wait for a maintenance window.
+
+	Deprecations []moduledeprecation.Notice // The deprecations of the module and its channel,
+	//                                         as announced by the ModuleReleaseMeta.
 }
 
 // GetOCMIdentity implements provider.OCMIProvider.
@@ -104,6 +120,9 @@ func (t *TemplateLookup) GetRegularTemplates(ctx context.Context, kyma *v1beta2.
 			kyma,
 			moduleReleaseMeta)
 
+		templateInfo.Requires = mergeRequirements(moduleReleaseMeta, templateInfo.ModuleTemplate)
+		templateInfo.Deprecations = moduledeprecation.Notices(moduleReleaseMeta, templateInfo.DesiredChannel)
+
 		templateInfo = t.ValidateTemplateMode(templateInfo, kyma, moduleReleaseMeta)
 		if templateInfo.Err != nil {
 			templates[moduleInfo.Name] = &templateInfo
@@ -125,7 +144,7 @@ func (t *TemplateLookup) GetRegularTemplates(ctx context.Context, kyma *v1beta2.
 		for i := range kyma.Status.Modules {
 			moduleStatus := &kyma.Status.Modules[i]
 			if moduleMatch(moduleStatus, moduleInfo.Name) {
-				markInvalidSkewUpdate(ctx, &templateInfo, moduleStatus, ocmId.Version())
+				markInvalidSkewUpdate(ctx, &templateInfo, moduleStatus, ocmId.Version(), moduleReleaseMeta)
 			}
 		}
 		templates[moduleInfo.Name] = &templateInfo
@@ -190,13 +209,36 @@ func (t *TemplateLookup) validateTemplateMode(template ModuleTemplateInfo,
 	return template
 }
 
+// mergeRequirements returns the requirements of the ModuleReleaseMeta, overridden by the requirements
+// of the same modules declared in the ModuleTemplate.
+func mergeRequirements(mrm *v1beta2.ModuleReleaseMeta,
+	template *v1beta2.ModuleTemplate,
+) []v1beta2.ModuleDependency {
+	requirements := slices.Clone(mrm.Spec.Requires)
+	if template == nil {
+		return requirements
+	}
+	for _, requirement := range template.Spec.Requires {
+		idx := slices.IndexFunc(requirements, func(existing v1beta2.ModuleDependency) bool {
+			return existing.Name == requirement.Name
+		})
+		if idx >= 0 {
+			requirements[idx] = requirement
+			continue
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements
+}
+
 func moduleMatch(moduleStatus *v1beta2.ModuleStatus, moduleName string) bool {
 	return moduleStatus.Name == moduleName
 }
 
 // markInvalidSkewUpdate verifies if the given ModuleTemplate is invalid for update.
+// A downgrade is only valid if the ModuleReleaseMeta explicitly allows the rollback.
 func markInvalidSkewUpdate(ctx context.Context, moduleTemplateInfo *ModuleTemplateInfo,
-	moduleStatus *v1beta2.ModuleStatus, templateVersion string,
+	moduleStatus *v1beta2.ModuleStatus, templateVersion string, moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
 ) {
 	if moduleStatus.Template == nil {
 		return
@@ -240,6 +282,12 @@ func markInvalidSkewUpdate(ctx context.Context, moduleTemplateInfo *ModuleTempla
 	)
 
 	if !isValidVersionChange(versionInTemplate, versionInStatus) {
+		if isAllowedRollback(moduleReleaseMeta, versionInStatus, versionInTemplate) {
+			checkLog.Info(fmt.Sprintf("rolling back module from version %s to %s",
+				versionInStatus.String(), versionInTemplate.String()))
+			moduleTemplateInfo.RollbackFrom = moduleStatus.Version
+			return
+		}
 		msg := fmt.Sprintf("ignore channel skew (from %s to %s), "+
 			"as a higher version (%s) of the module was previously installed",
 			moduleStatus.Channel, moduleTemplateInfo.DesiredChannel, versionInStatus.String())
@@ -248,6 +296,25 @@ func markInvalidSkewUpdate(ctx context.Context, moduleTemplateInfo *ModuleTempla
 	}
 }
 
+func isAllowedRollback(moduleReleaseMeta *v1beta2.ModuleReleaseMeta,
+	fromVersion, toVersion *semver.Version,
+) bool {
+	if moduleReleaseMeta == nil {
+		return false
+	}
+	return slices.ContainsFunc(moduleReleaseMeta.Spec.Rollbacks, func(rollback v1beta2.Rollback) bool {
+		rollbackFrom, err := semver.NewVersion(rollback.FromVersion)
+		if err != nil {
+			return false
+		}
+		rollbackTo, err := semver.NewVersion(rollback.ToVersion)
+		if err != nil {
+			return false
+		}
+		return rollbackFrom.Equal(fromVersion) && rollbackTo.Equal(toVersion)
+	})
+}
+
 func isValidVersionChange(newVersion *semver.Version, oldVersion *semver.Version) bool {
 	filteredNewVersion := filterVersion(newVersion)
 	filteredOldVersion := filterVersion(oldVersion)
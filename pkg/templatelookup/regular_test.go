@@ -480,6 +480,68 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchModuleChannel(t *testing.T
 				},
 			},
 		},
+		{
+			name: "When downgrade version with rollback allowed by ModuleReleaseMeta, " +
+				"then result contains no error and the rollback",
+			kyma: builder.NewKymaBuilder().
+				WithEnabledModule(testModule).
+				WithModuleStatus(v1beta2.ModuleStatus{
+					Name:    testModule.Name,
+					Version: version2,
+					Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: 1,
+						},
+					},
+				}).Build(),
+			availableModuleTemplate: generateModuleTemplateListWithModule(testModule.Name,
+				version1),
+			availableModuleReleaseMeta: v1beta2.ModuleReleaseMetaList{Items: []v1beta2.ModuleReleaseMeta{
+				*builder.NewModuleReleaseMetaBuilder().
+					WithModuleName(testModule.Name).
+					WithOcmComponentName(testutils.FullOCMName(testModule.Name)).
+					WithSingleModuleChannelAndVersions(testModule.Channel, version1).
+					WithRollbacks(v1beta2.Rollback{FromVersion: version2, ToVersion: version1}).
+					Build(),
+			}},
+			want: templatelookup.ModuleTemplatesByModuleName{
+				testModule.Name: &templatelookup.ModuleTemplateInfo{
+					DesiredChannel: testModule.Channel,
+					RollbackFrom:   version2,
+				},
+			},
+		},
+		{
+			name: "When downgrade version with rollback to another version, " +
+				"then result contains error",
+			kyma: builder.NewKymaBuilder().
+				WithEnabledModule(testModule).
+				WithModuleStatus(v1beta2.ModuleStatus{
+					Name:    testModule.Name,
+					Version: version2,
+					Template: &v1beta2.TrackingObject{
+						PartialMeta: v1beta2.PartialMeta{
+							Generation: 1,
+						},
+					},
+				}).Build(),
+			availableModuleTemplate: generateModuleTemplateListWithModule(testModule.Name,
+				version1),
+			availableModuleReleaseMeta: v1beta2.ModuleReleaseMetaList{Items: []v1beta2.ModuleReleaseMeta{
+				*builder.NewModuleReleaseMetaBuilder().
+					WithModuleName(testModule.Name).
+					WithOcmComponentName(testutils.FullOCMName(testModule.Name)).
+					WithSingleModuleChannelAndVersions(testModule.Channel, version1).
+					WithRollbacks(v1beta2.Rollback{FromVersion: version3, ToVersion: version1}).
+					Build(),
+			}},
+			want: templatelookup.ModuleTemplatesByModuleName{
+				testModule.Name: &templatelookup.ModuleTemplateInfo{
+					DesiredChannel: testModule.Channel,
+					Err:            templatelookup.ErrTemplateUpdateNotAllowed,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range tests {
@@ -502,6 +564,7 @@ func TestTemplateLookup_GetRegularTemplates_WhenSwitchModuleChannel(t *testing.T
 				assert.True(t, ok)
 				assert.Equal(t, wantModule.DesiredChannel, module.DesiredChannel)
 				require.ErrorIs(t, module.Err, wantModule.Err)
+				assert.Equal(t, wantModule.RollbackFrom, module.RollbackFrom)
 			}
 		})
 	}
@@ -991,6 +1054,48 @@ func TestTemplateLookup_GetRegularTemplates_WhenModuleTemplateExists(t *testing.
 	}
 }
 
+func TestTemplateLookup_GetRegularTemplates_MergesRequirementsOfModuleReleaseMetaAndModuleTemplate(t *testing.T) {
+	testModule := testutils.NewTestModule("module1", v1beta2.DefaultChannel)
+	const moduleVersion = "1.0.0"
+
+	fakeService := &componentdescriptor.FakeService{}
+	descriptorProvider := provider.NewCachedDescriptorProvider(fakeService, descriptorcache.NewDescriptorCache())
+	require.NoError(t, registerEmptyComponentDescriptor(fakeService, testutils.FullOCMName(testModule.Name),
+		moduleVersion))
+
+	templates := v1beta2.ModuleTemplateList{Items: []v1beta2.ModuleTemplate{
+		*builder.NewModuleTemplateBuilder().
+			WithName(fmt.Sprintf("%s-%s", testModule.Name, moduleVersion)).
+			WithModuleName(testModule.Name).
+			WithVersion(moduleVersion).
+			WithRequires(v1beta2.ModuleDependency{Name: "istio", Version: ">=1.2.0"}).
+			Build(),
+	}}
+	moduleReleaseMetas := v1beta2.ModuleReleaseMetaList{Items: []v1beta2.ModuleReleaseMeta{
+		*builder.NewModuleReleaseMetaBuilder().
+			WithModuleName(testModule.Name).
+			WithOcmComponentName(testutils.FullOCMName(testModule.Name)).
+			WithSingleModuleChannelAndVersions(testModule.Channel, moduleVersion).
+			WithRequires(
+				v1beta2.ModuleDependency{Name: "istio", Version: ">=1.0.0"},
+				v1beta2.ModuleDependency{Name: "api-gateway"},
+			).
+			Build(),
+	}}
+	reader := NewFakeModuleTemplateReader(templates, moduleReleaseMetas)
+	lookup := templatelookup.NewTemplateLookup(reader, descriptorProvider,
+		moduletemplateinfolookup.NewLookup(reader), nil)
+
+	got := lookup.GetRegularTemplates(t.Context(), builder.NewKymaBuilder().WithEnabledModule(testModule).Build())
+
+	require.Contains(t, got, testModule.Name)
+	require.NoError(t, got[testModule.Name].Err)
+	assert.Equal(t, []v1beta2.ModuleDependency{
+		{Name: "istio", Version: ">=1.2.0"},
+		{Name: "api-gateway"},
+	}, got[testModule.Name].Requires)
+}
+
 func TestTemplateNameMatch(t *testing.T) {
 	targetName := "module1"
 
@@ -68,6 +68,16 @@ func (m ModuleReleaseMetaBuilder) WithMandatory(version string) ModuleReleaseMet
 	return m
 }
 
+func (m ModuleReleaseMetaBuilder) WithRequires(requires ...v1beta2.ModuleDependency) ModuleReleaseMetaBuilder {
+	m.moduleReleaseMeta.Spec.Requires = requires
+	return m
+}
+
+func (m ModuleReleaseMetaBuilder) WithRollbacks(rollbacks ...v1beta2.Rollback) ModuleReleaseMetaBuilder {
+	m.moduleReleaseMeta.Spec.Rollbacks = rollbacks
+	return m
+}
+
 func (m ModuleReleaseMetaBuilder) WithKymaSelector(selector *apimetav1.LabelSelector) ModuleReleaseMetaBuilder {
 	m.moduleReleaseMeta.Spec.KymaSelector = selector
 	return m
@@ -117,6 +117,11 @@ func (m ModuleTemplateBuilder) WithRequiresDowntime(value bool) ModuleTemplateBu
 	return m
 }
 
+func (m ModuleTemplateBuilder) WithRequires(requires ...v1beta2.ModuleDependency) ModuleTemplateBuilder {
+	m.moduleTemplate.Spec.Requires = requires
+	return m
+}
+
 func (m ModuleTemplateBuilder) WithInternal(value bool) ModuleTemplateBuilder {
 	if m.moduleTemplate.Labels == nil {
 		m.moduleTemplate.Labels = make(map[string]string)
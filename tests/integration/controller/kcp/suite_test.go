@@ -53,6 +53,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
 	"github.com/kyma-project/lifecycle-manager/internal/repository/istiogateway"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
+	mrmrepo "github.com/kyma-project/lifecycle-manager/internal/repository/modulereleasemeta"
 	resultevent "github.com/kyma-project/lifecycle-manager/internal/result/event"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator"
@@ -235,8 +236,10 @@ var _ = BeforeSuite(func() {
 		DeletionService: deletionService,
 	}).SetupWithManager(mgr, ctrlruntime.Options{},
 		kyma.SetupOptions{ListenerAddr: UseRandomPort},
-		watchcmpse.ComposeTemplateChangeHandlerMapFunc(
+		watchcmpse.ComposeTemplateChangeHandler(
 			kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace),
+			mrmrepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace),
+			descriptorProvider,
 		),
 		watchcmpse.ComposeMrmEventHandler(
 			kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace),
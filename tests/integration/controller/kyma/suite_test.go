@@ -53,11 +53,13 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal/remote"
 	"github.com/kyma-project/lifecycle-manager/internal/repository/istiogateway"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
+	mrmrepo "github.com/kyma-project/lifecycle-manager/internal/repository/modulereleasemeta"
 	resultevent "github.com/kyma-project/lifecycle-manager/internal/result/event"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator"
 	"github.com/kyma-project/lifecycle-manager/internal/service/kyma/status/modules/generator/fromerror"
 	"github.com/kyma-project/lifecycle-manager/internal/setup"
+	"github.com/kyma-project/lifecycle-manager/internal/watch"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"github.com/kyma-project/lifecycle-manager/pkg/queue"
 	"github.com/kyma-project/lifecycle-manager/pkg/templatelookup"
@@ -238,13 +240,16 @@ var _ = BeforeSuite(func() {
 		DeletionService: deletionService,
 	}).SetupWithManager(mgr, ctrlruntime.Options{},
 		kyma.SetupOptions{ListenerAddr: randomPort},
-		watchcmpse.ComposeTemplateChangeHandlerMapFunc(
+		watchcmpse.ComposeTemplateChangeHandler(
 			kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace),
+			mrmrepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace),
+			descriptorProvider,
 		),
 		watchcmpse.ComposeMrmEventHandler(
 			kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace),
 			0,
 		),
+		watch.NewKymaRequeueSource(),
 	)
 	Expect(err).ToNot(HaveOccurred())
 	Eventually(CreateNamespace, Timeout, Interval).
@@ -139,7 +139,7 @@ var _ = BeforeSuite(func() {
 		mrmrepo.NewRepository(mgr.GetClient(), shared.DefaultControlPlaneNamespace),
 		mtrepo.NewRepository(mgr.GetClient(), shared.DefaultControlPlaneNamespace),
 		descriptorProvider, "",
-		flags.DefaultRemoteSyncNamespace, metrics.NewMandatoryModulesMetrics())
+		flags.DefaultRemoteSyncNamespace, metrics.NewMandatoryModulesMetrics(), nil)
 	installationReconciler := mandatorymodule.NewInstallationReconciler(installationService, intervals)
 
 	err = installationReconciler.SetupWithManager(mgr, ctrlruntime.Options{},
@@ -44,10 +44,13 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal"
 	manifestctrl "github.com/kyma-project/lifecycle-manager/internal/controller/manifest"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/helm"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/labelsremoval"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/manifestclient"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
@@ -142,7 +145,10 @@ var _ = BeforeSuite(func() {
 	kcpClient = mgr.GetClient()
 	nonExistingSecretName := types.NamespacedName{Namespace: "kcp-system", Name: "non-existing-secret"}
 	keyChainLookup := keychainprovider.NewFromSecretKeyChainProvider(kcpClient, nonExistingSecretName)
-	extractor := img.NewPathExtractor()
+	layerCache, err := layercache.NewCache(filepath.Join(os.TempDir(), "layer-cache"), 0,
+		metrics.NewLayerCacheMetrics())
+	Expect(err).ToNot(HaveOccurred())
+	extractor := img.NewPathExtractor(layerCache)
 	testEventRec := event.NewRecorderWrapper(mgr.GetEventRecorder(shared.OperatorName))
 	manifestClient := manifestclient.NewManifestClient(testEventRec, kcpClient)
 	orphanDetectionClient := kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace)
@@ -164,13 +170,15 @@ var _ = BeforeSuite(func() {
 		metrics.NewMandatoryModulesMetrics(),
 		manifestClient,
 		orphanDetectionService,
-		spec.NewResolver(keyChainLookup, extractor),
+		spec.NewResolver(keyChainLookup, extractor, helm.NewRenderer(layerCache)),
 		skrclientcache.NewService(),
 		skrclient.NewService(mgr.GetConfig().QPS, mgr.GetConfig().Burst, accessManagerService),
 		kcpClient,
 		renderService,
 		statecheck.NewExistsStateCheck(),
+		statecheck.NewCustomStateCheck(),
 		managedLabelRemovalService,
+		skrresources.DisabledDriftDetection{},
 	)
 
 	err = ctrl.NewControllerManagedBy(mgr).
@@ -15,6 +15,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api/shared"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
+	"github.com/kyma-project/lifecycle-manager/internal/pkg/flags"
 	"github.com/kyma-project/lifecycle-manager/internal/service/skrclient"
 	. "github.com/kyma-project/lifecycle-manager/pkg/testutils"
 	"github.com/kyma-project/lifecycle-manager/pkg/util"
@@ -89,8 +90,9 @@ var _ = Describe("Manifest readiness check", Ordered, func() {
 		By("Executing the CR readiness check")
 		statefulChecker := statecheck.NewStatefulSetStateCheck()
 		deploymentChecker := statecheck.NewDeploymentStateCheck()
-		customStateCheck := statecheck.NewManagerStateCheck(statefulChecker, deploymentChecker)
-		state, err := customStateCheck.GetState(ctx, testClient, resources)
+		healthCheck := statecheck.NewDefaultHealthCheck(statefulChecker, deploymentChecker,
+			flags.DefaultHealthReportMaxEntries)
+		state, _, err := healthCheck.GetState(ctx, testClient, resources)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(state).To(Equal(shared.StateReady))
 
@@ -43,10 +43,13 @@ import (
 	"github.com/kyma-project/lifecycle-manager/internal"
 	manifestctrl "github.com/kyma-project/lifecycle-manager/internal/controller/manifest"
 	"github.com/kyma-project/lifecycle-manager/internal/event"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/helm"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/img"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/keychainprovider"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/labelsremoval"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/layercache"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/manifestclient"
+	"github.com/kyma-project/lifecycle-manager/internal/manifest/skrresources"
 	"github.com/kyma-project/lifecycle-manager/internal/manifest/statecheck"
 	"github.com/kyma-project/lifecycle-manager/internal/pkg/metrics"
 	kymarepo "github.com/kyma-project/lifecycle-manager/internal/repository/kyma"
@@ -146,7 +149,10 @@ var _ = BeforeSuite(func() {
 
 	kcpClient = mgr.GetClient()
 	keyChainLookup := keychainprovider.NewDefaultKeyChainProvider()
-	extractor := img.NewPathExtractor()
+	layerCache, err := layercache.NewCache(filepath.Join(os.TempDir(), "layer-cache"), 0,
+		metrics.NewLayerCacheMetrics())
+	Expect(err).ToNot(HaveOccurred())
+	extractor := img.NewPathExtractor(layerCache)
 	testEventRec := event.NewRecorderWrapper(mgr.GetEventRecorder(shared.OperatorName))
 	manifestClient := manifestclient.NewManifestClient(testEventRec, kcpClient)
 	orphanDetectionClient := kymarepo.NewRepository(kcpClient, shared.DefaultControlPlaneNamespace)
@@ -163,10 +169,11 @@ var _ = BeforeSuite(func() {
 		Error:   1 * time.Second,
 		Warning: 1 * time.Second,
 	}, rateLimiter, metrics.NewManifestMetrics(metrics.NewSharedMetrics()), metrics.NewMandatoryModulesMetrics(),
-		manifestClient, orphanDetectionService, spec.NewResolver(keyChainLookup, extractor),
+		manifestClient, orphanDetectionService, spec.NewResolver(keyChainLookup, extractor, helm.NewRenderer(layerCache)),
 		skrclientcache.NewService(),
 		skrclient.NewService(mgr.GetConfig().QPS, mgr.GetConfig().Burst, accessManagerService),
-		kcpClient, renderService, statecheck.NewExistsStateCheck(), managedLabelRemovalService)
+		kcpClient, renderService, statecheck.NewExistsStateCheck(), statecheck.NewCustomStateCheck(),
+		managedLabelRemovalService, skrresources.DisabledDriftDetection{})
 
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta2.Manifest{}).